@@ -102,6 +102,13 @@ type NibiruApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// evmGenesisStreamDir is the directory of the streamed EVM accounts and
+	// storage in genesis import and export. Empty if not streamed.
+	evmGenesisStreamDir string
+	// evmGenesisStreamChunkSize is the number of records per chunk file in a
+	// streamed genesis export. Zero for the default.
+	evmGenesisStreamChunkSize int
 }
 
 func init() {
@@ -0,0 +1,65 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package app
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+var _ sdk.AnteDecorator = (*AnteDecEthCreatePolicy)(nil)
+
+// AnteDecEthCreatePolicy rejects contract creation txs that the
+// "create_policy" param of the EVM module forbids.
+type AnteDecEthCreatePolicy struct {
+	AppKeepers
+}
+
+// NewAnteDecEthCreatePolicy creates a new AnteDecEthCreatePolicy
+func NewAnteDecEthCreatePolicy(k AppKeepers) AnteDecEthCreatePolicy {
+	return AnteDecEthCreatePolicy{
+		AppKeepers: k,
+	}
+}
+
+// AnteHandle checks the sender of each contract creation tx against the
+// create policy. It must run after the EthSigVerificationDecorator, which sets
+// the "From" field of the msgs. Contracts deployed by other contracts with
+// CREATE and CREATE2 are checked during the EVM execution instead.
+func (anteDec AnteDecEthCreatePolicy) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	policy := anteDec.EvmKeeper.GetParams(ctx).CreatePolicy
+	if policy.Type == evm.CreatePolicyType_CREATE_POLICY_TYPE_PERMISSIONLESS {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evm.MsgEthereumTx)
+		if !ok {
+			return ctx, errors.Wrapf(
+				errortypes.ErrUnknownRequest,
+				"invalid message type %T, expected %T", msg, (*evm.MsgEthereumTx)(nil),
+			)
+		}
+
+		txData, err := evm.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errors.Wrap(err, "failed to unpack tx data")
+		}
+		if txData.GetTo() != nil {
+			continue
+		}
+
+		sender := gethcommon.HexToAddress(msgEthTx.From)
+		if err := policy.CheckCreate(sender, sender, false); err != nil {
+			return ctx, errors.Wrap(err, "failed to create new contract")
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
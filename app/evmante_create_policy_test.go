@@ -0,0 +1,101 @@
+package app_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+)
+
+func (s *TestSuite) TestAnteDecEthCreatePolicy() {
+	testCases := []struct {
+		name    string
+		policy  func(deps *evmtest.TestDeps) evm.CreatePolicy
+		txSetup func(deps *evmtest.TestDeps) sdk.Tx
+		wantErr string
+	}{
+		{
+			name: "happy: permissionless",
+			policy: func(deps *evmtest.TestDeps) evm.CreatePolicy {
+				return evm.CreatePolicy{}
+			},
+			txSetup: func(deps *evmtest.TestDeps) sdk.Tx {
+				return happyCreateContractTx(deps)
+			},
+			wantErr: "",
+		},
+		{
+			name: "happy: sender on the deployer allowlist",
+			policy: func(deps *evmtest.TestDeps) evm.CreatePolicy {
+				return evm.CreatePolicy{
+					Type:      evm.CreatePolicyType_CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST,
+					Allowlist: []string{deps.Sender.NibiruAddr.String()},
+				}
+			},
+			txSetup: func(deps *evmtest.TestDeps) sdk.Tx {
+				return happyCreateContractTx(deps)
+			},
+			wantErr: "",
+		},
+		{
+			name: "sad: sender not on the deployer allowlist",
+			policy: func(deps *evmtest.TestDeps) evm.CreatePolicy {
+				return evm.CreatePolicy{
+					Type:      evm.CreatePolicyType_CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST,
+					Allowlist: []string{evmtest.NewEthAccInfo().EthAddr.Hex()},
+				}
+			},
+			txSetup: func(deps *evmtest.TestDeps) sdk.Tx {
+				return happyCreateContractTx(deps)
+			},
+			wantErr: "not on the allowlist",
+		},
+		{
+			name: "sad: factory allowlist rejects direct deployments",
+			policy: func(deps *evmtest.TestDeps) evm.CreatePolicy {
+				return evm.CreatePolicy{
+					Type:      evm.CreatePolicyType_CREATE_POLICY_TYPE_FACTORY_ALLOWLIST,
+					Allowlist: []string{deps.Sender.EthAddr.Hex()},
+				}
+			},
+			txSetup: func(deps *evmtest.TestDeps) sdk.Tx {
+				return happyCreateContractTx(deps)
+			},
+			wantErr: "only be deployed by the factories",
+		},
+		{
+			name: "happy: calls are not restricted",
+			policy: func(deps *evmtest.TestDeps) evm.CreatePolicy {
+				return evm.CreatePolicy{
+					Type: evm.CreatePolicyType_CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST,
+				}
+			},
+			txSetup: func(deps *evmtest.TestDeps) sdk.Tx {
+				return happyTransfertTx(deps, 0)
+			},
+			wantErr: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			deps := evmtest.NewTestDeps()
+			params := deps.K.GetParams(deps.Ctx)
+			params.CreatePolicy = tc.policy(&deps)
+			deps.K.SetParams(deps.Ctx, params)
+
+			anteDec := app.NewAnteDecEthCreatePolicy(deps.Chain.AppKeepers)
+			tx := tc.txSetup(&deps)
+
+			_, err := anteDec.AnteHandle(
+				deps.Ctx, tx, false, NextNoOpAnteHandler,
+			)
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
@@ -18,6 +18,7 @@ func NewAnteHandlerEVM(
 		NewEthMinGasPriceDecorator(k),
 		NewEthValidateBasicDecorator(k),
 		NewEthSigVerificationDecorator(k),
+		NewAnteDecEthCreatePolicy(k),
 		NewAnteDecVerifyEthAcc(k),
 		NewCanTransferDecorator(k),
 		NewAnteDecEthGasConsume(k, options.MaxTxGasWanted),
@@ -2,7 +2,13 @@ package app
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
 	"log"
+	"os"
+	"path/filepath"
+	"slices"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
@@ -11,6 +17,9 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/evmmodule"
 )
 
 // ExportAppStateAndValidators exports the state of the application for a genesis
@@ -29,6 +38,10 @@ func (app *NibiruApp) ExportAppStateAndValidators(
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
 
+	if err := app.prepEvmGenesisStreamDir(modulesToExport); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
 	genState := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
 	appState, err := json.MarshalIndent(genState, "", "  ")
 	if err != nil {
@@ -44,6 +57,25 @@ func (app *NibiruApp) ExportAppStateAndValidators(
 	}, err
 }
 
+// prepEvmGenesisStreamDir creates the directory of the streamed EVM accounts
+// and storage, if set, and checks that it does not hold a previous export.
+func (app *NibiruApp) prepEvmGenesisStreamDir(modulesToExport []string) error {
+	if app.evmGenesisStreamDir == "" {
+		return nil
+	}
+	if len(modulesToExport) > 0 && !slices.Contains(modulesToExport, evm.ModuleName) {
+		return nil
+	}
+
+	manifestPath := filepath.Join(app.evmGenesisStreamDir, evmmodule.GenesisManifestFile)
+	if _, err := os.Stat(manifestPath); err == nil {
+		return fmt.Errorf("the evm genesis stream directory already holds an export: %s", manifestPath)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return os.MkdirAll(app.evmGenesisStreamDir, 0o755)
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
@@ -2,6 +2,7 @@ package app
 
 import (
 	"path/filepath"
+	"slices"
 	"strings"
 
 	ica "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts"
@@ -305,6 +306,12 @@ func (app *NibiruApp) InitKeepers(
 	}
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
 
+	app.evmGenesisStreamDir = cast.ToString(appOpts.Get("evm.genesis-stream-dir"))
+	if app.evmGenesisStreamDir != "" && !filepath.IsAbs(app.evmGenesisStreamDir) {
+		app.evmGenesisStreamDir = filepath.Join(homePath, app.evmGenesisStreamDir)
+	}
+	app.evmGenesisStreamChunkSize = cast.ToInt(appOpts.Get("evm.genesis-stream-chunk-size"))
+
 	/*upgradeKeeper must be created before ibcKeeper. */
 	app.upgradeKeeper = *upgradekeeper.NewKeeper(
 		skipUpgradeHeights,
@@ -372,6 +379,9 @@ func (app *NibiruApp) InitKeepers(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
+		app.DistrKeeper,
+		app.InflationKeeper,
+		app.SudoKeeper,
 		cast.ToString(appOpts.Get("evm.tracer")),
 	)
 
@@ -630,7 +640,8 @@ func (app *NibiruApp) initAppModules(
 		ibcfee.NewAppModule(app.ibcFeeKeeper),
 		ica.NewAppModule(&app.icaControllerKeeper, &app.icaHostKeeper),
 
-		evmmodule.NewAppModule(&app.EvmKeeper, app.AccountKeeper),
+		evmmodule.NewAppModule(appCodec, &app.EvmKeeper, app.AccountKeeper, app.BankKeeper).
+			WithGenesisStream(app.evmGenesisStreamDir, app.evmGenesisStreamChunkSize),
 
 		// wasm
 		wasm.NewAppModule(
@@ -742,7 +753,15 @@ func (app *NibiruApp) initModuleManager(
 	orderedModules := orderedModuleNames()
 	app.ModuleManager.SetOrderBeginBlockers(orderedModules...)
 	app.ModuleManager.SetOrderEndBlockers(orderedModules...)
-	app.ModuleManager.SetOrderInitGenesis(orderedModules...)
+	// x/crisis asserts the invariants of every module in its InitGenesis, so
+	// it must run after all of the other modules have set their state.
+	initGenesisOrder := append(
+		slices.DeleteFunc(slices.Clone(orderedModules), func(name string) bool {
+			return name == crisistypes.ModuleName
+		}),
+		crisistypes.ModuleName,
+	)
+	app.ModuleManager.SetOrderInitGenesis(initGenesisOrder...)
 	app.ModuleManager.SetOrderExportGenesis(orderedModules...)
 
 	// Uncomment if you want to set a custom migration order here.
@@ -117,6 +117,13 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// GenesisStreamDir defines the directory of the chunk files of the EVM
+	// accounts and storage in genesis import and export. If empty, the
+	// accounts are part of the genesis file.
+	GenesisStreamDir string `mapstructure:"genesis-stream-dir"`
+	// GenesisStreamChunkSize defines the number of records per chunk file of a
+	// streamed genesis export. If zero, the module default is used.
+	GenesisStreamChunkSize int `mapstructure:"genesis-stream-chunk-size"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -381,6 +388,16 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# GenesisStreamDir defines the directory of the chunk files of the EVM accounts and
+# storage in genesis import and export, relative to the home directory if not absolute.
+# When set, 'nibid export' writes the EVM state to this directory instead of the
+# genesis file, and the node reads it back on InitChain.
+genesis-stream-dir = "{{ .EVM.GenesisStreamDir }}"
+
+# GenesisStreamChunkSize defines the number of records per chunk file of a streamed
+# genesis export. If zero, the default of 100000 records is used.
+genesis-stream-chunk-size = {{ .EVM.GenesisStreamChunkSize }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
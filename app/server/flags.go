@@ -55,8 +55,10 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer                 = "evm.tracer"
+	EVMMaxTxGasWanted         = "evm.max-tx-gas-wanted"
+	EVMGenesisStreamDir       = "evm.genesis-stream-dir"
+	EVMGenesisStreamChunkSize = "evm.genesis-stream-chunk-size"
 )
 
 // TLS flags
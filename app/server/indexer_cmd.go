@@ -0,0 +1,184 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cometbft/cometbft/node"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/NibiruChain/nibiru/eth/indexer"
+)
+
+const (
+	FlagIndexerWorkers = "workers"
+	FlagIndexerResume  = "resume"
+)
+
+// NewIndexTxCmd returns the command that (re)builds the custom EVM tx indexer
+// from the local CometBFT block and state stores.
+func NewIndexTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-eth-tx [from-height] [to-height]",
+		Short: "Index the eth txs and logs of historical blocks into the EVM tx indexer",
+		Long: `Index the eth txs and logs of the blocks in [from-height, to-height] into the
+EVM tx indexer used by the JSON-RPC when "json-rpc.enable-indexer" is set.
+Blocks and ABCI results are read from the local CometBFT block and state
+stores, so the node must be stopped and must not discard ABCI responses.
+
+By default, it indexes every block in the block store, resuming from the first
+block of the range that is not indexed yet. Use it to backfill the indexer of
+an existing node or to rebuild a corrupted "evmindexer" DB with --resume=false.`,
+		Example: `nibid index-eth-tx
+nibid index-eth-tx 1 500000 --workers 8 --resume=false`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			workers, err := cmd.Flags().GetInt(FlagIndexerWorkers)
+			if err != nil {
+				return err
+			}
+			if workers < 1 {
+				return fmt.Errorf("--%s must be positive, got %d", FlagIndexerWorkers, workers)
+			}
+			resume, err := cmd.Flags().GetBool(FlagIndexerResume)
+			if err != nil {
+				return err
+			}
+
+			cfg := serverCtx.Config
+			idxDB, err := OpenIndexerDB(cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer idxDB.Close()
+			idxer := indexer.NewKVIndexer(idxDB, serverCtx.Logger.With("indexer", "evm"), clientCtx)
+
+			// Open the local CometBFT DBs, since the node and its RPC are not running.
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			blockStore := store.NewBlockStore(blockStoreDB)
+			stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: false})
+
+			if blockStore.Height() == 0 {
+				cmd.Println("nothing to index: the block store is empty")
+				return nil
+			}
+			from, to := blockStore.Base(), blockStore.Height()
+			if len(args) > 0 {
+				if from, err = parseHeight(args[0]); err != nil {
+					return err
+				}
+			}
+			if len(args) > 1 {
+				if to, err = parseHeight(args[1]); err != nil {
+					return err
+				}
+			}
+			if from < blockStore.Base() || to > blockStore.Height() {
+				return fmt.Errorf(
+					"heights [%d, %d] are not all in the block store, which has [%d, %d]",
+					from, to, blockStore.Base(), blockStore.Height(),
+				)
+			}
+			if resume && from <= to {
+				if from, err = resumeHeight(idxer, from, to); err != nil {
+					return err
+				}
+			}
+			if from > to {
+				cmd.Printf("nothing to index: from-height %d > to-height %d\n", from, to)
+				return nil
+			}
+
+			indexBlock := func(height int64) error {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return fmt.Errorf("block %d not found", height)
+				}
+				abciResponses, err := stateStore.LoadABCIResponses(height)
+				if err != nil {
+					return fmt.Errorf("failed to load ABCI responses of block %d: %w", height, err)
+				}
+				return idxer.IndexBlock(block, abciResponses.DeliverTxs)
+			}
+
+			cmd.Printf("indexing blocks [%d, %d] with %d workers\n", from, to, workers)
+			total := to - from + 1
+			start := time.Now()
+			lastReport := start
+			// Index in batches of "workers" blocks so that an interrupted run
+			// leaves gaps only in the last batch.
+			for batchStart := from; batchStart <= to; batchStart += int64(workers) {
+				batchEnd := batchStart + int64(workers) - 1
+				if batchEnd > to {
+					batchEnd = to
+				}
+				var group errgroup.Group
+				for height := batchStart; height <= batchEnd; height++ {
+					height := height
+					group.Go(func() error { return indexBlock(height) })
+				}
+				if err := group.Wait(); err != nil {
+					return err
+				}
+
+				if batchEnd == to || time.Since(lastReport) >= 5*time.Second {
+					lastReport = time.Now()
+					done := batchEnd - from + 1
+					cmd.Printf(
+						"indexed %d/%d blocks (%.1f%%), height %d, elapsed %s\n",
+						done, total, 100*float64(done)/float64(total), batchEnd,
+						time.Since(start).Round(time.Second),
+					)
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Int(FlagIndexerWorkers, 1, "Number of blocks to index concurrently")
+	cmd.Flags().Bool(FlagIndexerResume, true, "Start from the first block of the range that is not indexed yet")
+	return cmd
+}
+
+// resumeHeight returns the first height in [from, to] that is not indexed,
+// or to + 1 if the whole range is indexed. Blocks indexed concurrently may
+// complete out of order, so an interrupted run can leave gaps before the
+// highest indexed block.
+func resumeHeight(idxer *indexer.KVIndexer, from, to int64) (int64, error) {
+	gap, err := idxer.FirstLogGap(from, to)
+	if err != nil {
+		return 0, err
+	}
+	if gap < 0 {
+		return to + 1, nil
+	}
+	return gap, nil
+}
+
+func parseHeight(arg string) (int64, error) {
+	height, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || height < 1 {
+		return 0, fmt.Errorf("invalid height \"%s\": must be a positive integer", arg)
+	}
+	return height, nil
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/eth/indexer"
+)
+
+func TestResumeHeight(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec)
+	for _, tc := range []struct {
+		name     string
+		indexed  []int64
+		from, to int64
+		want     int64
+	}{
+		{
+			name: "empty indexer",
+			from: 1,
+			to:   10,
+			want: 1,
+		},
+		{
+			name:    "contiguous prefix",
+			indexed: []int64{1, 2, 3},
+			from:    1,
+			to:      10,
+			want:    4,
+		},
+		{
+			name:    "gap before the last indexed block",
+			indexed: []int64{1, 2, 3, 6, 8, 9},
+			from:    1,
+			to:      10,
+			want:    4,
+		},
+		{
+			name:    "blocks before from-height are ignored",
+			indexed: []int64{1, 2, 6},
+			from:    6,
+			to:      10,
+			want:    7,
+		},
+		{
+			name:    "fully indexed range",
+			indexed: []int64{5, 6, 7, 8, 9, 10},
+			from:    6,
+			to:      10,
+			want:    11,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+			for _, height := range tc.indexed {
+				block := &tmtypes.Block{Header: tmtypes.Header{Height: height}}
+				require.NoError(t, idxer.IndexBlock(block, nil))
+			}
+			got, err := resumeHeight(idxer, tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseHeight(t *testing.T) {
+	height, err := parseHeight("42")
+	require.NoError(t, err)
+	require.Equal(t, int64(42), height)
+
+	for _, arg := range []string{"0", "-1", "abc"} {
+		_, err := parseHeight(arg)
+		require.ErrorContains(t, err, "must be a positive integer", arg)
+	}
+}
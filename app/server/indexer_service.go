@@ -0,0 +1,140 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/NibiruChain/nibiru/eth"
+)
+
+const (
+	EVMIndexerServiceName = "EVMIndexerService"
+
+	// NewBlockWaitTimeout is the max time to wait for a new block before
+	// checking the latest height again.
+	NewBlockWaitTimeout = 60 * time.Second
+
+	// IndexerRetryBackoff is the time to wait before retrying a block that
+	// failed to be fetched or indexed. It doubles on every consecutive
+	// failure, up to NewBlockWaitTimeout.
+	IndexerRetryBackoff = time.Second
+)
+
+// EVMIndexerService indexes the eth txs and logs of every committed block
+// with an [eth.EVMTxIndexer].
+type EVMIndexerService struct {
+	service.BaseService
+
+	txIdxr       eth.EVMTxIndexer
+	client       rpcclient.Client
+	retryBackoff time.Duration
+}
+
+// NewEVMIndexerService returns a new service instance.
+func NewEVMIndexerService(
+	txIdxr eth.EVMTxIndexer,
+	client rpcclient.Client,
+) *EVMIndexerService {
+	is := &EVMIndexerService{
+		txIdxr:       txIdxr,
+		client:       client,
+		retryBackoff: IndexerRetryBackoff,
+	}
+	is.BaseService = *service.NewBaseService(nil, EVMIndexerServiceName, is)
+	return is
+}
+
+// OnStart implements service.Service by subscribing for new blocks and
+// indexing them from the block after the last indexed one. A block that fails
+// to be fetched or indexed is retried with backoff before moving on. It only
+// returns on error or when the service is stopped.
+func (eis *EVMIndexerService) OnStart() error {
+	ctx := context.Background()
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	var latestBlock atomic.Int64
+	latestBlock.Store(status.SyncInfo.LatestBlockHeight)
+	newBlockSignal := make(chan struct{}, 1)
+
+	blockHeadersChan, err := eis.client.Subscribe(
+		ctx,
+		EVMIndexerServiceName,
+		tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String(),
+		0,
+	)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for msg := range blockHeadersChan {
+			eventDataHeader, ok := msg.Data.(tmtypes.EventDataNewBlockHeader)
+			if !ok || eventDataHeader.Header.Height <= latestBlock.Load() {
+				continue
+			}
+			latestBlock.Store(eventDataHeader.Header.Height)
+			// notify
+			select {
+			case newBlockSignal <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	lastBlock, err := eis.txIdxr.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if lastBlock == -1 {
+		lastBlock = latestBlock.Load()
+	}
+	var backoff time.Duration
+	for {
+		if latestBlock.Load() <= lastBlock {
+			// nothing to index. wait for signal of new block
+			select {
+			case <-newBlockSignal:
+			case <-time.After(NewBlockWaitTimeout):
+			case <-eis.Quit():
+				return nil
+			}
+			continue
+		}
+		height := lastBlock + 1
+		if err := eis.indexBlock(ctx, height); err != nil {
+			// Retry the same block so that the indexed blocks stay contiguous.
+			backoff = min(max(2*backoff, eis.retryBackoff), NewBlockWaitTimeout)
+			eis.Logger.Error("failed to index block, retrying", "height", height, "backoff", backoff, "err", err)
+			select {
+			case <-time.After(backoff):
+			case <-eis.Quit():
+				return nil
+			}
+			continue
+		}
+		backoff = 0
+		lastBlock = height
+	}
+}
+
+// indexBlock fetches a block and its results and indexes them.
+func (eis *EVMIndexerService) indexBlock(ctx context.Context, height int64) error {
+	block, err := eis.client.Block(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block: %w", err)
+	}
+	blockResult, err := eis.client.BlockResults(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block result: %w", err)
+	}
+	return eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults)
+}
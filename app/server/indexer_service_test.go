@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/eth"
+)
+
+// mockIndexerClient serves blocks up to "latest" and fails to fetch the
+// blocks of "fetchFailures" as many times as their count.
+type mockIndexerClient struct {
+	rpcclient.Client
+
+	mu            sync.Mutex
+	latest        int64
+	fetchFailures map[int64]int
+}
+
+func (c *mockIndexerClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{
+		SyncInfo: coretypes.SyncInfo{LatestBlockHeight: c.latest},
+	}, nil
+}
+
+func (c *mockIndexerClient) Subscribe(
+	context.Context, string, string, ...int,
+) (<-chan coretypes.ResultEvent, error) {
+	return make(chan coretypes.ResultEvent), nil
+}
+
+func (c *mockIndexerClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fetchFailures[*height] > 0 {
+		c.fetchFailures[*height]--
+		return nil, errors.New("block not available")
+	}
+	return &coretypes.ResultBlock{
+		Block: &tmtypes.Block{Header: tmtypes.Header{Height: *height}},
+	}, nil
+}
+
+func (c *mockIndexerClient) BlockResults(
+	_ context.Context, height *int64,
+) (*coretypes.ResultBlockResults, error) {
+	return &coretypes.ResultBlockResults{Height: *height}, nil
+}
+
+// mockTxIndexer records the indexed blocks and fails to index the blocks of
+// "indexFailures" as many times as their count.
+type mockTxIndexer struct {
+	eth.EVMTxIndexer
+
+	mu            sync.Mutex
+	last          int64
+	indexed       []int64
+	indexFailures map[int64]int
+}
+
+func (idxr *mockTxIndexer) LastIndexedBlock() (int64, error) {
+	return idxr.last, nil
+}
+
+func (idxr *mockTxIndexer) IndexBlock(block *tmtypes.Block, _ []*abci.ResponseDeliverTx) error {
+	idxr.mu.Lock()
+	defer idxr.mu.Unlock()
+	if idxr.indexFailures[block.Height] > 0 {
+		idxr.indexFailures[block.Height]--
+		return errors.New("db write failed")
+	}
+	idxr.indexed = append(idxr.indexed, block.Height)
+	return nil
+}
+
+func (idxr *mockTxIndexer) indexedBlocks() []int64 {
+	idxr.mu.Lock()
+	defer idxr.mu.Unlock()
+	return append([]int64{}, idxr.indexed...)
+}
+
+func TestEVMIndexerServiceRetry(t *testing.T) {
+	client := &mockIndexerClient{
+		latest:        4,
+		fetchFailures: map[int64]int{2: 2},
+	}
+	idxr := &mockTxIndexer{
+		last:          0,
+		indexFailures: map[int64]int{3: 1},
+	}
+	eis := NewEVMIndexerService(idxr, client)
+	eis.retryBackoff = time.Millisecond
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- eis.Start() }()
+
+	wantIndexed := []int64{1, 2, 3, 4}
+	require.Eventually(t, func() bool {
+		return len(idxr.indexedBlocks()) == len(wantIndexed)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, wantIndexed, idxr.indexedBlocks(),
+		"failed blocks are retried in order, none is skipped")
+
+	require.NoError(t, eis.Stop())
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the service did not stop")
+	}
+	require.Equal(t, wantIndexed, idxr.indexedBlocks())
+}
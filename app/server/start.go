@@ -34,6 +34,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/rpc/client/local"
 
 	"cosmossdk.io/tools/rosetta"
@@ -200,6 +201,7 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().String(EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().String(EVMGenesisStreamDir, "", "the directory of the streamed EVM accounts and storage of the genesis, relative to the home directory if not absolute")            //nolint:lll
 
 	cmd.Flags().String(TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -391,6 +393,21 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 
 		idxLogger := ctx.Logger.With("indexer", "evm")
 		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
+		indexerService.SetLogger(idxLogger)
+
+		errCh := make(chan error)
+		go func() {
+			if err := indexerService.Start(); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(types.ServerStartTime): // assume server started successfully
+		}
 	}
 
 	if conf.API.Enable || conf.JSONRPC.Enable {
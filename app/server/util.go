@@ -43,15 +43,19 @@ func AddCommands(
 	startCmd := StartCmd(opts)
 	addStartFlags(startCmd)
 
+	exportCmd := sdkserver.ExportCmd(appExport, opts.DefaultNodeHome)
+	exportCmd.Flags().String(EVMGenesisStreamDir, "", "the directory to write the EVM accounts and storage to instead of the genesis, relative to the home directory if not absolute") //nolint:lll
+	exportCmd.Flags().Int(EVMGenesisStreamChunkSize, 0, "the number of records per chunk file of the streamed EVM accounts and storage (0 for the default)")                           //nolint:lll
+
 	rootCmd.AddCommand(
 		startCmd,
 		tendermintCmd,
-		sdkserver.ExportCmd(appExport, opts.DefaultNodeHome),
+		exportCmd,
 		version.NewVersionCommand(),
 		sdkserver.NewRollbackCmd(opts.AppCreator, opts.DefaultNodeHome),
 
 		// custom tx indexer command
-		// NewIndexTxCmd(), TODO: check indexer tx command
+		NewIndexTxCmd(),
 	)
 }
 
@@ -5,6 +5,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
@@ -18,3 +19,25 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMLogIndexer defines the interface of an eth tx indexer that also indexes
+// the logs of every block by address and topic, so that "eth_getLogs" can
+// answer large block ranges without reading each block.
+type EVMLogIndexer interface {
+	EVMTxIndexer
+
+	// FirstLogIndexedBlock returns -1 if no block is indexed
+	FirstLogIndexedBlock() (int64, error)
+	// LastLogIndexedBlock returns -1 if no block is indexed
+	LastLogIndexedBlock() (int64, error)
+	// FirstLogGap returns the first block in [from, to] that is not indexed,
+	// or -1 if the whole range is indexed.
+	FirstLogGap(from, to int64) (int64, error)
+	// GetBlockBloom returns false if the block is not indexed.
+	GetBlockBloom(height int64) (gethcore.Bloom, bool, error)
+	// GetLogs returns the logs of the blocks in [from, to] that match the
+	// addresses and topics. It errors if more than logLimit logs match.
+	GetLogs(
+		from, to int64, addresses []common.Address, topics [][]common.Hash, logLimit int,
+	) ([]*gethcore.Log, error)
+}
@@ -47,12 +47,48 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Stores the logs and the bloom of the block in the log index
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
 	height := block.Header.Height
 
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
+	ethTxs := ParseBlockEthTxs(kv.clientCtx.TxConfig.TxDecoder(), kv.logger, block, txResults)
+	for _, ethTx := range ethTxs {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, ethTx.TxHash, ethTx.Result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	if err := indexBlockLogs(batch, height, txResults); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// EthTxResult is an EVM tx of a block and its result.
+type EthTxResult struct {
+	TxHash common.Hash
+	Msg    *evm.MsgEthereumTx
+	Result *eth.TxResult
+}
+
+// ParseBlockEthTxs parses the EVM txs of a block and their results from the
+// ABCI events of the block, in block order. It returns what "IndexBlock"
+// stores, so that the results can also be read from a block without the
+// indexer.
+func ParseBlockEthTxs(
+	txDecoder sdk.TxDecoder,
+	logger log.Logger,
+	block *tmtypes.Block,
+	txResults []*abci.ResponseDeliverTx,
+) []EthTxResult {
+	height := block.Header.Height
+	var ethTxs []EthTxResult
+
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
 	for txIndex, tx := range block.Txs {
@@ -61,9 +97,9 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			continue
 		}
 
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
+		tx, err := txDecoder(tx)
 		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
 			continue
 		}
 
@@ -73,7 +109,7 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 
 		txs, err := rpc.ParseTxResult(result, tx)
 		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
 			continue
 		}
 
@@ -96,11 +132,11 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			} else {
 				parsedTx := txs.GetTxByMsgIndex(msgIndex)
 				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
 					continue
 				}
 				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
@@ -110,15 +146,14 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			txResult.CumulativeGasUsed = cumulativeGasUsed
 			ethTxIndex++
 
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
-			}
+			ethTxs = append(ethTxs, EthTxResult{
+				TxHash: txHash,
+				Msg:    ethMsg,
+				Result: &txResult,
+			})
 		}
 	}
-	if err := batch.Write(); err != nil {
-		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
-	}
-	return nil
+	return ethTxs
 }
 
 // LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
@@ -0,0 +1,379 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+const (
+	KeyPrefixBlockBloom = 3
+	KeyPrefixBlockLogs  = 4
+	KeyPrefixLogAddress = 5
+	KeyPrefixLogTopic   = 6
+
+	// BlockKeyLength is the length of the block-bloom and block-logs keys
+	BlockKeyLength = 1 + 8
+)
+
+var _ eth.EVMLogIndexer = &KVIndexer{}
+
+// indexBlockLogs adds the logs of every tx in a block to the db batch:
+//   - `block number -> block bloom`, for every indexed block, even without logs
+//   - `block number -> logs of the block`
+//   - `(address, block number) -> nil`, for each address that emitted a log
+//   - `(topic position, topic, block number) -> nil`, for each topic of a log
+func indexBlockLogs(
+	batch dbm.Batch, height int64, txResults []*abci.ResponseDeliverTx,
+) error {
+	var logs []*evm.Log
+	for _, result := range txResults {
+		txLogs, err := parseTxLogs(result.Events)
+		if err != nil {
+			return errorsmod.Wrapf(err, "parse logs of block %d", height)
+		}
+		logs = append(logs, txLogs...)
+	}
+
+	var bloom gethcore.Bloom
+	for _, log := range evm.LogsToEthereum(logs) {
+		bloom.Add(log.Address.Bytes())
+		if err := batch.Set(LogAddressKey(log.Address, height), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-address key")
+		}
+		for position, topic := range log.Topics {
+			bloom.Add(topic.Bytes())
+			if err := batch.Set(LogTopicKey(position, topic, height), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-topic key")
+			}
+		}
+	}
+	if err := batch.Set(BlockBloomKey(height), bloom.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set block-bloom key")
+	}
+
+	if len(logs) == 0 {
+		return nil
+	}
+	bz, err := json.Marshal(logs)
+	if err != nil {
+		return errorsmod.Wrap(err, "marshal logs")
+	}
+	if err := batch.Set(BlockLogsKey(height), bz); err != nil {
+		return errorsmod.Wrap(err, "set block-logs key")
+	}
+	return nil
+}
+
+// parseTxLogs parses the ethereum logs from the events of a tx.
+func parseTxLogs(events []abci.Event) (logs []*evm.Log, err error) {
+	for _, event := range events {
+		if event.Type != evm.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evm.AttributeKeyTxLog {
+				continue
+			}
+			var log evm.Log
+			if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+				return nil, err
+			}
+			logs = append(logs, &log)
+		}
+	}
+	return logs, nil
+}
+
+// FirstLogIndexedBlock returns the first block number with indexed logs,
+// returns -1 if no block is indexed
+func (kv *KVIndexer) FirstLogIndexedBlock() (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixBlockBloom}, []byte{KeyPrefixBlockBloom + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstLogIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromBlockKey(it.Key())
+}
+
+// LastLogIndexedBlock returns the last block number with indexed logs,
+// returns -1 if no block is indexed
+func (kv *KVIndexer) LastLogIndexedBlock() (int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixBlockBloom}, []byte{KeyPrefixBlockBloom + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastLogIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromBlockKey(it.Key())
+}
+
+// FirstLogGap returns the first block number in [from, to] without indexed
+// logs, returns -1 if every block of the range is indexed
+func (kv *KVIndexer) FirstLogGap(from, to int64) (int64, error) {
+	it, err := kv.db.Iterator(BlockBloomKey(from), BlockBloomKey(to+1))
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstLogGap")
+	}
+	defer it.Close()
+	next := from
+	for ; it.Valid() && next <= to; it.Next() {
+		height, err := parseBlockNumberFromBlockKey(it.Key())
+		if err != nil {
+			return 0, err
+		}
+		if height != next {
+			return next, nil
+		}
+		next++
+	}
+	if next <= to {
+		return next, nil
+	}
+	return -1, nil
+}
+
+// GetBlockBloom returns the bloom of the logs of a block, and false if the
+// block is not indexed.
+func (kv *KVIndexer) GetBlockBloom(height int64) (gethcore.Bloom, bool, error) {
+	bz, err := kv.db.Get(BlockBloomKey(height))
+	if err != nil {
+		return gethcore.Bloom{}, false, errorsmod.Wrapf(err, "GetBlockBloom %d", height)
+	}
+	if bz == nil {
+		return gethcore.Bloom{}, false, nil
+	}
+	return gethcore.BytesToBloom(bz), true, nil
+}
+
+// GetLogs returns the logs of the blocks in [from, to] that match the
+// addresses and the positional topics, with the same semantics as
+// "eth_getLogs". Only the blocks that the address and topic indexes point to
+// are read, and the bloom of each block is checked before decoding its logs.
+// It errors if more than "logLimit" logs match.
+func (kv *KVIndexer) GetLogs(
+	from, to int64, addresses []common.Address, topics [][]common.Hash, logLimit int,
+) ([]*gethcore.Log, error) {
+	heights, err := kv.candidateHeights(from, to, addresses, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []*gethcore.Log{}
+	for _, height := range heights {
+		bloom, found, err := kv.GetBlockBloom(height)
+		if err != nil {
+			return nil, err
+		}
+		if !found || !bloomMatches(bloom, addresses, topics) {
+			continue
+		}
+
+		blockLogs, err := kv.getBlockLogs(height)
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range blockLogs {
+			if !logMatches(log, addresses, topics) {
+				continue
+			}
+			if len(logs) == logLimit {
+				return nil, fmt.Errorf("query returned more than %d results", logLimit)
+			}
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+// candidateHeights returns the ordered block numbers in [from, to] that may
+// have matching logs. The most selective criteria is looked up in the index:
+// the addresses if any, else the first topic position with topics, else every
+// block with logs.
+func (kv *KVIndexer) candidateHeights(
+	from, to int64, addresses []common.Address, topics [][]common.Hash,
+) ([]int64, error) {
+	var prefixes [][]byte
+	switch {
+	case len(addresses) > 0:
+		for _, address := range addresses {
+			prefixes = append(prefixes, append([]byte{KeyPrefixLogAddress}, address.Bytes()...))
+		}
+	case firstTopicPosition(topics) >= 0:
+		position := firstTopicPosition(topics)
+		for _, topic := range topics[position] {
+			prefixes = append(prefixes, logTopicPrefix(position, topic))
+		}
+	default:
+		prefixes = [][]byte{{KeyPrefixBlockLogs}}
+	}
+
+	heightSet := make(map[int64]struct{})
+	for _, prefix := range prefixes {
+		start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...)
+		end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)
+		it, err := kv.db.Iterator(start, end)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "iterate log index")
+		}
+		for ; it.Valid(); it.Next() {
+			key := it.Key()
+			heightSet[int64(sdk.BigEndianToUint64(key[len(key)-8:]))] = struct{}{}
+		}
+		it.Close()
+	}
+
+	heights := make([]int64, 0, len(heightSet))
+	for height := range heightSet {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+// getBlockLogs returns the indexed logs of a block.
+func (kv *KVIndexer) getBlockLogs(height int64) ([]*gethcore.Log, error) {
+	bz, err := kv.db.Get(BlockLogsKey(height))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "getBlockLogs %d", height)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var logs []*evm.Log
+	if err := json.Unmarshal(bz, &logs); err != nil {
+		return nil, errorsmod.Wrapf(err, "getBlockLogs %d", height)
+	}
+	return evm.LogsToEthereum(logs), nil
+}
+
+// firstTopicPosition returns the first topic position with topics to match,
+// or -1 if every position is a wildcard.
+func firstTopicPosition(topics [][]common.Hash) int {
+	for position, sub := range topics {
+		if len(sub) > 0 {
+			return position
+		}
+	}
+	return -1
+}
+
+// bloomMatches returns false if the bloom proves that no log of the block
+// matches the addresses and topics.
+func bloomMatches(bloom gethcore.Bloom, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		var included bool
+		for _, address := range addresses {
+			if gethcore.BloomLookup(bloom, address) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+
+	for _, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		included := false
+		for _, topic := range sub {
+			if gethcore.BloomLookup(bloom, topic) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	return true
+}
+
+// logMatches returns true if the log was emitted by one of the addresses and
+// has one of the topics at each position. An empty list matches anything.
+func logMatches(log *gethcore.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		var found bool
+		for _, address := range addresses {
+			if log.Address == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		var found bool
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// BlockBloomKey returns the key for db entry: `block number -> block bloom`
+func BlockBloomKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockBloom}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// BlockLogsKey returns the key for db entry: `block number -> logs of the block`
+func BlockLogsKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockLogs}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64) []byte {
+	key := append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// LogTopicKey returns the key for db entry:
+// `(topic position, topic, block number) -> nil`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64) []byte {
+	return append(logTopicPrefix(position, topic), sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+func logTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...)
+}
+
+func parseBlockNumberFromBlockKey(key []byte) (int64, error) {
+	if len(key) != BlockKeyLength {
+		return 0, fmt.Errorf("wrong block key length, expect: %d, got: %d", BlockKeyLength, len(key))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), nil
+}
@@ -0,0 +1,175 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/eth/indexer"
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// txLogsResult returns a tx result with an eth tx log event for each log.
+func txLogsResult(t *testing.T, logs ...*evm.Log) *abci.ResponseDeliverTx {
+	event := abci.Event{Type: evm.EventTypeTxLog}
+	for _, log := range logs {
+		bz, err := json.Marshal(log)
+		require.NoError(t, err)
+		event.Attributes = append(event.Attributes, abci.EventAttribute{
+			Key: evm.AttributeKeyTxLog, Value: string(bz),
+		})
+	}
+	return &abci.ResponseDeliverTx{Events: []abci.Event{event}}
+}
+
+func TestKVIndexerLogs(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec)
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+
+	contractA := common.HexToAddress("0xA")
+	contractB := common.HexToAddress("0xB")
+	transfer := common.HexToHash("0x7")
+	approval := common.HexToHash("0x8")
+	alice := common.HexToHash("0xA11CE")
+
+	newLog := func(height uint64, index uint64, address common.Address, topics ...common.Hash) *evm.Log {
+		log := &evm.Log{
+			Address:     address.Hex(),
+			BlockNumber: height,
+			TxHash:      common.BytesToHash([]byte{byte(height), byte(index)}).Hex(),
+			Index:       index,
+		}
+		for _, topic := range topics {
+			log.Topics = append(log.Topics, topic.Hex())
+		}
+		return log
+	}
+
+	blockLogs := map[int64][]*evm.Log{
+		1: {newLog(1, 0, contractA, transfer, alice)},
+		2: {},
+		3: {newLog(3, 0, contractB, approval), newLog(3, 1, contractA, approval, alice)},
+		4: {newLog(4, 0, contractB, transfer)},
+	}
+	for height := int64(1); height <= 4; height++ {
+		block := &tmtypes.Block{
+			Header: tmtypes.Header{Height: height},
+			Data:   tmtypes.Data{Txs: []tmtypes.Tx{[]byte("not an eth tx")}},
+		}
+		results := []*abci.ResponseDeliverTx{txLogsResult(t, blockLogs[height]...)}
+		require.NoError(t, idxer.IndexBlock(block, results))
+	}
+
+	first, err := idxer.FirstLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err := idxer.LastLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), last)
+
+	gap, err := idxer.FirstLogGap(1, 4)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), gap, "the range is fully indexed")
+	gap, err = idxer.FirstLogGap(3, 6)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), gap)
+
+	_, found, err := idxer.GetBlockBloom(2)
+	require.NoError(t, err)
+	require.True(t, found, "blocks without logs are indexed too")
+	_, found, err = idxer.GetBlockBloom(5)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	for _, tc := range []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		// wantLogs: (height, log index) of each matching log
+		wantLogs [][2]uint64
+	}{
+		{
+			name:     "all logs",
+			from:     1,
+			to:       4,
+			wantLogs: [][2]uint64{{1, 0}, {3, 0}, {3, 1}, {4, 0}},
+		},
+		{
+			name:     "range",
+			from:     2,
+			to:       3,
+			wantLogs: [][2]uint64{{3, 0}, {3, 1}},
+		},
+		{
+			name:      "address",
+			from:      1,
+			to:        4,
+			addresses: []common.Address{contractA},
+			wantLogs:  [][2]uint64{{1, 0}, {3, 1}},
+		},
+		{
+			name:     "first topic",
+			from:     1,
+			to:       4,
+			topics:   [][]common.Hash{{transfer}},
+			wantLogs: [][2]uint64{{1, 0}, {4, 0}},
+		},
+		{
+			name:     "topic at second position with wildcard",
+			from:     1,
+			to:       4,
+			topics:   [][]common.Hash{{}, {alice}},
+			wantLogs: [][2]uint64{{1, 0}, {3, 1}},
+		},
+		{
+			name:      "address and topic",
+			from:      1,
+			to:        4,
+			addresses: []common.Address{contractA, contractB},
+			topics:    [][]common.Hash{{approval}},
+			wantLogs:  [][2]uint64{{3, 0}, {3, 1}},
+		},
+		{
+			name:     "topic at wrong position",
+			from:     1,
+			to:       4,
+			topics:   [][]common.Hash{{alice}},
+			wantLogs: [][2]uint64{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, 100)
+			require.NoError(t, err)
+			gotLogs := [][2]uint64{}
+			for _, log := range logs {
+				gotLogs = append(gotLogs, [2]uint64{log.BlockNumber, uint64(log.Index)})
+			}
+			require.Equal(t, tc.wantLogs, gotLogs)
+		})
+	}
+
+	_, err = idxer.GetLogs(1, 4, nil, nil, 3)
+	require.ErrorContains(t, err, "query returned more than 3 results")
+
+	t.Log("a block indexed out of order leaves a gap")
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 7}}
+	require.NoError(t, idxer.IndexBlock(block, nil))
+	last, err = idxer.LastLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(7), last)
+	gap, err = idxer.FirstLogGap(1, 7)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), gap)
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+
+// Package proof verifies the state proofs returned by the "eth_getProof"
+// JSON-RPC method of Nibiru against the app hash of a block.
+//
+// Nibiru does not keep EVM state in a Merkle-Patricia trie. Accounts, balances,
+// and contract storage live in IAVL stores of the Cosmos SDK multistore, so
+// "eth_getProof" returns ICS-23 commitment proofs instead of RLP-encoded trie
+// nodes. Each proof in the response is a list of hex-encoded
+// [ics23.CommitmentProof] protobufs, ordered from the leaf to the root:
+//
+//  1. An "ics23:iavl" proof of the key in the IAVL store of the module. It is
+//     an existence proof if the key is set and a non-existence proof otherwise.
+//  2. An "ics23:simple" proof of the root of that IAVL store in the multistore.
+//
+// The root computed by the last proof is the app hash committed at the queried
+// height. CometBFT includes that app hash in the header of the NEXT block, so
+// the proofs of "eth_getProof" at block N verify against "header(N+1).AppHash".
+//
+// The keys of each proof are not part of the response because a verifier can
+// derive them from the address and storage keys:
+//
+//   - "accountProof": key "0x01 ++ address" in the "acc" (x/auth) store. The
+//     value is the protobuf "Any" of the account, which holds the nonce and, for
+//     an [eth.EthAccount], the code hash.
+//   - "balanceProof": key "0x02 ++ len(address) ++ address ++ evmDenom" in the
+//     "bank" store. The value is the balance as a decimal-encoded [sdkmath.Int].
+//   - "storageProof[i].proof": key "0x02 ++ address ++ slot" in the "evm"
+//     store. The value is the 32-byte storage word.
+//
+// "storageHash" is always the zero hash since there is no per-account storage
+// trie.
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	cmtmerkle "github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/eth/rpc"
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// VerifyAccountResult verifies every proof of an "eth_getProof" response
+// against the app hash of a block: the nonce and code hash with the account
+// proof, the balance of "evmDenom" with the balance proof, and each storage
+// slot with its storage proof.
+func VerifyAccountResult(
+	cdc codec.BinaryCodec, res *rpc.AccountResult, evmDenom string, appHash []byte,
+) error {
+	if res == nil {
+		return fmt.Errorf("account result is nil")
+	}
+	if err := VerifyAccount(cdc, res, appHash); err != nil {
+		return err
+	}
+	if err := VerifyBalance(res, evmDenom, appHash); err != nil {
+		return err
+	}
+	for _, storage := range res.StorageProof {
+		if err := VerifyStorage(res.Address, storage, appHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyAccount verifies the nonce and code hash of "res" with its account
+// proof. An account that does not exist must have a zero nonce and the empty
+// code hash.
+func VerifyAccount(
+	cdc codec.BinaryCodec, res *rpc.AccountResult, appHash []byte,
+) error {
+	key := authtypes.AddressStoreKey(sdk.AccAddress(res.Address.Bytes()))
+	proofOps, err := DecodeHexProofs(res.AccountProof, authtypes.StoreKey, key)
+	if err != nil {
+		return fmt.Errorf("account proof: %w", err)
+	}
+
+	value, exists, err := provenValue(proofOps)
+	if err != nil {
+		return fmt.Errorf("account proof: %w", err)
+	}
+	keyPath := KeyPath(authtypes.StoreKey, key)
+	if !exists {
+		if err := proofRuntime.VerifyAbsence(proofOps, appHash, keyPath); err != nil {
+			return fmt.Errorf("account proof: %w", err)
+		}
+		if res.Nonce != 0 || res.CodeHash != common.BytesToHash(evm.EmptyCodeHash) {
+			return fmt.Errorf(
+				"account proof: account %s does not exist but has nonce %d and code hash %s",
+				res.Address.Hex(), res.Nonce, res.CodeHash.Hex(),
+			)
+		}
+		return nil
+	}
+
+	if err := proofRuntime.VerifyValue(proofOps, appHash, keyPath, value); err != nil {
+		return fmt.Errorf("account proof: %w", err)
+	}
+	var acc authtypes.AccountI
+	if err := cdc.UnmarshalInterface(value, &acc); err != nil {
+		return fmt.Errorf("account proof: failed to decode account: %w", err)
+	}
+
+	codeHash := common.BytesToHash(evm.EmptyCodeHash)
+	if ethAcc, ok := acc.(eth.EthAccountI); ok {
+		codeHash = ethAcc.GetCodeHash()
+	}
+	if uint64(res.Nonce) != acc.GetSequence() {
+		return fmt.Errorf(
+			"account proof: nonce mismatch: got %d, proven %d", res.Nonce, acc.GetSequence(),
+		)
+	}
+	if res.CodeHash != codeHash {
+		return fmt.Errorf(
+			"account proof: code hash mismatch: got %s, proven %s",
+			res.CodeHash.Hex(), codeHash.Hex(),
+		)
+	}
+	return nil
+}
+
+// VerifyBalance verifies the balance of "res" in "evmDenom" with its balance
+// proof. A zero balance is proven by absence since x/bank deletes zero
+// balances.
+func VerifyBalance(res *rpc.AccountResult, evmDenom string, appHash []byte) error {
+	key := BalanceStoreKey(res.Address, evmDenom)
+	proofOps, err := DecodeHexProofs(res.BalanceProof, banktypes.StoreKey, key)
+	if err != nil {
+		return fmt.Errorf("balance proof: %w", err)
+	}
+
+	balance := new(big.Int)
+	if res.Balance != nil {
+		balance = res.Balance.ToInt()
+	}
+	keyPath := KeyPath(banktypes.StoreKey, key)
+	if balance.Sign() == 0 {
+		err = proofRuntime.VerifyAbsence(proofOps, appHash, keyPath)
+	} else {
+		var value []byte
+		value, err = sdkmath.NewIntFromBigInt(balance).Marshal()
+		if err != nil {
+			return fmt.Errorf("balance proof: %w", err)
+		}
+		err = proofRuntime.VerifyValue(proofOps, appHash, keyPath, value)
+	}
+	if err != nil {
+		return fmt.Errorf("balance proof: %w", err)
+	}
+	return nil
+}
+
+// VerifyStorage verifies the value of a storage slot of the contract at
+// "address" with its storage proof. A zero value is proven by absence since
+// the EVM module deletes empty slots.
+func VerifyStorage(
+	address common.Address, storage rpc.StorageResult, appHash []byte,
+) error {
+	slot := common.HexToHash(storage.Key)
+	key := evm.StateKey(address, slot.Bytes())
+	proofOps, err := DecodeHexProofs(storage.Proof, evm.StoreKey, key)
+	if err != nil {
+		return fmt.Errorf("storage proof of slot %s: %w", storage.Key, err)
+	}
+
+	value := new(big.Int)
+	if storage.Value != nil {
+		value = storage.Value.ToInt()
+	}
+	keyPath := KeyPath(evm.StoreKey, key)
+	if value.Sign() == 0 {
+		err = proofRuntime.VerifyAbsence(proofOps, appHash, keyPath)
+	} else {
+		err = proofRuntime.VerifyValue(
+			proofOps, appHash, keyPath, common.BigToHash(value).Bytes(),
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("storage proof of slot %s: %w", storage.Key, err)
+	}
+	return nil
+}
+
+// BalanceStoreKey returns the key of the balance of "address" in "denom" in
+// the x/bank store.
+func BalanceStoreKey(address common.Address, denom string) []byte {
+	prefix := banktypes.CreateAccountBalancesPrefix(address.Bytes())
+	return append(prefix, []byte(denom)...)
+}
+
+// KeyPath returns the path of "key" in the store "storeName" of the
+// multistore, in the format of [cmtmerkle.KeyPath].
+func KeyPath(storeName string, key []byte) string {
+	return cmtmerkle.KeyPath{}.
+		AppendKey([]byte(storeName), cmtmerkle.KeyEncodingHex).
+		AppendKey(key, cmtmerkle.KeyEncodingHex).
+		String()
+}
+
+// DecodeHexProofs rebuilds the proof operations of "key" in the store
+// "storeName" from the hex-encoded proofs of an "eth_getProof" response.
+func DecodeHexProofs(
+	hexProofs []string, storeName string, key []byte,
+) (*cmtcrypto.ProofOps, error) {
+	if len(hexProofs) != 2 {
+		return nil, fmt.Errorf("expected 2 proofs (store and multistore), got %d", len(hexProofs))
+	}
+
+	opTypes := []string{storetypes.ProofOpIAVLCommitment, storetypes.ProofOpSimpleMerkleCommitment}
+	opKeys := [][]byte{key, []byte(storeName)}
+	proofOps := &cmtcrypto.ProofOps{}
+	for i, hexProof := range hexProofs {
+		data, err := hexutil.Decode(hexProof)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex proof at index %d: %w", i, err)
+		}
+		proofOps.Ops = append(proofOps.Ops, cmtcrypto.ProofOp{
+			Type: opTypes[i],
+			Key:  opKeys[i],
+			Data: data,
+		})
+	}
+	return proofOps, nil
+}
+
+// proofRuntime decodes and verifies the ICS-23 proofs of the multistore.
+var proofRuntime = rootmulti.DefaultProofRuntime()
+
+// provenValue returns the value in the existence proof of the store, or
+// "exists" false if it is a non-existence proof.
+func provenValue(proofOps *cmtcrypto.ProofOps) (value []byte, exists bool, err error) {
+	op, err := storetypes.CommitmentOpDecoder(proofOps.Ops[0])
+	if err != nil {
+		return nil, false, err
+	}
+	commitmentOp, ok := op.(storetypes.CommitmentOp)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected proof operator %T", op)
+	}
+	existProof := commitmentOp.Proof.GetExist()
+	if existProof == nil {
+		return nil, false, nil
+	}
+	if !bytes.Equal(existProof.Key, proofOps.Ops[0].Key) {
+		return nil, false, fmt.Errorf("existence proof is for a different key")
+	}
+	return existProof.Value, true, nil
+}
@@ -0,0 +1,187 @@
+package proof_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/eth/proof"
+	"github.com/NibiruChain/nibiru/eth/rpc"
+	"github.com/NibiruChain/nibiru/eth/rpc/backend"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+)
+
+const evmDenom = evm.DefaultEVMDenom
+
+// proofFixture: A committed multistore with the "acc", "bank", and "evm"
+// stores, from which it builds "eth_getProof" responses like the backend does.
+type proofFixture struct {
+	t   *testing.T
+	cdc codec.Codec
+	ms  *rootmulti.Store
+}
+
+func newProofFixture(t *testing.T) *proofFixture {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	eth.RegisterInterfaces(registry)
+
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	for _, name := range []string{authtypes.StoreKey, banktypes.StoreKey, evm.StoreKey} {
+		ms.MountStoreWithDB(storetypes.NewKVStoreKey(name), storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+	return &proofFixture{t: t, cdc: codec.NewProtoCodec(registry), ms: ms}
+}
+
+func (f *proofFixture) set(storeName string, key, value []byte) {
+	f.ms.GetKVStore(f.ms.StoreKeysByName()[storeName]).Set(key, value)
+}
+
+// commit returns the app hash that the proofs verify against.
+func (f *proofFixture) commit() []byte {
+	return f.ms.Commit().Hash
+}
+
+func (f *proofFixture) hexProofs(storeName string, key []byte) []string {
+	res := f.ms.Query(abci.RequestQuery{
+		Path:   "/" + storeName + "/key",
+		Data:   key,
+		Height: f.ms.LastCommitID().Version,
+		Prove:  true,
+	})
+	require.Zero(f.t, res.Code, res.Log)
+	return backend.GetHexProofs(res.ProofOps)
+}
+
+func (f *proofFixture) accountResult(
+	addr common.Address, nonce uint64, codeHash common.Hash, balance int64,
+	storage map[string]int64,
+) *rpc.AccountResult {
+	res := &rpc.AccountResult{
+		Address: addr,
+		AccountProof: f.hexProofs(
+			authtypes.StoreKey, authtypes.AddressStoreKey(sdk.AccAddress(addr.Bytes())),
+		),
+		Balance:      (*hexutil.Big)(big.NewInt(balance)),
+		BalanceProof: f.hexProofs(banktypes.StoreKey, proof.BalanceStoreKey(addr, evmDenom)),
+		CodeHash:     codeHash,
+		Nonce:        hexutil.Uint64(nonce),
+	}
+	for slot, value := range storage {
+		res.StorageProof = append(res.StorageProof, rpc.StorageResult{
+			Key:   slot,
+			Value: (*hexutil.Big)(big.NewInt(value)),
+			Proof: f.hexProofs(evm.StoreKey, evm.StateKey(addr, common.HexToHash(slot).Bytes())),
+		})
+	}
+	return res
+}
+
+func TestVerifyAccountResult(t *testing.T) {
+	f := newProofFixture(t)
+
+	contract := evmtest.NewEthAccInfo().EthAddr
+	codeHash := common.HexToHash("0xc0de")
+	acc := &eth.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(contract.Bytes()), nil, 7, 3),
+		CodeHash:    codeHash.Hex(),
+	}
+	accBz, err := f.cdc.MarshalInterface(acc)
+	require.NoError(t, err)
+	f.set(authtypes.StoreKey, authtypes.AddressStoreKey(sdk.AccAddress(contract.Bytes())), accBz)
+	balanceBz, err := sdkmath.NewInt(420).Marshal()
+	require.NoError(t, err)
+	f.set(banktypes.StoreKey, proof.BalanceStoreKey(contract, evmDenom), balanceBz)
+	f.set(evm.StoreKey, evm.StateKey(contract, common.HexToHash("0x1").Bytes()),
+		common.BigToHash(big.NewInt(69)).Bytes())
+	appHash := f.commit()
+
+	storage := map[string]int64{"0x1": 69, "0x2": 0}
+
+	t.Run("happy: existing contract, set and empty slots", func(t *testing.T) {
+		res := f.accountResult(contract, 3, codeHash, 420, storage)
+		require.NoError(t, proof.VerifyAccountResult(f.cdc, res, evmDenom, appHash))
+	})
+
+	t.Run("happy: account that does not exist", func(t *testing.T) {
+		res := f.accountResult(
+			evmtest.NewEthAccInfo().EthAddr, 0, common.BytesToHash(evm.EmptyCodeHash), 0, nil,
+		)
+		require.NoError(t, proof.VerifyAccountResult(f.cdc, res, evmDenom, appHash))
+	})
+
+	for _, tc := range []struct {
+		name    string
+		res     *rpc.AccountResult
+		appHash []byte
+		wantErr string
+	}{
+		{
+			name:    "sad: wrong nonce",
+			res:     f.accountResult(contract, 4, codeHash, 420, storage),
+			appHash: appHash,
+			wantErr: "nonce mismatch",
+		},
+		{
+			name:    "sad: wrong code hash",
+			res:     f.accountResult(contract, 3, common.HexToHash("0xbad"), 420, storage),
+			appHash: appHash,
+			wantErr: "code hash mismatch",
+		},
+		{
+			name:    "sad: wrong balance",
+			res:     f.accountResult(contract, 3, codeHash, 421, storage),
+			appHash: appHash,
+			wantErr: "balance proof",
+		},
+		{
+			name:    "sad: wrong storage value",
+			res:     f.accountResult(contract, 3, codeHash, 420, map[string]int64{"0x1": 70}),
+			appHash: appHash,
+			wantErr: "storage proof of slot 0x1",
+		},
+		{
+			name:    "sad: value claimed for an empty slot",
+			res:     f.accountResult(contract, 3, codeHash, 420, map[string]int64{"0x2": 1}),
+			appHash: appHash,
+			wantErr: "storage proof of slot 0x2",
+		},
+		{
+			name:    "sad: wrong app hash",
+			res:     f.accountResult(contract, 3, codeHash, 420, storage),
+			appHash: common.HexToHash("0xdead").Bytes(),
+			wantErr: "account proof",
+		},
+		{
+			name: "sad: missing proof",
+			res: &rpc.AccountResult{
+				Address:      contract,
+				AccountProof: []string{""},
+			},
+			appHash: appHash,
+			wantErr: "expected 2 proofs",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := proof.VerifyAccountResult(f.cdc, tc.res, evmDenom, tc.appHash)
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
@@ -12,10 +12,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 
+	ethproof "github.com/NibiruChain/nibiru/eth/proof"
 	"github.com/NibiruChain/nibiru/eth/rpc"
 	"github.com/NibiruChain/nibiru/x/evm"
 )
@@ -41,7 +43,9 @@ func (b *Backend) GetCode(
 	return res.Code, nil
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof and any storage proofs. The
+// proofs are ICS-23 proofs of the multistore that the "eth/proof" package
+// verifies, not Merkle-Patricia trie nodes.
 func (b *Backend) GetProof(
 	address common.Address,
 	storageKeys []string,
@@ -116,10 +120,22 @@ func (b *Backend) GetProof(
 		return nil, errors.New("invalid balance")
 	}
 
+	// query balance proof
+	paramsRes, err := b.queryClient.Params(ctx, &evm.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	balanceKey := ethproof.BalanceStoreKey(address, paramsRes.Params.EvmDenom)
+	_, balanceProof, err := b.queryClient.GetProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
 	return &rpc.AccountResult{
 		Address:      address,
 		AccountProof: GetHexProofs(proof),
 		Balance:      (*hexutil.Big)(balance.BigInt()),
+		BalanceProof: GetHexProofs(balanceProof),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
 		// NOTE: The StorageHash is blank. Consider whether this is useful in the
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"google.golang.org/grpc/metadata"
 
+	ethproof "github.com/NibiruChain/nibiru/eth/proof"
 	"github.com/NibiruChain/nibiru/eth/rpc"
 	"github.com/NibiruChain/nibiru/eth/rpc/backend/mocks"
 	"github.com/NibiruChain/nibiru/x/evm"
@@ -149,12 +150,21 @@ func (s *BackendSuite) TestGetProof() {
 					authtypes.AddressStoreKey(sdk.AccAddress(address1.Bytes())),
 					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterParamsWithoutHeader(queryClient, bn.Int64())
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/bank/key",
+					ethproof.BalanceStoreKey(address1, evm.DefaultEVMDenom),
+					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
 			},
 			true,
 			&rpc.AccountResult{
 				Address:      address1,
 				AccountProof: []string{""},
 				Balance:      (*hexutil.Big)(big.NewInt(0)),
+				BalanceProof: []string{""},
 				CodeHash:     common.HexToHash(""),
 				Nonce:        0x0,
 				StorageHash:  common.Hash{},
@@ -17,6 +17,7 @@ import (
 	params "github.com/ethereum/go-ethereum/params"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	lru "github.com/hashicorp/golang-lru"
 
 	"github.com/NibiruChain/nibiru/app/server/config"
 	"github.com/NibiruChain/nibiru/eth"
@@ -86,10 +87,10 @@ type EVMBackend interface {
 	// Chain Info
 	ChainID() (*hexutil.Big, error)
 	ChainConfig() *params.ChainConfig
-	// TODO: feat: Dynamic fees
 	BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error)
 	CurrentHeader() (*gethcore.Header, error)
 	PendingTransactions() ([]*sdk.Tx, error)
+	TxPoolContent() (pending, queued map[common.Address]map[uint64]*rpc.EthTxJsonRPC, err error)
 	FeeHistory(blockCount gethrpc.DecimalOrHex, lastBlock gethrpc.BlockNumber, rewardPercentiles []float64) (*rpc.FeeHistoryResult, error)
 	SuggestGasTipCap(baseFee *big.Int) (*big.Int, error)
 
@@ -99,6 +100,7 @@ type EVMBackend interface {
 	GetTxByTxIndex(height int64, txIndex uint) (*eth.TxResult, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpc.EthTxJsonRPC, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNrOrHash rpc.BlockNumberOrHash) ([]map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpc.EthTxJsonRPC, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpc.BlockNumber, idx hexutil.Uint) (*rpc.EthTxJsonRPC, error)
 
@@ -106,13 +108,22 @@ type EVMBackend interface {
 	Resend(args evm.JsonTxArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evm.JsonTxArgs) (evm.JsonTxArgs, error)
-	EstimateGas(args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evm.JsonTxArgs, blockNr rpc.BlockNumber) (*evm.MsgEthereumTxResponse, error)
+	EstimateGas(
+		args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber, overrides *rpc.StateOverride,
+	) (hexutil.Uint64, error)
+	DoCall(
+		args evm.JsonTxArgs, blockNr rpc.BlockNumber,
+		overrides *rpc.StateOverride, blockOverrides *rpc.BlockOverrides,
+	) (*evm.MsgEthereumTxResponse, error)
+	CreateAccessList(
+		args evm.JsonTxArgs, blockNrOrHash rpc.BlockNumberOrHash,
+	) (*rpc.AccessListResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*gethcore.Log, error)
 	GetLogsByHeight(height *int64) ([][]*gethcore.Log, error)
+	LogIndexer() eth.EVMLogIndexer
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
@@ -122,6 +133,12 @@ type EVMBackend interface {
 		config *evm.TraceConfig,
 		block *tmrpctypes.ResultBlock,
 	) ([]*evm.TxTraceResult, error)
+	TraceCall(
+		args evm.JsonTxArgs,
+		blockNrOrHash rpc.BlockNumberOrHash,
+		config *rpc.TraceCallConfig,
+	) (interface{}, error)
+	IntermediateRoots(block *tmrpctypes.ResultBlock) ([]common.Hash, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -136,6 +153,8 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             eth.EVMTxIndexer
+	feeCache            *lru.Cache // fee data of recent blocks, by height
+	gasTip              *gasTipCache
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -156,6 +175,11 @@ func NewBackend(
 		panic(err)
 	}
 
+	feeCache, err := lru.New(feeHistoryCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Backend{
 		ctx:                 context.Background(),
 		clientCtx:           clientCtx,
@@ -165,5 +189,7 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		feeCache:            feeCache,
+		gasTip:              &gasTipCache{},
 	}
 }
@@ -78,6 +78,7 @@ func (s *BackendSuite) SetupTest() {
 	clientCtx := client.Context{}.WithChainID(ChainID).
 		WithHeight(1).
 		WithTxConfig(encCfg.TxConfig).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
 		WithKeyringDir(clientDir).
 		WithKeyring(keyRing).
 		WithAccountRetriever(client.TestAccountRetriever{Accounts: accounts})
@@ -11,6 +11,7 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
@@ -350,20 +351,27 @@ func (b *Backend) HeaderByHash(blockHash common.Hash) (*gethcore.Header, error)
 	return ethHeader, nil
 }
 
-// BlockBloom query block bloom filter from block results
+// BlockBloom returns the bloom filter of the logs of a block. It reads the
+// [evm.EventBlockBloom] emitted by the EVM module at the end of the block, and
+// falls back to the bloom of the block's tx logs for blocks without it.
 func (b *Backend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (gethcore.Bloom, error) {
+	msgType := proto.MessageName(new(evm.EventBlockBloom))
 	for _, event := range blockRes.EndBlockEvents {
-		if event.Type != evm.EventTypeBlockBloom {
+		if event.Type != msgType {
 			continue
 		}
+		return evm.EventBlockBloomFromABCIEvent(event)
+	}
 
-		for _, attr := range event.Attributes {
-			if attr.Key == evm.AttributeKeyEthereumBloom {
-				return gethcore.BytesToBloom([]byte(attr.Value)), nil
-			}
-		}
+	blockLogs, err := GetLogsFromBlockResults(blockRes)
+	if err != nil {
+		return gethcore.Bloom{}, errors.Wrap(err, "failed to parse block logs")
+	}
+	var logs []*gethcore.Log
+	for _, txLogs := range blockLogs {
+		logs = append(logs, txLogs...)
 	}
-	return gethcore.Bloom{}, errors.New("block bloom event is not found")
+	return gethcore.BytesToBloom(gethcore.LogsBloom(logs)), nil
 }
 
 // RPCBlockFromTendermintBlock returns a JSON-RPC compatible Ethereum block from a
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 
@@ -10,6 +11,7 @@ import (
 	cmtrpc "github.com/cometbft/cometbft/rpc/core/types"
 	cmt "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
@@ -820,6 +822,17 @@ func (s *BackendSuite) TestBlockNumberFromTendermintByHash() {
 }
 
 func (s *BackendSuite) TestBlockBloom() {
+	bloom := gethcore.BytesToBloom([]byte{0x1, 0x2, 0x3})
+	bloomEvent, err := sdk.TypedEventToEvent(evm.NewEventBlockBloom(bloom))
+	s.Require().NoError(err)
+
+	txLog := &evm.Log{
+		Address: evmtest.NewEthAccInfo().EthAddr.Hex(),
+		Topics:  []string{common.HexToHash("0x1").Hex()},
+	}
+	txLogJSON, err := json.Marshal(txLog)
+	s.Require().NoError(err)
+
 	testCases := []struct {
 		name           string
 		blockRes       *cmtrpc.ResultBlockResults
@@ -827,27 +840,27 @@ func (s *BackendSuite) TestBlockBloom() {
 		wantPass       bool
 	}{
 		{
-			"fail - empty block result",
+			"pass - empty block result",
 			&cmtrpc.ResultBlockResults{},
 			gethcore.Bloom{},
-			false,
+			true,
 		},
 		{
-			"fail - non block bloom event type",
+			"pass - non block bloom event type",
 			&cmtrpc.ResultBlockResults{
 				EndBlockEvents: []types.Event{{Type: evm.EventTypeEthereumTx}},
 			},
 			gethcore.Bloom{},
-			false,
+			true,
 		},
 		{
-			"fail - nonblock bloom attribute key",
+			"fail - invalid block bloom event",
 			&cmtrpc.ResultBlockResults{
 				EndBlockEvents: []types.Event{
 					{
-						Type: evm.EventTypeBlockBloom,
+						Type: proto.MessageName(new(evm.EventBlockBloom)),
 						Attributes: []types.EventAttribute{
-							{Key: evm.AttributeKeyEthereumTxHash},
+							{Key: "bloom", Value: `"not hex"`},
 						},
 					},
 				},
@@ -856,18 +869,28 @@ func (s *BackendSuite) TestBlockBloom() {
 			false,
 		},
 		{
-			"pass - block bloom attribute key",
+			"pass - block bloom event",
 			&cmtrpc.ResultBlockResults{
-				EndBlockEvents: []types.Event{
-					{
-						Type: evm.EventTypeBlockBloom,
+				EndBlockEvents: []types.Event{types.Event(bloomEvent)},
+			},
+			bloom,
+			true,
+		},
+		{
+			"pass - no block bloom event, bloom of the tx logs",
+			&cmtrpc.ResultBlockResults{
+				TxsResults: []*types.ResponseDeliverTx{{
+					Events: []types.Event{{
+						Type: evm.EventTypeTxLog,
 						Attributes: []types.EventAttribute{
-							{Key: evm.AttributeKeyEthereumBloom},
+							{Key: evm.AttributeKeyTxLog, Value: string(txLogJSON)},
 						},
-					},
-				},
+					}},
+				}},
 			},
-			gethcore.Bloom{},
+			gethcore.BytesToBloom(gethcore.LogsBloom(
+				evm.LogsToEthereum([]*evm.Log{txLog}),
+			)),
 			true,
 		},
 	}
@@ -1579,14 +1602,6 @@ func (s *BackendSuite) TestEthBlockFromTendermintBlock() {
 			blockRes: &cmtrpc.ResultBlockResults{
 				Height:     1,
 				TxsResults: []*types.ResponseDeliverTx{{Code: 0, GasUsed: 0}},
-				EndBlockEvents: []types.Event{
-					{
-						Type: evm.EventTypeBlockBloom,
-						Attributes: []types.EventAttribute{
-							{Key: evm.AttributeKeyEthereumBloom},
-						},
-					},
-				},
 			},
 			registerMock: func(baseFee math.Int, blockNum int64) {
 				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
@@ -275,7 +275,7 @@ func (b *Backend) SetTxDefaults(args evm.JsonTxArgs) (evm.JsonTxArgs, error) {
 		}
 
 		blockNr := rpc.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil)
 		if err != nil {
 			return args, err
 		}
@@ -290,8 +290,13 @@ func (b *Backend) SetTxDefaults(args evm.JsonTxArgs) (evm.JsonTxArgs, error) {
 	return args, nil
 }
 
-// EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber) (hexutil.Uint64, error) {
+// EstimateGas returns an estimate of gas usage for the given smart contract
+// call, on the state with the optional state overrides applied.
+func (b *Backend) EstimateGas(
+	args evm.JsonTxArgs,
+	blockNrOptional *rpc.BlockNumber,
+	overrides *rpc.StateOverride,
+) (hexutil.Uint64, error) {
 	blockNr := rpc.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -301,6 +306,10 @@ func (b *Backend) EstimateGas(args evm.JsonTxArgs, blockNrOptional *rpc.BlockNum
 	if err != nil {
 		return 0, err
 	}
+	overridesBz, _, err := marshalOverrides(overrides, nil)
+	if err != nil {
+		return 0, err
+	}
 
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
@@ -313,6 +322,7 @@ func (b *Backend) EstimateGas(args evm.JsonTxArgs, blockNrOptional *rpc.BlockNum
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		StateOverrides:  overridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -326,14 +336,22 @@ func (b *Backend) EstimateGas(args evm.JsonTxArgs, blockNrOptional *rpc.BlockNum
 }
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
-// estimated gas used on the operation or an error if fails.
+// estimated gas used on the operation or an error if fails. The optional state
+// and block overrides only apply to the call.
 func (b *Backend) DoCall(
-	args evm.JsonTxArgs, blockNr rpc.BlockNumber,
+	args evm.JsonTxArgs,
+	blockNr rpc.BlockNumber,
+	overrides *rpc.StateOverride,
+	blockOverrides *rpc.BlockOverrides,
 ) (*evm.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
+	overridesBz, blockOverridesBz, err := marshalOverrides(overrides, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -345,6 +363,8 @@ func (b *Backend) DoCall(
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		StateOverrides:  overridesBz,
+		BlockOverrides:  blockOverridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -381,6 +401,69 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// CreateAccessList returns the EIP-2930 access list of a call on the state at
+// the given block, with the gas the call uses when it sends that list.
+func (b *Backend) CreateAccessList(
+	args evm.JsonTxArgs, blockNrOrHash rpc.BlockNumberOrHash,
+) (*rpc.AccessListResult, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evm.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	res, err := b.queryClient.CreateAccessList(rpc.NewContextWithHeight(blockNr.Int64()), &req)
+	if err != nil {
+		return nil, err
+	}
+	accessList := res.AccessList.ToEthAccessList()
+	if *accessList == nil {
+		// geth returns an empty list, not null
+		*accessList = gethcore.AccessList{}
+	}
+	return &rpc.AccessListResult{
+		AccessList: accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// marshalOverrides encodes the optional state and block overrides of a call
+// as the JSON of the EVM query requests. Absent overrides encode as nil.
+func marshalOverrides(
+	overrides *rpc.StateOverride, blockOverrides *rpc.BlockOverrides,
+) (overridesBz, blockOverridesBz []byte, err error) {
+	if overrides != nil {
+		if overridesBz, err = json.Marshal(overrides); err != nil {
+			return nil, nil, err
+		}
+	}
+	if blockOverrides != nil {
+		if blockOverridesBz, err = json.Marshal(blockOverrides); err != nil {
+			return nil, nil, err
+		}
+	}
+	return overridesBz, blockOverridesBz, nil
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
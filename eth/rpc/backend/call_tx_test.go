@@ -71,6 +71,7 @@ func (s *BackendSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
 				RegisterBaseFeeDisabled(queryClient)
+				RegisterConsensusParams(client, 1)
 			},
 			evm.JsonTxArgs{
 				Nonce:   &txNonce,
@@ -93,6 +94,7 @@ func (s *BackendSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterConsensusParams(client, 1)
 			},
 			evm.JsonTxArgs{
 				Nonce: &txNonce,
@@ -114,6 +116,7 @@ func (s *BackendSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
 				RegisterBaseFeeDisabled(queryClient)
+				RegisterConsensusParams(client, 1)
 			},
 			evm.JsonTxArgs{
 				Nonce:                &txNonce,
@@ -405,13 +408,26 @@ func (s *BackendSuite) TestDoCall() {
 	argsBz, err := json.Marshal(callArgs)
 	s.Require().NoError(err)
 
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	stateOverride := rpc.StateOverride{
+		toAddr: rpc.OverrideAccount{Balance: &balance},
+	}
+	stateOverrideBz, err := json.Marshal(stateOverride)
+	s.Require().NoError(err)
+	blockTime := hexutil.Uint64(1_700_000_000)
+	blockOverrides := rpc.BlockOverrides{Time: &blockTime}
+	blockOverridesBz, err := json.Marshal(blockOverrides)
+	s.Require().NoError(err)
+
 	testCases := []struct {
-		name         string
-		registerMock func()
-		blockNum     rpc.BlockNumber
-		callArgs     evm.JsonTxArgs
-		expEthTx     *evm.MsgEthereumTxResponse
-		expPass      bool
+		name           string
+		registerMock   func()
+		blockNum       rpc.BlockNumber
+		callArgs       evm.JsonTxArgs
+		overrides      *rpc.StateOverride
+		blockOverrides *rpc.BlockOverrides
+		expEthTx       *evm.MsgEthereumTxResponse
+		expPass        bool
 	}{
 		{
 			"fail - Invalid request",
@@ -424,6 +440,8 @@ func (s *BackendSuite) TestDoCall() {
 			},
 			rpc.BlockNumber(1),
 			callArgs,
+			nil,
+			nil,
 			&evm.MsgEthereumTxResponse{},
 			false,
 		},
@@ -438,6 +456,29 @@ func (s *BackendSuite) TestDoCall() {
 			},
 			rpc.BlockNumber(1),
 			callArgs,
+			nil,
+			nil,
+			&evm.MsgEthereumTxResponse{},
+			true,
+		},
+		{
+			"pass - Overrides are passed to the query",
+			func() {
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				s.Require().NoError(err)
+				RegisterEthCall(queryClient, &evm.EthCallRequest{
+					Args:           argsBz,
+					ChainId:        s.backend.chainID.Int64(),
+					StateOverrides: stateOverrideBz,
+					BlockOverrides: blockOverridesBz,
+				})
+			},
+			rpc.BlockNumber(1),
+			callArgs,
+			&stateOverride,
+			&blockOverrides,
 			&evm.MsgEthereumTxResponse{},
 			true,
 		},
@@ -448,7 +489,9 @@ func (s *BackendSuite) TestDoCall() {
 			s.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := s.backend.DoCall(
+				tc.callArgs, tc.blockNum, tc.overrides, tc.blockOverrides,
+			)
 
 			if tc.expPass {
 				s.Require().Equal(tc.expEthTx, msgEthTx)
@@ -459,6 +502,93 @@ func (s *BackendSuite) TestDoCall() {
 	}
 }
 
+func (s *BackendSuite) TestCreateAccessList() {
+	_, bz := s.buildEthereumTx()
+	toAddr := evmtest.NewEthAccInfo().EthAddr
+	chainID := (*hexutil.Big)(s.backend.chainID)
+	callArgs := evm.JsonTxArgs{To: &toAddr, ChainID: chainID}
+	blockNr := rpc.BlockNumber(1)
+	slot := common.BigToHash(big.NewInt(1))
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpc.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - empty access list",
+			func() {
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				s.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, callArgs, &evm.QueryCreateAccessListResponse{
+					GasUsed: 21000,
+				})
+			},
+			&rpc.AccessListResult{
+				AccessList: &gethcore.AccessList{},
+				GasUsed:    21000,
+			},
+			true,
+		},
+		{
+			"pass - access list and vm error",
+			func() {
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				s.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, callArgs, &evm.QueryCreateAccessListResponse{
+					AccessList: evm.AccessList{{
+						Address:     toAddr.Hex(),
+						StorageKeys: []string{slot.Hex()},
+					}},
+					GasUsed: 30000,
+					VmError: "execution reverted",
+				})
+			},
+			&rpc.AccessListResult{
+				AccessList: &gethcore.AccessList{{
+					Address:     toAddr,
+					StorageKeys: []common.Hash{slot},
+				}},
+				Error:   "execution reverted",
+				GasUsed: 30000,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := s.backend.CreateAccessList(
+				callArgs, rpc.BlockNumberOrHash{BlockNumber: &blockNr},
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *BackendSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
@@ -480,6 +610,7 @@ func (s *BackendSuite) TestGasPrice() {
 				_, err = RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
 				RegisterBaseFee(queryClient, math.NewInt(1))
+				RegisterConsensusParams(client, 1)
 			},
 			defaultGasPrice,
 			true,
@@ -4,6 +4,8 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"sort"
+	"sync"
 
 	sdkmath "cosmossdk.io/math"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -50,19 +52,33 @@ func (b *Backend) ChainConfig() *params.ChainConfig {
 	return evm.EthereumConfig(b.chainID)
 }
 
-// BaseFee returns the base fee tracked by the Fee Market module.
-// If the base fee is not enabled globally, the query returns nil.
+// BaseFee returns the EIP-1559 base fee of the given block. If the state of
+// the block is pruned, the base fee is read from the "fee_market" event that
+// the EVM module emits at the beginning of each block. It returns nil if
+// neither is available.
 func (b *Backend) BaseFee(
 	blockRes *tmrpctypes.ResultBlockResults,
 ) (baseFee *big.Int, err error) {
-	// return BaseFee if feemarket is enabled
 	res, err := b.queryClient.BaseFee(rpc.NewContextWithHeight(blockRes.Height), &evm.QueryBaseFeeRequest{})
-	if err != nil || res.BaseFee == nil {
-		baseFee = nil
-		// TODO: feat: dynamic fee handling on events
-		return baseFee, nil
+	if err == nil && res.BaseFee != nil {
+		return res.BaseFee.BigInt(), nil
 	}
-	return res.BaseFee.BigInt(), nil
+
+	for _, event := range blockRes.BeginBlockEvents {
+		if event.Type != evm.EventTypeFeeMarket {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evm.AttributeKeyBaseFee {
+				continue
+			}
+			if baseFee, ok := new(big.Int).SetString(attr.Value, 10); ok {
+				return baseFee, nil
+			}
+		}
+		break
+	}
+	return nil, nil
 }
 
 // CurrentHeader returns the latest block header
@@ -97,108 +113,205 @@ func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 	return result, nil
 }
 
-// FeeHistory returns data relevant for fee estimation based on the specified range of blocks.
+const (
+	// feeHistoryCacheSize is the number of blocks whose fee data is cached.
+	feeHistoryCacheSize = 2048
+
+	// gasTipCheckBlocks is the number of recent blocks sampled by
+	// "SuggestGasTipCap".
+	gasTipCheckBlocks = 20
+	// gasTipSampleNumber is the number of the lowest tips sampled per block.
+	gasTipSampleNumber = 3
+	// gasTipPercentile is the percentile of the sampled tips that is suggested.
+	gasTipPercentile = 60
+)
+
+var (
+	// gasTipIgnorePrice is the tip below which txs are left out of the samples.
+	gasTipIgnorePrice = big.NewInt(2)
+	// gasTipMaxPrice is the maximum tip that is ever suggested: 500 gwei.
+	gasTipMaxPrice = big.NewInt(500 * params.GWei)
+)
+
+// gasTipCache holds the last tip suggested by "SuggestGasTipCap" and the block
+// it was computed at.
+type gasTipCache struct {
+	sync.RWMutex
+	height int64
+	tip    *big.Int
+}
+
+// blockFeesAt returns the fee data of the block at the given height, from the
+// cache if possible.
+func (b *Backend) blockFeesAt(height int64) (*blockFees, error) {
+	if cached, ok := b.feeCache.Get(height); ok {
+		return cached.(*blockFees), nil
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(rpc.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", height, "error", err.Error())
+		return nil, err
+	}
+
+	fees, err := b.processBlock(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+	b.feeCache.Add(height, fees)
+	return fees, nil
+}
+
+// FeeHistory returns data relevant for fee estimation based on the specified
+// range of blocks: the base fee of each block and the block after the range,
+// the ratio of gas used to the gas limit, and the effective tips at the given
+// percentiles of the gas used by the EVM txs of each block.
 func (b *Backend) FeeHistory(
-	userBlockCount gethrpc.DecimalOrHex, // number blocks to fetch, maximum is 100
+	userBlockCount gethrpc.DecimalOrHex, // number blocks to fetch, maximum is RPCFeeHistoryCap
 	lastBlock gethrpc.BlockNumber, // the block to start search , to oldest
 	rewardPercentiles []float64, // percentiles to fetch reward
 ) (*rpc.FeeHistoryResult, error) {
-	blockEnd := int64(lastBlock) //#nosec G701 -- checked for int overflow already
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid reward percentile: %f", p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return nil, fmt.Errorf("invalid reward percentile: #%d:%f > #%d:%f", i-1, rewardPercentiles[i-1], i, p)
+		}
+	}
 
+	blockNumber, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	head := int64(blockNumber) //#nosec G701 -- checked for int overflow already
+
+	blockEnd := int64(lastBlock) //#nosec G701 -- checked for int overflow already
 	if blockEnd < 0 {
-		blockNumber, err := b.BlockNumber()
-		if err != nil {
-			return nil, err
-		}
-		blockEnd = int64(blockNumber) //#nosec G701 -- checked for int overflow already
+		blockEnd = head
+	}
+	if blockEnd > head {
+		return nil, fmt.Errorf("FeeHistory last block %d is beyond the head block %d", blockEnd, head)
 	}
 
-	blocks := int64(userBlockCount)                     // #nosec G701 -- checked for int overflow already
-	maxBlockCount := int64(b.cfg.JSONRPC.FeeHistoryCap) // #nosec G701 -- checked for int overflow already
+	blocks := int64(userBlockCount)              // #nosec G701 -- checked for int overflow already
+	maxBlockCount := int64(b.RPCFeeHistoryCap()) // #nosec G701 -- checked for int overflow already
 	if blocks > maxBlockCount {
 		return nil, fmt.Errorf("FeeHistory user block count %d higher than %d", blocks, maxBlockCount)
 	}
+	if blocks < 1 {
+		return &rpc.FeeHistoryResult{OldestBlock: (*hexutil.Big)(big.NewInt(0))}, nil
+	}
 
 	if blockEnd+1 < blocks {
 		blocks = blockEnd + 1
 	}
 	// Ensure not trying to retrieve before genesis.
 	blockStart := blockEnd + 1 - blocks
-	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
-	// prepare space
-	reward := make([][]*hexutil.Big, blocks)
-	rewardCount := len(rewardPercentiles)
-	for i := 0; i < int(blocks); i++ {
-		reward[i] = make([]*hexutil.Big, rewardCount)
+	baseFees := make([]*hexutil.Big, blocks+1)
+	gasUsedRatios := make([]float64, blocks)
+	var rewards [][]*hexutil.Big
+	if len(rewardPercentiles) != 0 {
+		rewards = make([][]*hexutil.Big, blocks)
 	}
 
-	thisBaseFee := make([]*hexutil.Big, blocks+1)
-	thisGasUsedRatio := make([]float64, blocks)
-
-	// rewards should only be calculated if reward percentiles were included
-	calculateRewards := rewardCount != 0
-
-	// fetch block
 	for blockID := blockStart; blockID <= blockEnd; blockID++ {
-		index := int32(blockID - blockStart) // #nosec G701
-		// tendermint block
-		tendermintblock, err := b.TendermintBlockByNumber(rpc.BlockNumber(blockID))
-		if tendermintblock == nil {
+		index := blockID - blockStart
+		fees, err := b.blockFeesAt(blockID)
+		if err != nil {
 			return nil, err
 		}
 
-		// eth block
-		ethBlock, err := b.GetBlockByNumber(rpc.BlockNumber(blockID), true)
-		if ethBlock == nil {
-			return nil, err
+		baseFees[index] = (*hexutil.Big)(fees.baseFee)
+		gasUsedRatios[index] = fees.gasUsedRatio
+		if rewards != nil {
+			blockRewards := fees.rewards(rewardPercentiles)
+			rewards[index] = make([]*hexutil.Big, len(blockRewards))
+			for j, reward := range blockRewards {
+				rewards[index][j] = (*hexutil.Big)(reward)
+			}
 		}
+	}
 
-		// tendermint block result
-		tendermintBlockResult, err := b.TendermintBlockResultByNumber(&tendermintblock.Block.Height)
-		if tendermintBlockResult == nil {
-			b.logger.Debug("block result not found", "height", tendermintblock.Block.Height, "error", err.Error())
+	// The base fee of the block after the range is read from that block if it
+	// is committed. Past the head block, the base fee of the head is used.
+	nextBaseFee := baseFees[blocks-1]
+	if blockEnd < head {
+		fees, err := b.blockFeesAt(blockEnd + 1)
+		if err != nil {
 			return nil, err
 		}
+		nextBaseFee = (*hexutil.Big)(fees.baseFee)
+	}
+	baseFees[blocks] = nextBaseFee
+
+	return &rpc.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:      baseFees,
+		GasUsedRatio: gasUsedRatios,
+		Reward:       rewards,
+	}, nil
+}
+
+// SuggestGasTipCap returns a tip for dynamic fee txs that is likely to be
+// accepted, the same way as the gas price oracle of geth: the lowest effective
+// tips of the recent blocks are sampled and the suggestion is a percentile of
+// them. Blocks without EVM txs sample the previous suggestion. The result is
+// cached until the next block. Effective tips are over the base fee of the
+// block that included them, so "baseFee" does not change the suggestion.
+func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
+	blockNumber, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	head := int64(blockNumber) //#nosec G701 -- checked for int overflow already
 
-		oneFeeHistory := rpc.OneFeeHistory{}
-		err = b.processBlock(tendermintblock, &ethBlock, rewardPercentiles, tendermintBlockResult, &oneFeeHistory)
+	b.gasTip.RLock()
+	lastHeight, lastTip := b.gasTip.height, b.gasTip.tip
+	b.gasTip.RUnlock()
+	if lastHeight == head && lastTip != nil {
+		return new(big.Int).Set(lastTip), nil
+	}
+	if lastTip == nil {
+		lastTip = big.NewInt(0)
+	}
+
+	var samples []*big.Int
+	for height := head; height > 0 && height > head-gasTipCheckBlocks; height-- {
+		fees, err := b.blockFeesAt(height)
 		if err != nil {
 			return nil, err
 		}
-
-		// copy
-		thisBaseFee[index] = (*hexutil.Big)(oneFeeHistory.BaseFee)
-		thisBaseFee[index+1] = (*hexutil.Big)(oneFeeHistory.NextBaseFee)
-		thisGasUsedRatio[index] = oneFeeHistory.GasUsedRatio
-		if calculateRewards {
-			for j := 0; j < rewardCount; j++ {
-				reward[index][j] = (*hexutil.Big)(oneFeeHistory.Reward[j])
-				if reward[index][j] == nil {
-					reward[index][j] = (*hexutil.Big)(big.NewInt(0))
-				}
-			}
+		tips := fees.lowestTips(gasTipSampleNumber, gasTipIgnorePrice)
+		if len(tips) == 0 {
+			tips = []*big.Int{lastTip}
 		}
+		samples = append(samples, tips...)
 	}
 
-	feeHistory := rpc.FeeHistoryResult{
-		OldestBlock:  oldestBlock,
-		BaseFee:      thisBaseFee,
-		GasUsedRatio: thisGasUsedRatio,
+	tip := lastTip
+	if len(samples) > 0 {
+		sort.Slice(samples, func(i, j int) bool { return samples[i].Cmp(samples[j]) < 0 })
+		tip = samples[(len(samples)-1)*gasTipPercentile/100]
 	}
-
-	if calculateRewards {
-		feeHistory.Reward = reward
+	if tip.Cmp(gasTipMaxPrice) > 0 {
+		tip = new(big.Int).Set(gasTipMaxPrice)
 	}
 
-	return &feeHistory, nil
-}
+	b.gasTip.Lock()
+	b.gasTip.height, b.gasTip.tip = head, tip
+	b.gasTip.Unlock()
 
-// SuggestGasTipCap: Not yet supported. Returns 0 as the suggested tip cap. After
-// implementing tx prioritization, this function can come to life.
-func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
-	maxDelta := big.NewInt(0)
-	return maxDelta, nil
+	return new(big.Int).Set(tip), nil
 }
 
 func DefaultMinGasPrice() sdkmath.LegacyDec { return sdkmath.LegacyZeroDec() }
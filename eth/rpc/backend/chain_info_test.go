@@ -5,13 +5,12 @@ import (
 	"math/big"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"google.golang.org/grpc/metadata"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
-
 	"github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
@@ -19,7 +18,6 @@ import (
 	"github.com/NibiruChain/nibiru/eth/rpc"
 	"github.com/NibiruChain/nibiru/eth/rpc/backend/mocks"
 	"github.com/NibiruChain/nibiru/x/evm"
-	evmtest "github.com/NibiruChain/nibiru/x/evm/evmtest"
 )
 
 func (s *BackendSuite) TestBaseFee() {
@@ -32,24 +30,33 @@ func (s *BackendSuite) TestBaseFee() {
 		expBaseFee   *big.Int
 		expPass      bool
 	}{
-		// TODO: test(eth): Test base fee query after it's enabled.
-		// {
-		// 	"fail - grpc BaseFee error",
-		// 	&tmrpctypes.ResultBlockResults{Height: 1},
-		// 	func() {
-		// 		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
-		// 		RegisterBaseFeeError(queryClient)
-		// 	},
-		// 	nil,
-		// 	false,
-		// },
+		{
+			name: "pass - grpc BaseFee error - base fee from the fee market event",
+			blockRes: &tmrpctypes.ResultBlockResults{
+				Height: 1,
+				BeginBlockEvents: []types.Event{
+					{
+						Type: evm.EventTypeFeeMarket,
+						Attributes: []types.EventAttribute{
+							{Key: evm.AttributeKeyBaseFee, Value: "1000"},
+						},
+					},
+				},
+			},
+			registerMock: func() {
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBaseFeeError(queryClient)
+			},
+			expBaseFee: big.NewInt(1000),
+			expPass:    true,
+		},
 		{
 			name: "pass - grpc BaseFee error - with non feemarket block event",
 			blockRes: &tmrpctypes.ResultBlockResults{
 				Height: 1,
 				BeginBlockEvents: []types.Event{
 					{
-						Type: evm.EventTypeBlockBloom,
+						Type: evm.EventTypeTxLog,
 					},
 				},
 			},
@@ -136,42 +143,110 @@ func (s *BackendSuite) TestChainId() {
 	}
 }
 
+// buildBlockWithEthTx returns a block with one EVM tx of the given gas price,
+// along with the results of the block.
+func (s *BackendSuite) buildBlockWithEthTx(
+	gasPrice *big.Int, gasUsed int64,
+) (txBz []byte, txResults []*types.ResponseDeliverTx) {
+	msgEthereumTx := evm.NewTx(&evm.EvmTxArgs{
+		ChainID:  s.backend.chainID,
+		Nonce:    0,
+		To:       &common.Address{},
+		Amount:   big.NewInt(0),
+		GasLimit: 100000,
+		GasPrice: gasPrice,
+	})
+	txBz = s.signAndEncodeEthTx(msgEthereumTx)
+	txResults = []*types.ResponseDeliverTx{{
+		Code:    0,
+		GasUsed: gasUsed,
+		Events: []types.Event{
+			{Type: evm.EventTypeEthereumTx, Attributes: []types.EventAttribute{
+				{Key: "ethereumTxHash", Value: msgEthereumTx.AsTransaction().Hash().Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "amount", Value: "0"},
+				{Key: "txGasUsed", Value: fmt.Sprint(gasUsed)},
+				{Key: "txHash", Value: ""},
+				{Key: "recipient", Value: common.Address{}.Hex()},
+			}},
+		},
+	}}
+	return txBz, txResults
+}
+
 func (s *BackendSuite) TestSuggestGasTipCap() {
 	testCases := []struct {
 		name         string
 		registerMock func()
-		baseFee      *big.Int
 		expGasTipCap *big.Int
 		expPass      bool
 	}{
 		{
-			"pass - Feemarket not enabled ",
-			func() {},
-			nil,
-			big.NewInt(0),
-			true,
+			name: "fail - can't get the head block",
+			registerMock: func() {
+				var header metadata.MD
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParamsError(queryClient, &header, 1)
+			},
+			expPass: false,
 		},
 		{
-			"pass - Gets the suggest gas tip cap ",
-			func() {},
-			nil,
-			big.NewInt(0),
-			true,
+			name: "pass - block without EVM txs",
+			registerMock: func() {
+				var header metadata.MD
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+				RegisterBaseFee(queryClient, sdkmath.NewInt(1))
+				RegisterConsensusParams(client, 1)
+			},
+			expGasTipCap: big.NewInt(0),
+			expPass:      true,
+		},
+		{
+			name: "pass - effective tip of the EVM txs",
+			registerMock: func() {
+				var header metadata.MD
+				txBz, txResults := s.buildBlockWithEthTx(big.NewInt(10), 21000)
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+				RegisterBlockResultsWithTxResults(client, 1, txResults)
+				RegisterBaseFee(queryClient, sdkmath.NewInt(1))
+				RegisterConsensusParams(client, 1)
+			},
+			// gas price 10 over a base fee of 1
+			expGasTipCap: big.NewInt(9),
+			expPass:      true,
 		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("case %s", tc.name), func() {
 			s.SetupTest() // reset test and queries
+			s.backend.indexer = nil
 			tc.registerMock()
 
-			maxDelta, err := s.backend.SuggestGasTipCap(tc.baseFee)
-
-			if tc.expPass {
-				s.Require().Equal(tc.expGasTipCap, maxDelta)
-			} else {
+			gasTipCap, err := s.backend.SuggestGasTipCap(nil)
+			if !tc.expPass {
 				s.Require().Error(err)
+				return
 			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expGasTipCap, gasTipCap)
+
+			// The suggestion is cached until the next block.
+			gasTipCap, err = s.backend.SuggestGasTipCap(nil)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expGasTipCap, gasTipCap)
+			client := s.backend.clientCtx.Client.(*mocks.Client)
+			client.AssertNumberOfCalls(s.T(), "Block", 1)
 		})
 	}
 }
@@ -179,90 +254,102 @@ func (s *BackendSuite) TestSuggestGasTipCap() {
 func (s *BackendSuite) TestFeeHistory() {
 	testCases := []struct {
 		name           string
-		registerMock   func(validator sdk.AccAddress)
+		registerMock   func()
 		userBlockCount ethrpc.DecimalOrHex
 		latestBlock    ethrpc.BlockNumber
+		percentiles    []float64
 		expFeeHistory  *rpc.FeeHistoryResult
-		validator      sdk.AccAddress
 		expPass        bool
 	}{
 		{
-			"fail - can't get params ",
-			func(validator sdk.AccAddress) {
+			name: "fail - can't get params ",
+			registerMock: func() {
 				var header metadata.MD
 				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				s.backend.cfg.JSONRPC.FeeHistoryCap = 0
 				RegisterParamsError(queryClient, &header, ethrpc.BlockNumber(1).Int64())
 			},
-			1,
-			-1,
-			nil,
-			nil,
-			false,
+			userBlockCount: 1,
+			latestBlock:    -1,
+			expPass:        false,
 		},
 		{
-			"fail - user block count higher than max block count ",
-			func(validator sdk.AccAddress) {
+			name: "fail - user block count higher than max block count ",
+			registerMock: func() {
 				var header metadata.MD
 				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				s.backend.cfg.JSONRPC.FeeHistoryCap = 0
 				RegisterParams(queryClient, &header, ethrpc.BlockNumber(1).Int64())
 			},
-			1,
-			-1,
-			nil,
-			nil,
-			false,
+			userBlockCount: 1,
+			latestBlock:    -1,
+			expPass:        false,
+		},
+		{
+			name:           "fail - invalid reward percentiles",
+			registerMock:   func() {},
+			userBlockCount: 1,
+			latestBlock:    1,
+			percentiles:    []float64{50, 25},
+			expPass:        false,
+		},
+		{
+			name: "fail - last block beyond the head block",
+			registerMock: func() {
+				var header metadata.MD
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+			},
+			userBlockCount: 1,
+			latestBlock:    2,
+			expPass:        false,
 		},
 		{
-			"fail - Tendermint block fetching error ",
-			func(validator sdk.AccAddress) {
+			name: "fail - Tendermint block fetching error ",
+			registerMock: func() {
+				var header metadata.MD
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := s.backend.clientCtx.Client.(*mocks.Client)
 				s.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterParams(queryClient, &header, 1)
 				RegisterBlockError(client, ethrpc.BlockNumber(1).Int64())
 			},
-			1,
-			1,
-			nil,
-			nil,
-			false,
+			userBlockCount: 1,
+			latestBlock:    1,
+			expPass:        false,
 		},
 		{
-			"fail - Eth block fetching error",
-			func(validator sdk.AccAddress) {
+			name: "fail - block results fetching error",
+			registerMock: func() {
+				var header metadata.MD
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := s.backend.clientCtx.Client.(*mocks.Client)
 				s.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterParams(queryClient, &header, 1)
 				_, err := RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				s.Require().NoError(err)
 				RegisterBlockResultsError(client, 1)
 			},
-			1,
-			1,
-			nil,
-			nil,
-			true,
+			userBlockCount: 1,
+			latestBlock:    1,
+			expPass:        false,
 		},
 		{
 			name: "pass - Valid FeeHistoryResults object",
-			registerMock: func(validator sdk.AccAddress) {
+			registerMock: func() {
+				var header metadata.MD
 				baseFee := sdkmath.NewInt(1)
 				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := s.backend.clientCtx.Client.(*mocks.Client)
 				s.backend.cfg.JSONRPC.FeeHistoryCap = 2
 				blockHeight := int64(1)
+				RegisterParams(queryClient, &header, blockHeight)
 				_, err := RegisterBlock(client, blockHeight, nil)
 				s.Require().NoError(err)
-
 				_, err = RegisterBlockResults(client, blockHeight)
 				s.Require().NoError(err)
-
 				RegisterBaseFee(queryClient, baseFee)
-				RegisterValidatorAccount(queryClient, validator)
 				RegisterConsensusParams(client, blockHeight)
-
-				header := new(metadata.MD)
-				RegisterParams(queryClient, header, blockHeight)
-				RegisterParamsWithoutHeader(queryClient, blockHeight)
 			},
 			userBlockCount: 1,
 			latestBlock:    1,
@@ -272,20 +359,50 @@ func (s *BackendSuite) TestFeeHistory() {
 				GasUsedRatio: []float64{0},
 				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))}},
 			},
-			validator: sdk.AccAddress(evmtest.NewEthAccInfo().EthAddr.Bytes()),
-			expPass:   true,
+			expPass: true,
+		},
+		{
+			name: "pass - rewards from the effective tips of the EVM txs",
+			registerMock: func() {
+				var header metadata.MD
+				txBz, txResults := s.buildBlockWithEthTx(big.NewInt(10), 21000)
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				s.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+				RegisterBlockResultsWithTxResults(client, 1, txResults)
+				RegisterBaseFee(queryClient, sdkmath.NewInt(1))
+				RegisterConsensusParams(client, 1)
+			},
+			userBlockCount: 1,
+			latestBlock:    -1,
+			percentiles:    []float64{50},
+			expFeeHistory: &rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))},
+				GasUsedRatio: []float64{21000 / float64(^uint32(0))},
+				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(9))}},
+			},
+			expPass: true,
 		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("case %s", tc.name), func() {
 			s.SetupTest() // reset test and queries
-			tc.registerMock(tc.validator)
+			s.backend.indexer = nil
+			tc.registerMock()
 
-			feeHistory, err := s.backend.FeeHistory(tc.userBlockCount, tc.latestBlock, []float64{25, 50, 75, 100})
+			percentiles := tc.percentiles
+			if percentiles == nil {
+				percentiles = []float64{25, 50, 75, 100}
+			}
+			feeHistory, err := s.backend.FeeHistory(tc.userBlockCount, tc.latestBlock, percentiles)
 			if tc.expPass {
 				s.Require().NoError(err)
-				s.Require().Equal(feeHistory, tc.expFeeHistory)
+				s.Require().Equal(tc.expFeeHistory, feeHistory)
 			} else {
 				s.Require().Error(err)
 			}
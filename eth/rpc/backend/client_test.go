@@ -212,6 +212,20 @@ func RegisterBlockResults(
 	return res, nil
 }
 
+func RegisterBlockResultsWithTxResults(
+	client *mocks.Client,
+	height int64,
+	txResults []*abci.ResponseDeliverTx,
+) *tmrpctypes.ResultBlockResults {
+	res := &tmrpctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: txResults,
+	}
+	client.On("BlockResults", rpc.NewContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(res, nil)
+	return res
+}
+
 func RegisterBlockResultsError(client *mocks.Client, height int64) {
 	client.On("BlockResults", rpc.NewContextWithHeight(height), mock.AnythingOfType("*int64")).
 		Return(nil, errortypes.ErrInvalidRequest)
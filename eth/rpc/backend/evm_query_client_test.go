@@ -79,6 +79,24 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(
+	queryClient *mocks.EVMQueryClient, matchReq func(*evm.QueryTraceCallRequest) bool,
+) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", rpc.NewContextWithHeight(1), mock.MatchedBy(matchReq)).
+		Return(&evm.QueryTraceCallResponse{Data: data}, nil)
+}
+
+// IntermediateRoots
+func RegisterIntermediateRoots(
+	queryClient *mocks.EVMQueryClient, txs []*evm.MsgEthereumTx, roots []string,
+) {
+	queryClient.On("IntermediateRoots", rpc.NewContextWithHeight(1),
+		&evm.QueryIntermediateRootsRequest{Txs: txs, BlockNumber: 1, ChainId: TEST_CHAIN_ID_NUMBER(), BlockMaxGas: -1}).
+		Return(&evm.QueryIntermediateRootsResponse{Roots: roots}, nil)
+}
+
 // Params
 func RegisterParams(
 	queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64,
@@ -187,6 +205,15 @@ func RegisterEstimateGas(
 		Return(&evm.EstimateGasResponse{}, nil)
 }
 
+// CreateAccessList
+func RegisterCreateAccessList(
+	queryClient *mocks.EVMQueryClient, args evm.JsonTxArgs, res *evm.QueryCreateAccessListResponse,
+) {
+	bz, _ := json.Marshal(args)
+	queryClient.On("CreateAccessList", rpc.NewContextWithHeight(1), &evm.EthCallRequest{Args: bz, ChainId: args.ChainID.ToInt().Int64()}).
+		Return(res, nil)
+}
+
 // BaseFee
 func RegisterBaseFee(
 	queryClient *mocks.EVMQueryClient, baseFee math.Int,
@@ -5,6 +5,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	"github.com/NibiruChain/nibiru/eth"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -30,6 +32,13 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*gethcore.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// LogIndexer returns the custom eth tx indexer if it also indexes the logs of
+// each block, and nil otherwise.
+func (b *Backend) LogIndexer() eth.EVMLogIndexer {
+	logIndexer, _ := b.indexer.(eth.EVMLogIndexer)
+	return logIndexer
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -137,6 +137,36 @@ func (_m *EVMQueryClient) Code(ctx context.Context, in *evm.QueryCodeRequest, op
 	return r0, r1
 }
 
+// CreateFunTokenFee provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) CreateFunTokenFee(ctx context.Context, in *evm.QueryCreateFunTokenFeeRequest, opts ...grpc.CallOption) (*evm.QueryCreateFunTokenFeeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *evm.QueryCreateFunTokenFeeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *evm.QueryCreateFunTokenFeeRequest, ...grpc.CallOption) *evm.QueryCreateFunTokenFeeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evm.QueryCreateFunTokenFeeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *evm.QueryCreateFunTokenFeeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NibiruAccount provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) NibiruAccount(ctx context.Context, in *evm.QueryNibiruAccountRequest, opts ...grpc.CallOption) (*evm.QueryNibiruAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -167,6 +197,36 @@ func (_m *EVMQueryClient) NibiruAccount(ctx context.Context, in *evm.QueryNibiru
 	return r0, r1
 }
 
+// CreateAccessList provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) CreateAccessList(ctx context.Context, in *evm.EthCallRequest, opts ...grpc.CallOption) (*evm.QueryCreateAccessListResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *evm.QueryCreateAccessListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *evm.EthCallRequest, ...grpc.CallOption) *evm.QueryCreateAccessListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evm.QueryCreateAccessListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *evm.EthCallRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EstimateGas provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) EstimateGas(ctx context.Context, in *evm.EthCallRequest, opts ...grpc.CallOption) (*evm.EstimateGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -227,6 +287,36 @@ func (_m *EVMQueryClient) EthCall(ctx context.Context, in *evm.EthCallRequest, o
 	return r0, r1
 }
 
+// IntermediateRoots provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) IntermediateRoots(ctx context.Context, in *evm.QueryIntermediateRootsRequest, opts ...grpc.CallOption) (*evm.QueryIntermediateRootsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *evm.QueryIntermediateRootsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *evm.QueryIntermediateRootsRequest, ...grpc.CallOption) *evm.QueryIntermediateRootsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evm.QueryIntermediateRootsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *evm.QueryIntermediateRootsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Params(ctx context.Context, in *evm.QueryParamsRequest, opts ...grpc.CallOption) (*evm.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -317,6 +407,36 @@ func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *evm.QueryTraceBloc
 	return r0, r1
 }
 
+// TraceCall provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) TraceCall(ctx context.Context, in *evm.QueryTraceCallRequest, opts ...grpc.CallOption) (*evm.QueryTraceCallResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *evm.QueryTraceCallResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *evm.QueryTraceCallRequest, ...grpc.CallOption) *evm.QueryTraceCallResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evm.QueryTraceCallResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *evm.QueryTraceCallRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TraceTx provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceTx(ctx context.Context, in *evm.QueryTraceTxRequest, opts ...grpc.CallOption) (*evm.QueryTraceTxResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -148,47 +148,115 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 		return []*evm.TxTraceResult{}, nil
 	}
 
-	txDecoder := b.clientCtx.TxConfig.TxDecoder()
+	txsMessages := b.blockEthMsgs(block)
+	ctxWithHeight := rpc.NewContextWithHeight(blockReplayContextHeight(height))
 
-	var txsMessages []*evm.MsgEthereumTx
-	for i, tx := range txs {
-		decodedTx, err := txDecoder(tx)
+	cp, err := b.blockConsensusParams(block)
+	if err != nil {
+		return nil, err
+	}
+
+	traceBlockRequest := &evm.QueryTraceBlockRequest{
+		Txs:             txsMessages,
+		TraceConfig:     config,
+		BlockNumber:     block.Block.Height,
+		BlockTime:       block.Block.Time,
+		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedResults := make([]*evm.TxTraceResult, txsLength)
+	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+		return nil, err
+	}
+
+	return decodedResults, nil
+}
+
+// TraceCall traces a call built from tx args, as "eth_call" would run it, on
+// the state at the end of the given block. The state and block overrides of
+// the config are applied before the call and are not persisted.
+func (b *Backend) TraceCall(
+	args evm.JsonTxArgs,
+	blockNrOrHash rpc.BlockNumberOrHash,
+	config *rpc.TraceCallConfig,
+) (interface{}, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+	cp, err := b.blockConsensusParams(block)
+	if err != nil {
+		return nil, err
+	}
+
+	argsBz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	req := &evm.QueryTraceCallRequest{
+		Args:            argsBz,
+		GasCap:          b.RPCGasCap(),
+		BlockNumber:     block.Block.Height,
+		BlockTime:       block.Block.Time,
+		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+	if config != nil {
+		req.TraceConfig = &config.TraceConfig
+		req.StateOverrides, req.BlockOverrides, err = marshalOverrides(
+			config.StateOverrides, config.BlockOverrides,
+		)
 		if err != nil {
-			b.logger.Error("failed to decode transaction", "hash", txs[i].Hash(), "error", err.Error())
-			continue
+			return nil, err
 		}
+	}
 
-		for _, msg := range decodedTx.GetMsgs() {
-			ethMessage, ok := msg.(*evm.MsgEthereumTx)
-			if !ok {
-				// Just considers Ethereum transactions
-				continue
-			}
-			txsMessages = append(txsMessages, ethMessage)
-		}
+	// The call runs on the state at the end of the block, like "eth_call".
+	res, err := b.queryClient.TraceCall(rpc.NewContextWithHeight(block.Block.Height), req)
+	if err != nil {
+		return nil, err
 	}
 
-	// minus one to get the context at the beginning of the block
-	contextHeight := height - 1
-	if contextHeight < 1 {
-		// 0 is a special value for `ContextWithHeight`.
-		contextHeight = 1
+	var decodedResult interface{}
+	if err := json.Unmarshal(res.Data, &decodedResult); err != nil {
+		return nil, err
 	}
-	ctxWithHeight := rpc.NewContextWithHeight(int64(contextHeight))
+	return decodedResult, nil
+}
 
-	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
-	if !ok {
-		return nil, errors.New("invalid rpc client")
+// IntermediateRoots replays the EVM transactions of a block and returns the
+// state commitment after each of them, in order. A transaction that fails to
+// apply is logged and keeps the root of the previous transaction.
+func (b *Backend) IntermediateRoots(
+	block *tmrpctypes.ResultBlock,
+) ([]common.Hash, error) {
+	txsMessages := b.blockEthMsgs(block)
+	if len(txsMessages) == 0 {
+		return []common.Hash{}, nil
 	}
 
-	cp, err := nc.ConsensusParams(b.ctx, &block.Block.Height)
+	cp, err := b.blockConsensusParams(block)
 	if err != nil {
 		return nil, err
 	}
 
-	traceBlockRequest := &evm.QueryTraceBlockRequest{
+	req := &evm.QueryIntermediateRootsRequest{
 		Txs:             txsMessages,
-		TraceConfig:     config,
 		BlockNumber:     block.Block.Height,
 		BlockTime:       block.Block.Time,
 		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
@@ -196,16 +264,70 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 		ChainId:         b.chainID.Int64(),
 		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
 	}
-
-	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
+	ctxWithHeight := rpc.NewContextWithHeight(
+		blockReplayContextHeight(rpc.BlockNumber(block.Block.Height)),
+	)
+	res, err := b.queryClient.IntermediateRoots(ctxWithHeight, req)
 	if err != nil {
 		return nil, err
 	}
 
-	decodedResults := make([]*evm.TxTraceResult, txsLength)
-	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
-		return nil, err
+	roots := make([]common.Hash, len(res.Roots))
+	for i, root := range res.Roots {
+		roots[i] = common.HexToHash(root)
+		if i < len(res.Errors) && res.Errors[i] != "" {
+			b.logger.Debug("intermediate roots: tx failed",
+				"height", block.Block.Height, "index", i, "error", res.Errors[i])
+		}
 	}
+	return roots, nil
+}
 
-	return decodedResults, nil
+// blockEthMsgs returns the Ethereum tx messages of a block, in order. Txs that
+// fail to decode and non-Ethereum messages are skipped.
+func (b *Backend) blockEthMsgs(block *tmrpctypes.ResultBlock) []*evm.MsgEthereumTx {
+	txs := block.Block.Txs
+	txDecoder := b.clientCtx.TxConfig.TxDecoder()
+
+	var txsMessages []*evm.MsgEthereumTx
+	for i, tx := range txs {
+		decodedTx, err := txDecoder(tx)
+		if err != nil {
+			b.logger.Error("failed to decode transaction", "hash", txs[i].Hash(), "error", err.Error())
+			continue
+		}
+
+		for _, msg := range decodedTx.GetMsgs() {
+			ethMessage, ok := msg.(*evm.MsgEthereumTx)
+			if !ok {
+				// Just considers Ethereum transactions
+				continue
+			}
+			txsMessages = append(txsMessages, ethMessage)
+		}
+	}
+	return txsMessages
+}
+
+// blockConsensusParams returns the consensus params at the height of a block.
+func (b *Backend) blockConsensusParams(
+	block *tmrpctypes.ResultBlock,
+) (*tmrpctypes.ResultConsensusParams, error) {
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+	return nc.ConsensusParams(b.ctx, &block.Block.Height)
+}
+
+// blockReplayContextHeight returns the height of the state that txs of the
+// block at "height" are replayed on.
+func blockReplayContextHeight(height rpc.BlockNumber) int64 {
+	// minus one to get the context at the beginning of the block
+	contextHeight := height - 1
+	if contextHeight < 1 {
+		// 0 is a special value for `ContextWithHeight`.
+		contextHeight = 1
+	}
+	return int64(contextHeight)
 }
@@ -1,7 +1,9 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -10,12 +12,15 @@ import (
 	"github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/NibiruChain/nibiru/eth/crypto/ethsecp256k1"
 	"github.com/NibiruChain/nibiru/eth/indexer"
+	"github.com/NibiruChain/nibiru/eth/rpc"
 	"github.com/NibiruChain/nibiru/eth/rpc/backend/mocks"
 	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
 )
 
 func (s *BackendSuite) TestTraceTransaction() {
@@ -263,3 +268,129 @@ func (s *BackendSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (s *BackendSuite) TestTraceCall() {
+	_, bz := s.buildEthereumTx()
+	toAddr := evmtest.NewEthAccInfo().EthAddr
+	callArgs := evm.JsonTxArgs{To: &toAddr}
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	blockNr := rpc.BlockNumber(1)
+	stateOverride := rpc.StateOverride{
+		toAddr: rpc.OverrideAccount{Balance: &balance},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *rpc.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - trace config and overrides are passed to the query",
+			func() {
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				s.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCall(queryClient, func(req *evm.QueryTraceCallRequest) bool {
+					var gotOverride rpc.StateOverride
+					return req.TraceConfig.Tracer == "callTracer" &&
+						json.Unmarshal(req.StateOverrides, &gotOverride) == nil &&
+						gotOverride[toAddr].Balance != nil &&
+						len(req.BlockOverrides) == 0
+				})
+			},
+			&rpc.TraceCallConfig{
+				TraceConfig:    evm.TraceConfig{Tracer: "callTracer"},
+				StateOverrides: &stateOverride,
+			},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := s.backend.TraceCall(
+				callArgs, rpc.BlockNumberOrHash{BlockNumber: &blockNr}, tc.config,
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *BackendSuite) TestIntermediateRoots() {
+	msgEthTx, bz := s.buildEthereumTx()
+	emptyBlock := types.MakeBlock(1, []types.Tx{}, nil, nil)
+	emptyBlock.ChainID = ChainID
+	filledBlock := types.MakeBlock(1, []types.Tx{bz}, nil, nil)
+	filledBlock.ChainID = ChainID
+	resBlockEmpty := tmrpctypes.ResultBlock{Block: emptyBlock, BlockID: emptyBlock.LastBlockID}
+	resBlockFilled := tmrpctypes.ResultBlock{Block: filledBlock, BlockID: filledBlock.LastBlockID}
+	root := common.BigToHash(big.NewInt(1))
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expRoots     []common.Hash
+		resBlock     *tmrpctypes.ResultBlock
+		expPass      bool
+	}{
+		{
+			"pass - no transaction returning empty array",
+			func() {},
+			[]common.Hash{},
+			&resBlockEmpty,
+			true,
+		},
+		{
+			"pass - one root per transaction",
+			func() {
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				RegisterIntermediateRoots(queryClient, []*evm.MsgEthereumTx{msgEthTx}, []string{root.Hex()})
+				RegisterConsensusParams(client, 1)
+			},
+			[]common.Hash{root},
+			&resBlockFilled,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			roots, err := s.backend.IntermediateRoots(tc.resBlock)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expRoots, roots)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
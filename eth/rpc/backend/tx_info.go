@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/eth/indexer"
 	"github.com/NibiruChain/nibiru/eth/rpc"
 	"github.com/NibiruChain/nibiru/x/evm"
 )
@@ -158,22 +159,147 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evm.MsgEthereumTx)
 
-	txData, err := evm.UnpackTxData(ethMsg.Data)
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+	chainID, err := b.ChainID()
 	if err != nil {
-		b.logger.Error("failed to unpack tx data", "error", err.Error())
 		return nil, err
 	}
 
-	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	var baseFee *big.Int
+	if ethMsg.AsTransaction().Type() == gethcore.DynamicFeeTxType {
+		if baseFee, err = b.BaseFee(blockRes); err != nil {
+			// tolerate the error for pruned node.
+			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
+		}
+	}
+
+	// Like in "GetBlockReceipts", the cumulative gas used only counts the EVM
+	// txs of the block.
+	var cumulativeGasUsed uint64
+	for _, ethTx := range b.blockEthTxs(resBlock, blockRes) {
+		cumulativeGasUsed += ethTx.Result.GasUsed
+		if ethTx.TxHash == hash {
+			break
+		}
+	}
+	return b.formatTxReceipt(ethMsg, res, resBlock, blockRes, chainID.ToInt(), baseFee, cumulativeGasUsed)
+}
+
+// GetBlockReceipts returns the receipts of all the EVM txs of a block, or nil
+// if the block is not found. Unlike one "GetTransactionReceipt" per tx, the
+// block and its results are only fetched once, and the results of the txs are
+// read from the tx indexer when it is enabled or else from the block results.
+func (b *Backend) GetBlockReceipts(
+	blockNrOrHash rpc.BlockNumberOrHash,
+) ([]map[string]interface{}, error) {
+	var (
+		resBlock *tmrpctypes.ResultBlock
+		err      error
+	)
+	if blockNrOrHash.BlockHash != nil {
+		resBlock, err = b.TendermintBlockByHash(*blockNrOrHash.BlockHash)
+	} else {
+		var blockNum rpc.BlockNumber
+		if blockNum, err = b.BlockNumberFromTendermint(blockNrOrHash); err != nil {
+			return nil, err
+		}
+		resBlock, err = b.TendermintBlockByNumber(blockNum)
+	}
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.logger.Debug("block not found", "block number or hash", blockNrOrHash)
+		return nil, nil
+	}
+
+	height := resBlock.Block.Height
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
 	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		b.logger.Debug("failed to retrieve block results", "height", height, "error", err.Error())
 		return nil, nil
 	}
-	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
-		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	ethTxs := b.blockEthTxs(resBlock, blockRes)
+	receipts := make([]map[string]interface{}, 0, len(ethTxs))
+	var (
+		baseFee        *big.Int
+		baseFeeFetched bool
+	)
+	var cumulativeGasUsed uint64
+	for i, ethTx := range ethTxs {
+		cumulativeGasUsed += ethTx.Result.GasUsed
+		if !baseFeeFetched && ethTx.Msg.AsTransaction().Type() == gethcore.DynamicFeeTxType {
+			if baseFee, err = b.BaseFee(blockRes); err != nil {
+				// tolerate the error for pruned node.
+				b.logger.Error("fetch basefee failed, node is pruned?", "height", height, "error", err)
+			}
+			baseFeeFetched = true
+		}
+		receipt, err := b.formatTxReceipt(
+			ethTx.Msg, ethTx.Result, resBlock, blockRes, chainID.ToInt(), baseFee, cumulativeGasUsed,
+		)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "receipt of tx %d in block %d", i, height)
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// blockEthTxs returns the EVM txs of a block and their results, in block
+// order. The results come from the tx indexer when it is enabled and has
+// indexed the block. Otherwise, they are parsed from the block results.
+func (b *Backend) blockEthTxs(
+	resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults,
+) []indexer.EthTxResult {
+	if b.indexer != nil {
+		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+		ethTxs := make([]indexer.EthTxResult, 0, len(msgs))
+		for _, msg := range msgs {
+			txHash := common.HexToHash(msg.Hash)
+			res, err := b.indexer.GetByTxHash(txHash)
+			if err != nil {
+				b.logger.Debug("tx not indexed", "hash", msg.Hash, "error", err.Error())
+				break
+			}
+			ethTxs = append(ethTxs, indexer.EthTxResult{TxHash: txHash, Msg: msg, Result: res})
+		}
+		if len(ethTxs) == len(msgs) {
+			return ethTxs
+		}
+	}
+	return indexer.ParseBlockEthTxs(
+		b.clientCtx.TxConfig.TxDecoder(), b.logger, resBlock.Block, blockRes.TxsResults,
+	)
+}
+
+// formatTxReceipt returns the receipt of an EVM tx, given its result and the
+// block that includes it. The base fee of the block is only used for dynamic
+// fee txs, and can be nil. "cumulativeGasUsed" is the gas used by the EVM txs
+// of the block up to and including this one.
+func (b *Backend) formatTxReceipt(
+	ethMsg *evm.MsgEthereumTx,
+	res *eth.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	chainID *big.Int,
+	baseFee *big.Int,
+	cumulativeGasUsed uint64,
+) (map[string]interface{}, error) {
+	hash := ethMsg.AsTransaction().Hash()
+	hexTx := hash.Hex()
+
+	txData, err := evm.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		b.logger.Error("failed to unpack tx data", "error", err.Error())
+		return nil, err
 	}
-	cumulativeGasUsed += res.CumulativeGasUsed
 
 	var status hexutil.Uint
 	if res.Failed {
@@ -181,12 +307,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	} else {
 		status = hexutil.Uint(gethcore.ReceiptStatusSuccessful)
 	}
-	chainID, err := b.ChainID()
-	if err != nil {
-		return nil, err
-	}
-
-	from, err := ethMsg.GetSender(chainID.ToInt())
+	from, err := ethMsg.GetSender(chainID)
 	if err != nil {
 		return nil, err
 	}
@@ -247,14 +368,8 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evm.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
-		if err != nil {
-			// tolerate the error for pruned node.
-			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
-		}
+	if dynamicTx, ok := txData.(*evm.DynamicFeeTx); ok && baseFee != nil {
+		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
 	}
 
 	return receipt, nil
@@ -10,8 +10,11 @@ import (
 	tmlog "github.com/cometbft/cometbft/libs/log"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/NibiruChain/nibiru/eth"
@@ -614,6 +617,115 @@ func (s *BackendSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (s *BackendSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	blockNr := rpc.BlockNumber(1)
+
+	// The block starts with a non-EVM tx, whose gas is not part of the
+	// cumulative gas used of the EVM receipts.
+	txBuilder := s.backend.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(&banktypes.MsgSend{
+		FromAddress: sdk.AccAddress(s.from.Bytes()).String(),
+		ToAddress:   sdk.AccAddress(s.from.Bytes()).String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(eth.EthBaseDenom, 1)),
+	}))
+	cosmosTxBz, err := s.backend.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	txs := []types.Tx{cosmosTxBz, txBz}
+	txResults := []*abci.ResponseDeliverTx{
+		{Code: 0, GasUsed: 50_000},
+		{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: evm.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: common.Address{}.Hex()},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		withIndexer  bool
+		registerMock func()
+		expReceipts  int
+	}{
+		{
+			name: "block not found",
+			registerMock: func() {
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			expReceipts: -1,
+		},
+		{
+			name:        "receipts from the block results",
+			withIndexer: false,
+			expReceipts: 1,
+		},
+		{
+			name:        "receipts from the tx indexer",
+			withIndexer: true,
+			expReceipts: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			if tc.registerMock != nil {
+				tc.registerMock()
+			} else {
+				var header metadata.MD
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := s.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				_, err := RegisterBlockMultipleTxs(client, 1, txs)
+				s.Require().NoError(err)
+				RegisterBlockResultsWithTxResults(client, 1, txResults)
+			}
+
+			s.backend.indexer = nil
+			if tc.withIndexer {
+				db := dbm.NewMemDB()
+				s.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), s.backend.clientCtx)
+				block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: txs}}
+				s.Require().NoError(s.backend.indexer.IndexBlock(block, txResults))
+			}
+
+			receipts, err := s.backend.GetBlockReceipts(rpc.BlockNumberOrHash{BlockNumber: &blockNr})
+			s.Require().NoError(err)
+			if tc.expReceipts < 0 {
+				s.Require().Nil(receipts)
+				return
+			}
+			s.Require().Len(receipts, tc.expReceipts)
+			receipt := receipts[0]
+			s.Equal(txHash, receipt["transactionHash"])
+			s.Equal(hexutil.Uint(gethcore.ReceiptStatusSuccessful), receipt["status"])
+			s.Equal(hexutil.Uint64(21000), receipt["gasUsed"])
+			s.Equal(hexutil.Uint64(21000), receipt["cumulativeGasUsed"])
+			s.Equal(hexutil.Uint64(0), receipt["transactionIndex"])
+			s.Equal(hexutil.Uint64(1), receipt["blockNumber"])
+
+			if tc.withIndexer {
+				txReceipt, err := s.backend.GetTransactionReceipt(txHash)
+				s.Require().NoError(err)
+				s.Equal(receipt, txReceipt)
+			}
+		})
+	}
+}
+
 func (s *BackendSuite) TestGetGasUsed() {
 	origin := s.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
@@ -0,0 +1,104 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package backend
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/eth/rpc"
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// TxPoolContent returns the Ethereum transactions in the CometBFT mempool,
+// grouped by sender and nonce.
+//
+// A transaction is "pending" if it is executable: its nonce continues the
+// sequence that starts at the committed nonce of the sender. Transactions
+// after a nonce gap are "queued". Transactions with a nonce below the
+// committed nonce are already included in a block and are skipped.
+func (b *Backend) TxPoolContent() (
+	pending, queued map[common.Address]map[uint64]*rpc.EthTxJsonRPC, err error,
+) {
+	pending = make(map[common.Address]map[uint64]*rpc.EthTxJsonRPC)
+	queued = make(map[common.Address]map[uint64]*rpc.EthTxJsonRPC)
+
+	txsBySender, err := b.pendingEthTxsBySender()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for sender, txsByNonce := range txsBySender {
+		nonces := make([]uint64, 0, len(txsByNonce))
+		for nonce := range txsByNonce {
+			nonces = append(nonces, nonce)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+		nextNonce, err := b.getAccountNonce(sender, false, 0, b.logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, nonce := range nonces {
+			tx := txsByNonce[nonce]
+			switch {
+			case nonce < nextNonce:
+				continue
+			case nonce == nextNonce:
+				addTxPoolEntry(pending, sender, nonce, tx)
+				nextNonce++
+			default:
+				addTxPoolEntry(queued, sender, nonce, tx)
+			}
+		}
+	}
+	return pending, queued, nil
+}
+
+// pendingEthTxsBySender decodes the Ethereum transactions of the mempool the
+// same way "PendingTransactions" does and groups them by sender and nonce.
+func (b *Backend) pendingEthTxsBySender() (
+	map[common.Address]map[uint64]*rpc.EthTxJsonRPC, error,
+) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	txsBySender := make(map[common.Address]map[uint64]*rpc.EthTxJsonRPC)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evm.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to get sender of pending tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			rpcTx, err := rpc.NewRPCTxFromMsg(
+				ethMsg, common.Hash{}, uint64(0), uint64(0), nil, b.chainID,
+			)
+			if err != nil {
+				return nil, err
+			}
+			addTxPoolEntry(txsBySender, sender, uint64(rpcTx.Nonce), rpcTx)
+		}
+	}
+	return txsBySender, nil
+}
+
+func addTxPoolEntry(
+	entries map[common.Address]map[uint64]*rpc.EthTxJsonRPC,
+	sender common.Address,
+	nonce uint64,
+	tx *rpc.EthTxJsonRPC,
+) {
+	if entries[sender] == nil {
+		entries[sender] = make(map[uint64]*rpc.EthTxJsonRPC)
+	}
+	entries[sender][nonce] = tx
+}
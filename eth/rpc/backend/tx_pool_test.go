@@ -0,0 +1,89 @@
+package backend
+
+import (
+	"math/big"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/eth/rpc/backend/mocks"
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// buildSignedEthTx returns an encoded Ethereum tx with the given nonce,
+// signed by the sender of the suite.
+func (s *BackendSuite) buildSignedEthTx(nonce uint64) []byte {
+	msgEthereumTx := evm.NewTx(&evm.EvmTxArgs{
+		ChainID:  s.backend.chainID,
+		Nonce:    nonce,
+		To:       &common.Address{},
+		Amount:   big.NewInt(0),
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1),
+	})
+	msgEthereumTx.From = s.from.Hex()
+	ethSigner := gethcore.LatestSignerForChainID(s.backend.chainID)
+	s.Require().NoError(msgEthereumTx.Sign(ethSigner, s.signer))
+
+	tx, err := msgEthereumTx.BuildTx(s.backend.clientCtx.TxConfig.NewTxBuilder(), eth.EthBaseDenom)
+	s.Require().NoError(err)
+	txBz, err := s.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+	return txBz
+}
+
+func (s *BackendSuite) TestTxPoolContent() {
+	s.Run("empty mempool", func() {
+		s.SetupTest()
+		client := s.backend.clientCtx.Client.(*mocks.Client)
+		RegisterUnconfirmedTxs(client, nil, []types.Tx{})
+
+		pending, queued, err := s.backend.TxPoolContent()
+		s.Require().NoError(err)
+		s.Empty(pending)
+		s.Empty(queued)
+	})
+
+	s.Run("split into pending and queued by the account nonce", func() {
+		s.SetupTest()
+		client := s.backend.clientCtx.Client.(*mocks.Client)
+		// The committed nonce of the sender is 1: nonce 0 is already in a
+		// block, nonces 1 and 2 are executable, and nonce 4 follows a gap.
+		RegisterUnconfirmedTxs(client, nil, []types.Tx{
+			s.buildSignedEthTx(0),
+			s.buildSignedEthTx(1),
+			s.buildSignedEthTx(2),
+			s.buildSignedEthTx(4),
+		})
+		reqBz, err := (&authtypes.QueryAccountRequest{
+			Address: sdk.AccAddress(s.from.Bytes()).String(),
+		}).Marshal()
+		s.Require().NoError(err)
+		RegisterABCIQueryAccount(client, reqBz, tmrpcclient.ABCIQueryOptions{Height: 1},
+			authtypes.NewBaseAccount(sdk.AccAddress(s.from.Bytes()), nil, 0, 1),
+		)
+
+		pending, queued, err := s.backend.TxPoolContent()
+		s.Require().NoError(err)
+		s.Require().Len(pending[s.from], 2)
+		s.Contains(pending[s.from], uint64(1))
+		s.Contains(pending[s.from], uint64(2))
+		s.Require().Len(queued[s.from], 1)
+		s.Contains(queued[s.from], uint64(4))
+		s.Equal(s.from, queued[s.from][4].From)
+	})
+
+	s.Run("fail - mempool error", func() {
+		s.SetupTest()
+		client := s.backend.clientCtx.Client.(*mocks.Client)
+		RegisterUnconfirmedTxsError(client, nil)
+
+		_, _, err := s.backend.TxPoolContent()
+		s.Require().Error(err)
+	})
+}
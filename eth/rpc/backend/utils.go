@@ -15,7 +15,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/consensus/misc"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -103,108 +102,109 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	return nonce, nil
 }
 
-// output: targetOneFeeHistory
+// blockFees is the fee data of a block that "FeeHistory" and the gas tip
+// oracle are derived from. Committed blocks never change, so it is cached.
+type blockFees struct {
+	baseFee      *big.Int
+	gasUsedRatio float64
+	// evmGasUsed is the gas used by the EVM txs of the block.
+	evmGasUsed uint64
+	// txs holds the gas used and effective tip of each EVM tx of the block,
+	// sorted by tip in ascending order.
+	txs sortGasAndReward
+}
+
+// processBlock computes the fee data of a block from the block and its
+// results. Each EVM tx contributes the gas it used and its effective tip over
+// the base fee of the block.
 func (b *Backend) processBlock(
-	tendermintBlock *tmrpctypes.ResultBlock,
-	ethBlock *map[string]interface{},
-	rewardPercentiles []float64,
-	tendermintBlockResult *tmrpctypes.ResultBlockResults,
-	targetOneFeeHistory *rpc.OneFeeHistory,
-) error {
-	blockHeight := tendermintBlock.Block.Height
-	blockBaseFee, err := b.BaseFee(tendermintBlockResult)
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) (*blockFees, error) {
+	blockHeight := resBlock.Block.Height
+	baseFee, err := b.BaseFee(blockRes)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if baseFee == nil {
+		baseFee = big.NewInt(0)
 	}
 
-	// set basefee
-	targetOneFeeHistory.BaseFee = blockBaseFee
-	cfg := b.ChainConfig()
-	header, err := b.CurrentHeader()
+	gasLimit, err := rpc.BlockMaxGasFromConsensusParams(
+		rpc.NewContextWithHeight(blockHeight), b.clientCtx, blockHeight,
+	)
 	if err != nil {
-		return err
+		b.logger.Error("failed to query consensus params", "error", err.Error())
 	}
-	targetOneFeeHistory.NextBaseFee = misc.CalcBaseFee(cfg, header)
-
-	// set gas used ratio
-	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
-	if !ok {
-		return fmt.Errorf("invalid gas limit type: %T", (*ethBlock)["gasLimit"])
+	if gasLimit <= 0 {
+		return nil, fmt.Errorf("gasLimit of block height %d should be bigger than 0, current gaslimit %d", blockHeight, gasLimit)
 	}
 
-	gasUsedBig, ok := (*ethBlock)["gasUsed"].(*hexutil.Big)
-	if !ok {
-		return fmt.Errorf("invalid gas used type: %T", (*ethBlock)["gasUsed"])
+	gasUsed := uint64(0)
+	for _, txsResult := range blockRes.TxsResults {
+		// workaround for cosmos-sdk bug. https://github.com/cosmos/cosmos-sdk/issues/10832
+		if ShouldIgnoreGasUsed(txsResult) {
+			break
+		}
+		gasUsed += uint64(txsResult.GetGasUsed()) // #nosec G701 -- checked for int overflow already
 	}
 
-	gasusedfloat, _ := new(big.Float).SetInt(gasUsedBig.ToInt()).Float64()
-
-	if gasLimitUint64 <= 0 {
-		return fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", blockHeight, gasLimitUint64)
+	fees := &blockFees{
+		baseFee:      baseFee,
+		gasUsedRatio: float64(gasUsed) / float64(gasLimit),
 	}
-
-	gasUsedRatio := gasusedfloat / float64(gasLimitUint64)
-	blockGasUsed := gasusedfloat
-	targetOneFeeHistory.GasUsedRatio = gasUsedRatio
-
-	rewardCount := len(rewardPercentiles)
-	targetOneFeeHistory.Reward = make([]*big.Int, rewardCount)
-	for i := 0; i < rewardCount; i++ {
-		targetOneFeeHistory.Reward[i] = big.NewInt(0)
-	}
-
-	// check tendermintTxs
-	tendermintTxs := tendermintBlock.Block.Txs
-	tendermintTxResults := tendermintBlockResult.TxsResults
-	tendermintTxCount := len(tendermintTxs)
-
-	var sorter sortGasAndReward
-
-	for i := 0; i < tendermintTxCount; i++ {
-		eachTendermintTx := tendermintTxs[i]
-		eachTendermintTxResult := tendermintTxResults[i]
-
-		tx, err := b.clientCtx.TxConfig.TxDecoder()(eachTendermintTx)
+	for _, ethTx := range b.blockEthTxs(resBlock, blockRes) {
+		tip, err := ethTx.Msg.AsTransaction().EffectiveGasTip(baseFee)
 		if err != nil {
-			b.logger.Debug("failed to decode transaction in block", "height", blockHeight, "error", err.Error())
-			continue
-		}
-		txGasUsed := uint64(eachTendermintTxResult.GasUsed) // #nosec G701
-		for _, msg := range tx.GetMsgs() {
-			ethMsg, ok := msg.(*evm.MsgEthereumTx)
-			if !ok {
-				continue
-			}
-			tx := ethMsg.AsTransaction()
-			reward := tx.EffectiveGasTipValue(blockBaseFee)
-			if reward == nil {
-				reward = big.NewInt(0)
-			}
-			sorter = append(sorter, txGasAndReward{gasUsed: txGasUsed, reward: reward})
+			// the fee cap is below the base fee, so nothing is left for a tip
+			tip = big.NewInt(0)
 		}
+		fees.evmGasUsed += ethTx.Result.GasUsed
+		fees.txs = append(fees.txs, txGasAndReward{gasUsed: ethTx.Result.GasUsed, reward: tip})
 	}
+	sort.Stable(fees.txs)
 
-	// return an all zero row if there are no transactions to gather data from
-	ethTxCount := len(sorter)
-	if ethTxCount == 0 {
-		return nil
-	}
+	return fees, nil
+}
 
-	sort.Sort(sorter)
+// rewards returns the effective tip at each of the given percentiles of the
+// gas used by the EVM txs of the block, or zeros for a block without EVM txs.
+func (fees *blockFees) rewards(percentiles []float64) []*big.Int {
+	rewards := make([]*big.Int, len(percentiles))
+	if len(fees.txs) == 0 {
+		for i := range rewards {
+			rewards[i] = big.NewInt(0)
+		}
+		return rewards
+	}
 
 	var txIndex int
-	sumGasUsed := sorter[0].gasUsed
-
-	for i, p := range rewardPercentiles {
-		thresholdGasUsed := uint64(blockGasUsed * p / 100) // #nosec G701
-		for sumGasUsed < thresholdGasUsed && txIndex < ethTxCount-1 {
+	sumGasUsed := fees.txs[0].gasUsed
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(fees.evmGasUsed) * p / 100) // #nosec G701
+		for sumGasUsed < thresholdGasUsed && txIndex < len(fees.txs)-1 {
 			txIndex++
-			sumGasUsed += sorter[txIndex].gasUsed
+			sumGasUsed += fees.txs[txIndex].gasUsed
 		}
-		targetOneFeeHistory.Reward[i] = sorter[txIndex].reward
+		rewards[i] = fees.txs[txIndex].reward
 	}
+	return rewards
+}
 
-	return nil
+// lowestTips returns up to "limit" of the lowest effective tips of the block
+// that are not below "ignoreUnder".
+func (fees *blockFees) lowestTips(limit int, ignoreUnder *big.Int) []*big.Int {
+	var tips []*big.Int
+	for _, tx := range fees.txs {
+		if len(tips) == limit {
+			break
+		}
+		if tx.reward.Cmp(ignoreUnder) < 0 {
+			continue
+		}
+		tips = append(tips, tx.reward)
+	}
+	return tips
 }
 
 // AllTxLogsFromEvents parses all ethereum logs from cosmos events
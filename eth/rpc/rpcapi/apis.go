@@ -88,12 +88,18 @@ func init() {
 				},
 			}
 		},
-		NamespaceTxPool: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ eth.EVMTxIndexer) []rpc.API {
+		NamespaceTxPool: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: NamespaceTxPool,
 					Version:   apiVersion,
-					Service:   NewImplTxPoolAPI(ctx.Logger),
+					Service:   NewImplTxPoolAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -106,6 +106,19 @@ func (a *DebugAPI) TraceBlockByHash(hash common.Hash, config *evm.TraceConfig) (
 	return a.backend.TraceBlock(rpc.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM if the given transaction was added on
+// top of the provided block and returns them as a JSON object. The config
+// may override the state and the block fields of the call.
+func (a *DebugAPI) TraceCall(
+	args evm.JsonTxArgs,
+	blockNrOrHash rpc.BlockNumberOrHash,
+	config *rpc.TraceCallConfig,
+) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+	return a.backend.TraceCall(args, blockNrOrHash, config)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
@@ -330,9 +343,25 @@ func (a *DebugAPI) SeedHash(number uint64) (string, error) {
 	return fmt.Sprintf("0x%x", ethash.SeedHash(number)), nil
 }
 
-// IntermediateRoots executes a block, and returns a list
-// of intermediate roots: the stateroot after each transaction.
+// IntermediateRoots executes a block, and returns a list of intermediate
+// roots: a state commitment after each EVM transaction. Each root is a hash
+// over the EVM account and storage changes of the block up to and including
+// that transaction. The trace config is unused.
 func (a *DebugAPI) IntermediateRoots(hash common.Hash, _ *evm.TraceConfig) ([]common.Hash, error) {
 	a.logger.Debug("debug_intermediateRoots", "hash", hash)
-	return ([]common.Hash)(nil), nil
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "hash", hash.Hex())
+		return nil, errors.New("block not found")
+	}
+	if resBlock.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	return a.backend.IntermediateRoots(resBlock)
 }
@@ -46,7 +46,7 @@ type IEthAPI interface {
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpc.EthTxJsonRPC, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpc.BlockNumber, idx hexutil.Uint) (*rpc.EthTxJsonRPC, error)
-	// eth_getBlockReceipts
+	GetBlockReceipts(blockNrOrHash rpc.BlockNumberOrHash) ([]map[string]interface{}, error)
 
 	// Writing Transactions
 	//
@@ -79,8 +79,12 @@ type IEthAPI interface {
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(
-		args evm.JsonTxArgs, blockNrOrHash rpc.BlockNumberOrHash, _ *rpc.StateOverride,
+		args evm.JsonTxArgs, blockNrOrHash rpc.BlockNumberOrHash,
+		overrides *rpc.StateOverride, blockOverrides *rpc.BlockOverrides,
 	) (hexutil.Bytes, error)
+	CreateAccessList(
+		args evm.JsonTxArgs, blockNrOrHash rpc.BlockNumberOrHash,
+	) (*rpc.AccessListResult, error)
 
 	// Chain Information
 	//
@@ -88,7 +92,7 @@ type IEthAPI interface {
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
 	EstimateGas(
-		args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber,
+		args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber, overrides *rpc.StateOverride,
 	) (hexutil.Uint64, error)
 	FeeHistory(
 		blockCount gethrpc.DecimalOrHex, lastBlock gethrpc.BlockNumber, rewardPercentiles []float64,
@@ -198,6 +202,15 @@ func (e *EthAPI) GetTransactionReceipt(
 	return e.backend.GetTransactionReceipt(hash)
 }
 
+// GetBlockReceipts returns the receipts of all the transactions in the block
+// identified by number or hash.
+func (e *EthAPI) GetBlockReceipts(
+	blockNrOrHash rpc.BlockNumberOrHash,
+) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+	return e.backend.GetBlockReceipts(blockNrOrHash)
+}
+
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
 func (e *EthAPI) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint {
 	e.logger.Debug("eth_getBlockTransactionCountByHash", "hash", hash.Hex())
@@ -293,10 +306,12 @@ func (e *EthAPI) GetProof(address common.Address,
 //                           EVM/Smart Contract Execution
 // --------------------------------------------------------------------------
 
-// Call performs a raw contract call.
+// Call performs a raw contract call. The optional state and block overrides
+// only apply to the call.
 func (e *EthAPI) Call(args evm.JsonTxArgs,
 	blockNrOrHash rpc.BlockNumberOrHash,
-	_ *rpc.StateOverride,
+	overrides *rpc.StateOverride,
+	blockOverrides *rpc.BlockOverrides,
 ) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
@@ -304,7 +319,7 @@ func (e *EthAPI) Call(args evm.JsonTxArgs,
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum)
+	data, err := e.backend.DoCall(args, blockNum, overrides, blockOverrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -312,6 +327,16 @@ func (e *EthAPI) Call(args evm.JsonTxArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// CreateAccessList returns the EIP-2930 access list of a call, which the
+// sender can attach to the tx to pay less for the accounts and storage slots
+// it touches, and the gas the call uses with that list.
+func (e *EthAPI) CreateAccessList(
+	args evm.JsonTxArgs, blockNrOrHash rpc.BlockNumberOrHash,
+) (*rpc.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+	return e.backend.CreateAccessList(args, blockNrOrHash)
+}
+
 // --------------------------------------------------------------------------
 //                           Event Logs
 // --------------------------------------------------------------------------
@@ -335,10 +360,10 @@ func (e *EthAPI) GasPrice() (*hexutil.Big, error) {
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
 func (e *EthAPI) EstimateGas(
-	args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber,
+	args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber, overrides *rpc.StateOverride,
 ) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides)
 }
 
 func (e *EthAPI) FeeHistory(blockCount gethrpc.DecimalOrHex,
@@ -353,11 +378,9 @@ func (e *EthAPI) FeeHistory(blockCount gethrpc.DecimalOrHex,
 // transactions.
 func (e *EthAPI) MaxPriorityFeePerGas() (*hexutil.Big, error) {
 	e.logger.Debug("eth_maxPriorityFeePerGas")
-	head, err := e.backend.CurrentHeader()
-	if err != nil {
-		return nil, err
-	}
-	tipcap, err := e.backend.SuggestGasTipCap(head.BaseFee)
+	// The suggested tip is over the base fee of each sampled block, so it does
+	// not depend on the base fee of the head block.
+	tipcap, err := e.backend.SuggestGasTipCap(nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 
+	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/eth/rpc"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -47,6 +48,7 @@ type IFilterEthBackend interface {
 	GetLogs(blockHash common.Hash) ([][]*gethcore.Log, error)
 	GetLogsByHeight(*int64) ([][]*gethcore.Log, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (gethcore.Bloom, error)
+	LogIndexer() eth.EVMLogIndexer
 
 	BloomStatus() (uint64, uint64)
 
@@ -141,6 +141,12 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*get
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
+	// The log index answers the range without reading every block, so the
+	// block range cap only applies to the fallback below.
+	if logs, ok, err := f.indexedLogs(head, logLimit); ok || err != nil {
+		return logs, err
+	}
+
 	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
@@ -181,6 +187,37 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*get
 	return logs, nil
 }
 
+// indexedLogs returns the logs matching the filter criteria within the range
+// of the filter from the log index of the backend. It returns false if the
+// backend has no log index or if the range is not fully indexed.
+func (f *Filter) indexedLogs(head int64, logLimit int) ([]*gethcore.Log, bool, error) {
+	logIndexer := f.backend.LogIndexer()
+	if logIndexer == nil {
+		return nil, false, nil
+	}
+
+	from := f.criteria.FromBlock.Int64()
+	to := f.criteria.ToBlock.Int64()
+	if to > head {
+		to = head
+	}
+	if from > to {
+		return []*gethcore.Log{}, true, nil
+	}
+
+	// A block missing from the index would silently drop its logs.
+	gap, err := logIndexer.FirstLogGap(from, to)
+	if err != nil || gap >= 0 {
+		return nil, false, nil
+	}
+
+	logs, err := logIndexer.GetLogs(from, to, f.criteria.Addresses, f.criteria.Topics, logLimit)
+	if err != nil {
+		return nil, false, err
+	}
+	return logs, true, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom gethcore.Bloom) ([]*gethcore.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
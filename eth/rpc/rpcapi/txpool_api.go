@@ -2,23 +2,30 @@
 package rpcapi
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/NibiruChain/nibiru/eth/rpc"
+	"github.com/NibiruChain/nibiru/eth/rpc/backend"
 )
 
 // TxPoolAPI offers and API for the transaction pool. It only operates on data
-// that is non-confidential.
+// that is non-confidential. The pool is the CometBFT mempool, and only its
+// Ethereum transactions are listed.
 type TxPoolAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewImplTxPoolAPI creates a new tx pool service that gives information about the transaction pool.
-func NewImplTxPoolAPI(logger log.Logger) *TxPoolAPI {
+func NewImplTxPoolAPI(logger log.Logger, backend backend.EVMBackend) *TxPoolAPI {
 	return &TxPoolAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
@@ -27,28 +34,91 @@ func (api *TxPoolAPI) Content() (
 	map[string]map[string]map[string]*rpc.EthTxJsonRPC, error,
 ) {
 	api.logger.Debug("txpool_content")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*rpc.EthTxJsonRPC{
 		"pending": make(map[string]map[string]*rpc.EthTxJsonRPC),
 		"queued":  make(map[string]map[string]*rpc.EthTxJsonRPC),
 	}
+	for kind, txsBySender := range map[string]map[common.Address]map[uint64]*rpc.EthTxJsonRPC{
+		"pending": pending,
+		"queued":  queued,
+	} {
+		for sender, txsByNonce := range txsBySender {
+			dump := make(map[string]*rpc.EthTxJsonRPC, len(txsByNonce))
+			for nonce, tx := range txsByNonce {
+				dump[fmt.Sprintf("%d", nonce)] = tx
+			}
+			content[kind][sender.Hex()] = dump
+		}
+	}
 	return content, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *TxPoolAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for kind, txsBySender := range map[string]map[common.Address]map[uint64]*rpc.EthTxJsonRPC{
+		"pending": pending,
+		"queued":  queued,
+	} {
+		for sender, txsByNonce := range txsBySender {
+			dump := make(map[string]string, len(txsByNonce))
+			for nonce, tx := range txsByNonce {
+				dump[fmt.Sprintf("%d", nonce)] = inspectTx(tx)
+			}
+			content[kind][sender.Hex()] = dump
+		}
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
 func (api *TxPoolAPI) Status() map[string]hexutil.Uint {
 	api.logger.Debug("txpool_status")
-	return map[string]hexutil.Uint{
+	status := map[string]hexutil.Uint{
 		"pending": hexutil.Uint(0),
 		"queued":  hexutil.Uint(0),
 	}
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		api.logger.Error("failed to fetch the tx pool content", "error", err.Error())
+		return status
+	}
+	for _, txsByNonce := range pending {
+		status["pending"] += hexutil.Uint(len(txsByNonce))
+	}
+	for _, txsByNonce := range queued {
+		status["queued"] += hexutil.Uint(len(txsByNonce))
+	}
+	return status
+}
+
+// inspectTx summarizes a transaction in the format of "txpool_inspect" in
+// go-ethereum: "to: value wei + gas gas × gasPrice wei".
+func inspectTx(tx *rpc.EthTxJsonRPC) string {
+	gasPrice := tx.GasPrice
+	if tx.GasFeeCap != nil {
+		gasPrice = tx.GasFeeCap
+	}
+	to := "contract creation"
+	if tx.To != nil {
+		to = tx.To.Hex()
+	}
+	return fmt.Sprintf("%s: %v wei + %v gas × %v wei",
+		to, tx.Value.ToInt(), uint64(tx.Gas), gasPrice.ToInt(),
+	)
 }
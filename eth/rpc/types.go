@@ -4,28 +4,45 @@ package rpc
 // TODO: docs(eth-rpc): Explain types further.
 
 import (
-	"math/big"
+	"encoding/json"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/x/evm"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
 // internal pkg on geth.
 
-// AccountResult struct for account proof
+// AccountResult struct for account proof.
+//
+// The proofs are not Merkle-Patricia trie nodes. Each proof is a list of two
+// hex-encoded ICS-23 commitment proofs: one of the key in the IAVL store of
+// the module and one of that store in the multistore. The proofs of a block N
+// verify against the app hash in the header of block N+1. See the
+// "eth/proof" package for the exact keys and values and for a verifier.
 type AccountResult struct {
-	Address      common.Address  `json:"address"`
-	AccountProof []string        `json:"accountProof"`
-	Balance      *hexutil.Big    `json:"balance"`
-	CodeHash     common.Hash     `json:"codeHash"`
-	Nonce        hexutil.Uint64  `json:"nonce"`
+	Address common.Address `json:"address"`
+	// AccountProof: Proof of the account in the x/auth store, which holds the
+	// nonce and code hash.
+	AccountProof []string     `json:"accountProof"`
+	Balance      *hexutil.Big `json:"balance"`
+	// BalanceProof: Proof of the balance in the EVM denom in the x/bank store.
+	// Not part of the Ethereum response since Nibiru keeps balances apart from
+	// accounts.
+	BalanceProof []string       `json:"balanceProof"`
+	CodeHash     common.Hash    `json:"codeHash"`
+	Nonce        hexutil.Uint64 `json:"nonce"`
+	// StorageHash: Always empty since there is no per-account storage trie.
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
 }
 
-// StorageResult defines the format for storage proof return
+// StorageResult defines the format for storage proof return. "Proof" is a
+// proof of the slot in the "evm" store, in the format of
+// [AccountResult.AccountProof].
 type StorageResult struct {
 	Key   string       `json:"key"`
 	Value *hexutil.Big `json:"value"`
@@ -56,20 +73,49 @@ type EthTxJsonRPC struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
-
-// OverrideAccount indicates the overriding fields of account during the execution of
-// a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type StateOverride = evm.StateOverride
+
+// OverrideAccount indicates the overriding fields of account during the
+// execution of a message call.
+type OverrideAccount = evm.OverrideAccount
+
+// BlockOverrides is a set of header fields to override for a message call.
+type BlockOverrides = evm.BlockOverrides
+
+// TraceCallConfig is the config of "debug_traceCall": a trace config, with the
+// same JSON fields, and the state and block overrides of the call.
+type TraceCallConfig struct {
+	evm.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It is needed because the
+// embedded trace config has its own "UnmarshalJSON", which would otherwise be
+// promoted and ignore the overrides.
+func (c *TraceCallConfig) UnmarshalJSON(bz []byte) error {
+	if err := json.Unmarshal(bz, &c.TraceConfig); err != nil {
+		return err
+	}
+	var overrides struct {
+		StateOverrides *StateOverride  `json:"stateOverrides"`
+		BlockOverrides *BlockOverrides `json:"blockOverrides"`
+	}
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return err
+	}
+	c.StateOverrides = overrides.StateOverrides
+	c.BlockOverrides = overrides.BlockOverrides
+	return nil
+}
+
+// AccessListResult is the result of "eth_createAccessList": the access list
+// of a call, the gas it uses with that list, and the error of the EVM
+// execution, if any.
+type AccessListResult struct {
+	AccessList *gethcore.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
 }
 
 type FeeHistoryResult struct {
@@ -84,9 +130,3 @@ type SignTransactionResult struct {
 	Raw hexutil.Bytes         `json:"raw"`
 	Tx  *gethcore.Transaction `json:"tx"`
 }
-
-type OneFeeHistory struct {
-	BaseFee, NextBaseFee *big.Int   // base fee for each block
-	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
-	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
-}
@@ -2,6 +2,7 @@ package eth
 
 import (
 	fmt "fmt"
+	"math/big"
 
 	"github.com/NibiruChain/collections"
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -17,6 +18,9 @@ var (
 	ValueEncoderBytes collections.ValueEncoder[[]byte] = veBytes{}
 	KeyEncoderBytes   collections.KeyEncoder[[]byte]   = keBytes{}
 
+	// Implements a `collections.ValueEncoder` for a non-negative big integer.
+	ValueEncoderBigInt collections.ValueEncoder[*big.Int] = veBigInt{}
+
 	// Implements a `collections.ValueEncoder` for an Ethereum address.
 	ValueEncoderEthAddr collections.ValueEncoder[gethcommon.Address] = veEthAddr{}
 	// keEthHash: Implements a `collections.KeyEncoder` for an Ethereum address.
@@ -34,6 +38,15 @@ func (_ veBytes) Decode(bz []byte) []byte       { return bz }
 func (_ veBytes) Stringify(value []byte) string { return BytesToHex(value) }
 func (_ veBytes) Name() string                  { return "[]byte" }
 
+// veBigInt: Implements a `collections.ValueEncoder` for a non-negative big
+// integer, stored as its big-endian bytes.
+type veBigInt struct{}
+
+func (_ veBigInt) Encode(value *big.Int) []byte    { return value.Bytes() }
+func (_ veBigInt) Decode(bz []byte) *big.Int       { return new(big.Int).SetBytes(bz) }
+func (_ veBigInt) Stringify(value *big.Int) string { return value.String() }
+func (_ veBigInt) Name() string                    { return "*big.Int" }
+
 // veEthAddr: Implements a `collections.ValueEncoder` for an Ethereum address.
 type veEthAddr struct{}
 
@@ -1,6 +1,7 @@
 package eth_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/NibiruChain/collections"
@@ -61,6 +62,18 @@ func (s *Suite) TestEncoderBytes() {
 	}
 }
 
+func (s *Suite) TestEncoderBigInt() {
+	for _, given := range []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		new(big.Int).Lsh(big.NewInt(1), 200),
+	} {
+		s.Run("bijectivity: *big.Int encoder "+given.String(), func() {
+			assertBijectiveValue(s.T(), eth.ValueEncoderBigInt, given)
+		})
+	}
+}
+
 func (s *Suite) TestEncoderEthAddr() {
 	testCases := []struct {
 		name      string
@@ -38,6 +38,7 @@ import (
 	"github.com/NibiruChain/nibiru/app"
 	devgastypes "github.com/NibiruChain/nibiru/x/devgas/v1/types"
 	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	"github.com/NibiruChain/nibiru/x/evm"
 	inflationtypes "github.com/NibiruChain/nibiru/x/inflation/types"
 	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
 	sudotypes "github.com/NibiruChain/nibiru/x/sudo/types"
@@ -282,6 +283,10 @@ func TestAppImportExport(t *testing.T) {
 		{oldApp.GetKey(oracletypes.StoreKey), newApp.GetKey(oracletypes.StoreKey), [][]byte{}},
 		{oldApp.GetKey(sudotypes.StoreKey), newApp.GetKey(sudotypes.StoreKey), [][]byte{}},
 		{oldApp.GetKey(tokenfactorytypes.StoreKey), newApp.GetKey(tokenfactorytypes.StoreKey), [][]byte{}},
+		{
+			oldApp.GetKey(evm.StoreKey), newApp.GetKey(evm.StoreKey),
+			[][]byte{evm.KeyPrefixBaseFee.Prefix(), evm.KeyPrefixLastBlockGasUsed.Prefix()},
+		}, // the base fee and block gas are set each block and are not exported
 	}
 
 	for _, skp := range storeKeysPrefixes {
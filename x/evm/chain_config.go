@@ -11,6 +11,11 @@ import (
 )
 
 // EthereumConfig returns an Ethereum ChainConfig for EVM state transitions.
+//
+// The go-ethereum dependency has no Shanghai or Cancun instruction set, so
+// those forks stay unscheduled. The Shanghai EIPs it supports, such as PUSH0,
+// are activated with the ExtraEIPs param (see [ShanghaiEIPs]). The Cancun
+// opcodes TSTORE, TLOAD and MCOPY need a go-ethereum upgrade.
 func EthereumConfig(chainID *big.Int) *params.ChainConfig {
 	return &params.ChainConfig{
 		ChainID:                 chainID,
@@ -31,8 +36,8 @@ func EthereumConfig(chainID *big.Int) *params.ChainConfig {
 		ArrowGlacierBlock:       big.NewInt(0),
 		GrayGlacierBlock:        big.NewInt(0),
 		MergeNetsplitBlock:      big.NewInt(0),
-		ShanghaiBlock:           nil, // TODO: change this if we upgrade go-ethereum dependency
-		CancunBlock:             nil, // TODO: change this if we upgrade go-ethereum dependency
+		ShanghaiBlock:           nil, // see ShanghaiEIPs
+		CancunBlock:             nil, // TODO: feat: Cancun (EIP-1153, EIP-5656) after go-ethereum upgrade
 		TerminalTotalDifficulty: nil,
 		Ethash:                  nil,
 		Clique:                  nil,
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -28,6 +30,11 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		CmdCreateFunTokenFromBankCoin(),
 		SendFunTokenToEvm(),
+		ConvertEvmToCoin(),
+		CmdSetFunTokenPaused(),
+		CmdUnregisterFunToken(),
+		CmdRefreshFunTokenMetadata(),
+		CmdEditCreateAllowlist(),
 	}
 	for _, cmd := range cmds {
 		txCmd.AddCommand(cmd)
@@ -48,13 +55,41 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	// Add subcommands
-	cmds := []*cobra.Command{}
+	cmds := []*cobra.Command{
+		CmdQueryCreateFunTokenFee(),
+	}
 	for _, cmd := range cmds {
 		moduleQueryCmd.AddCommand(cmd)
 	}
 	return moduleQueryCmd
 }
 
+// CmdQueryCreateFunTokenFee queries the fee to create a FunToken mapping
+func CmdQueryCreateFunTokenFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-fun-token-fee",
+		Short: "Query the fee to create a FunToken mapping",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+			res, err := queryClient.CreateFunTokenFee(
+				cmd.Context(), &evm.QueryCreateFunTokenFeeRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdCreateFunTokenFromBankCoin broadcast MsgCreateFunToken
 func CmdCreateFunTokenFromBankCoin() *cobra.Command {
 	cmd := &cobra.Command{
@@ -120,3 +155,173 @@ func SendFunTokenToEvm() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// ConvertEvmToCoin broadcast MsgConvertEvmToCoin
+func ConvertEvmToCoin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-evm-to-coin [erc20_addr] [amount] [to_bank_addr] [flags]",
+		Short: `Convert [amount] of the ERC20 [erc20_addr] into its bank coin representation"`,
+		Long: `Convert [amount] of the ERC20 [erc20_addr] held by the Ethereum address of
+the sender into the bank coin given by the ERC20's FunToken mapping. The coins
+are sent to [to_bank_addr], which defaults to the sender.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txFactory = txFactory.
+				WithTxConfig(clientCtx.TxConfig).
+				WithAccountRetriever(clientCtx.AccountRetriever)
+
+			erc20Addr, err := eth.NewHexAddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount \"%s\"", args[1])
+			}
+			toBankAddr := clientCtx.GetFromAddress().String()
+			if len(args) == 3 {
+				toBankAddr = args[2]
+			}
+			msg := &evm.MsgConvertEvmToCoin{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Erc20Addr:  erc20Addr,
+				Amount:     amount,
+				ToBankAddr: toBankAddr,
+			}
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txFactory, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSetFunTokenPaused broadcast MsgSetFunTokenPaused
+func CmdSetFunTokenPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-funtoken-paused [erc20_addr] [paused] [flags]",
+		Short: `[SUDO] Pause or resume the conversions of the FunToken mapping of [erc20_addr]`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			erc20Addr, err := eth.NewHexAddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			msg := &evm.MsgSetFunTokenPaused{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Erc20Addr: erc20Addr,
+				Paused:    paused,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUnregisterFunToken broadcast MsgUnregisterFunToken
+func CmdUnregisterFunToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unregister-funtoken [erc20_addr] [flags]",
+		Short: `[SUDO] Delete the FunToken mapping of [erc20_addr] once its supply is fully unwound`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			erc20Addr, err := eth.NewHexAddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			msg := &evm.MsgUnregisterFunToken{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Erc20Addr: erc20Addr,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRefreshFunTokenMetadata broadcast MsgRefreshFunTokenMetadata
+func CmdRefreshFunTokenMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh-funtoken-metadata [erc20_addr] [flags]",
+		Short: `[SUDO] Re-sync the bank metadata of the FunToken mapping of [erc20_addr] with the ERC20`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			erc20Addr, err := eth.NewHexAddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			msg := &evm.MsgRefreshFunTokenMetadata{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Erc20Addr: erc20Addr,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdEditCreateAllowlist broadcast MsgEditCreateAllowlist
+func CmdEditCreateAllowlist() *cobra.Command {
+	const (
+		flagAdd    = "add"
+		flagRemove = "remove"
+	)
+	cmd := &cobra.Command{
+		Use:   "edit-create-allowlist [flags]",
+		Short: `[SUDO] Add and remove addresses from the allowlist of the EVM "create_policy" param`,
+		Long: `[SUDO] Add and remove addresses from the allowlist of the EVM "create_policy"
+param. Addresses can be given in hex (0x) or Bech32 (nibi) format, for example:
+nibid tx evm edit-create-allowlist --add=0x...,nibi1... --remove=nibi1...`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			addAddrs, err := cmd.Flags().GetStringSlice(flagAdd)
+			if err != nil {
+				return err
+			}
+			removeAddrs, err := cmd.Flags().GetStringSlice(flagRemove)
+			if err != nil {
+				return err
+			}
+			msg := &evm.MsgEditCreateAllowlist{
+				Sender:      clientCtx.GetFromAddress().String(),
+				AddAddrs:    addAddrs,
+				RemoveAddrs: removeAddrs,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().StringSlice(flagAdd, nil, "Addresses to add to the create allowlist")
+	cmd.Flags().StringSlice(flagRemove, nil, "Addresses to remove from the create allowlist")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
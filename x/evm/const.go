@@ -36,6 +36,10 @@ const (
 	KeyPrefixFunTokenIdxErc20
 	// KV store prefix for indexing `FunToken` by bank coin denomination
 	KeyPrefixFunTokenIdxBankDenom
+	// KV store prefix for the EIP-1559 base fee of the current block
+	KeyPrefixBaseFee
+	// KV store prefix for the gas used by the last block
+	KeyPrefixLastBlockGasUsed
 )
 
 // KVStore transient prefix namespaces for the EVM Module. Transient stores only
@@ -38,9 +38,11 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	authtypes.BankKeeper
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 
 	GetDenomMetaData(ctx sdk.Context, denom string) (metadata bank.Metadata, isFound bool)
 	SetDenomMetaData(ctx sdk.Context, denomMetaData bank.Metadata)
@@ -52,6 +54,21 @@ type StakingKeeper interface {
 	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, found bool)
 }
 
+// DistrKeeper funds the community pool with the fees of the module.
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// InflationKeeper burns the fees of the module.
+type InflationKeeper interface {
+	Burn(ctx sdk.Context, coins sdk.Coins, sender sdk.AccAddress) error
+}
+
+// SudoKeeper gates the sudo messages of the module behind the x/sudo root.
+type SudoKeeper interface {
+	GetRootAddr(ctx sdk.Context) (sdk.AccAddress, error)
+}
+
 // EvmHooks: Ethereum transaction processing callbacks/hooks.
 type EvmHooks interface {
 	// PostTxProcessing: Called after default tx processing. If the hook errors,
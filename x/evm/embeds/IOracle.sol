@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+/// @dev Read access to the exchange rates of the Nibiru oracle module
+/// ("x/oracle"). Prices are only available for pairs on the oracle whitelist.
+/// All prices are 18-decimal fixed point numbers.
+interface IOracle {
+  /// @dev getExchangeRate returns the latest exchange rate of a pair
+  /// @param pair the asset pair, e.g. "ubtc:unusd"
+  /// @return price the exchange rate with 18 decimals
+  function getExchangeRate(
+    string memory pair
+  ) external view returns (uint256 price);
+
+  /// @dev getExchangeRateTwap returns the time-weighted average exchange rate
+  /// of a pair over the oracle's TWAP lookback window
+  /// @param pair the asset pair, e.g. "ubtc:unusd"
+  /// @return price the TWAP exchange rate with 18 decimals
+  function getExchangeRateTwap(
+    string memory pair
+  ) external view returns (uint256 price);
+
+  /// @dev getDatedExchangeRate returns the latest exchange rate of a pair
+  /// together with the block at which it was posted, which can be used to
+  /// check the staleness of the price
+  /// @param pair the asset pair, e.g. "ubtc:unusd"
+  /// @return price the exchange rate with 18 decimals
+  /// @return createdBlock the block height at which the price was set
+  function getDatedExchangeRate(
+    string memory pair
+  ) external view returns (uint256 price, uint64 createdBlock);
+}
+
+address constant ORACLE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000801;
+
+IOracle constant ORACLE_GATEWAY = IOracle(ORACLE_PRECOMPILE_ADDRESS);
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+/// @dev Delegates, undelegates, and redelegates NIBI and claims staking
+/// rewards from the EVM. The EVM caller is the delegator of every call.
+/// Amounts are in the smallest unit of the bond denom ("unibi"). Validators
+/// are identified by their "nibivaloper"-prefixed Bech32 operator address.
+interface IStaking {
+  struct BankCoin {
+    string denom;
+    uint256 amount;
+  }
+
+  struct Validator {
+    string operatorAddr;
+    bool jailed;
+    /// @dev 1: unbonded, 2: unbonding, 3: bonded
+    uint8 status;
+    uint256 tokens;
+    /// @dev delegator shares with 18 decimals
+    uint256 delegatorShares;
+    /// @dev commission rate with 18 decimals
+    uint256 commissionRate;
+    string moniker;
+  }
+
+  /// @dev Emitted when "delegator" delegates "amount" to "validatorAddr".
+  event Delegate(
+    address indexed delegator,
+    string validatorAddr,
+    uint256 amount
+  );
+
+  /// @dev Emitted when "delegator" starts unbonding "amount" from
+  /// "validatorAddr". The tokens are returned at "completionTime".
+  event Undelegate(
+    address indexed delegator,
+    string validatorAddr,
+    uint256 amount,
+    int64 completionTime
+  );
+
+  /// @dev Emitted when "delegator" moves "amount" from "srcValidatorAddr" to
+  /// "dstValidatorAddr".
+  event Redelegate(
+    address indexed delegator,
+    string srcValidatorAddr,
+    string dstValidatorAddr,
+    uint256 amount,
+    int64 completionTime
+  );
+
+  /// @dev Emitted when "delegator" claims its rewards from "validatorAddr".
+  event WithdrawDelegatorRewards(
+    address indexed delegator,
+    string validatorAddr,
+    BankCoin[] rewards
+  );
+
+  /// @dev delegate bonds tokens of the caller to a validator
+  /// @param validatorAddr operator address of the validator
+  /// @param amount amount of the bond denom to delegate
+  /// @return success true if the delegation succeeded
+  function delegate(
+    string memory validatorAddr,
+    uint256 amount
+  ) external returns (bool success);
+
+  /// @dev undelegate starts unbonding tokens of the caller from a validator
+  /// @param validatorAddr operator address of the validator
+  /// @param amount amount of the bond denom to undelegate
+  /// @return completionTime unix time (seconds) when the unbonding completes
+  function undelegate(
+    string memory validatorAddr,
+    uint256 amount
+  ) external returns (int64 completionTime);
+
+  /// @dev redelegate moves bonded tokens of the caller between validators
+  /// @param srcValidatorAddr operator address of the current validator
+  /// @param dstValidatorAddr operator address of the new validator
+  /// @param amount amount of the bond denom to redelegate
+  /// @return completionTime unix time (seconds) when the redelegation completes
+  function redelegate(
+    string memory srcValidatorAddr,
+    string memory dstValidatorAddr,
+    uint256 amount
+  ) external returns (int64 completionTime);
+
+  /// @dev withdrawDelegatorRewards claims the staking rewards of the caller
+  /// from a validator
+  /// @param validatorAddr operator address of the validator
+  /// @return rewards the claimed rewards
+  function withdrawDelegatorRewards(
+    string memory validatorAddr
+  ) external returns (BankCoin[] memory rewards);
+
+  /// @dev delegation returns the delegation of "delegator" to a validator.
+  /// Both values are zero if there is no delegation.
+  /// @param delegator EVM address of the delegator
+  /// @param validatorAddr operator address of the validator
+  /// @return shares delegator shares with 18 decimals
+  /// @return balance amount of the bond denom the shares are worth
+  function delegation(
+    address delegator,
+    string memory validatorAddr
+  ) external view returns (uint256 shares, uint256 balance);
+
+  /// @dev delegationRewards returns the outstanding rewards of "delegator"
+  /// from a validator
+  /// @param delegator EVM address of the delegator
+  /// @param validatorAddr operator address of the validator
+  /// @return rewards outstanding rewards, rounded down
+  function delegationRewards(
+    address delegator,
+    string memory validatorAddr
+  ) external view returns (BankCoin[] memory rewards);
+
+  /// @dev validator returns a validator by its operator address
+  /// @param validatorAddr operator address of the validator
+  function validator(
+    string memory validatorAddr
+  ) external view returns (Validator memory);
+
+  /// @dev validators returns the bonded validators, sorted by power
+  function validators() external view returns (Validator[] memory);
+}
+
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+IStaking constant STAKING_PRECOMPILE = IStaking(STAKING_PRECOMPILE_ADDRESS);
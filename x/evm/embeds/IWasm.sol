@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+/// @dev Executes, queries, and instantiates CosmWasm smart contracts from the
+/// EVM. The EVM caller is the sender of every Wasm message. Messages and query
+/// requests are the JSON-encoded bytes that the Wasm contract expects.
+interface IWasm {
+  struct BankCoin {
+    string denom;
+    uint256 amount;
+  }
+
+  /// @dev execute calls a Wasm contract
+  /// @param contractAddr nibi-prefixed Bech32 address of the wasm contract
+  /// @param msgArgs JSON encoded wasm execute invocation
+  /// @param funds Optional funds to supply during the execute call. It's
+  /// uncommon to use this field, so you'll pass an empty array most of the time.
+  /// @return response response of the execute call
+  function execute(
+    string memory contractAddr,
+    bytes memory msgArgs,
+    BankCoin[] memory funds
+  ) external returns (bytes memory response);
+
+  /// @dev query runs a smart query against a Wasm contract
+  /// @param contractAddr nibi-prefixed Bech32 address of the wasm contract
+  /// @param req JSON encoded query request
+  /// @return response JSON encoded query response
+  function query(
+    string memory contractAddr,
+    bytes memory req
+  ) external view returns (bytes memory response);
+
+  /// @dev instantiate creates a new Wasm contract from stored code
+  /// @param admin Optional nibi-prefixed Bech32 address of the contract admin.
+  /// Pass an empty string for a contract without an admin.
+  /// @param codeID ID of the stored Wasm code
+  /// @param msgArgs JSON encoded instantiate message
+  /// @param label human readable label of the contract
+  /// @param funds Optional funds to supply during instantiation
+  /// @return contractAddr nibi-prefixed Bech32 address of the new contract
+  /// @return data data returned by the instantiation
+  function instantiate(
+    string memory admin,
+    uint64 codeID,
+    bytes memory msgArgs,
+    string memory label,
+    BankCoin[] memory funds
+  ) external returns (string memory contractAddr, bytes memory data);
+
+  struct WasmExecuteMsg {
+    string contractAddr;
+    bytes msgArgs;
+    BankCoin[] funds;
+  }
+
+  /// @dev executeMulti runs several Wasm execute calls in order. If any of
+  /// them fails, none of them take effect.
+  /// @param executeMsgs the execute calls to run
+  /// @return responses the responses of the execute calls, in order
+  function executeMulti(
+    WasmExecuteMsg[] memory executeMsgs
+  ) external returns (bytes[] memory responses);
+}
+
+address constant WASM_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000802;
+
+IWasm constant WASM_PRECOMPILE = IWasm(WASM_PRECOMPILE_ADDRESS);
@@ -34,11 +34,35 @@ var (
 	Contract_Funtoken CompiledEvmContract
 	//go:embed IFunTokenCompiled.json
 	funtokenContractJSON []byte
+
+	// Contract_Oracle: Precompile contract interface for "IOracle.sol". This
+	// precompile gives EVM contracts read access to the exchange rates of the
+	// oracle module. Only the ABI is used.
+	Contract_Oracle CompiledEvmContract
+	//go:embed IOracleCompiled.json
+	oracleContractJSON []byte
+
+	// Contract_Wasm: Precompile contract interface for "IWasm.sol". This
+	// precompile enables EVM contracts to execute, query, and instantiate
+	// CosmWasm contracts. Only the ABI is used.
+	Contract_Wasm CompiledEvmContract
+	//go:embed IWasmCompiled.json
+	wasmContractJSON []byte
+
+	// Contract_Staking: Precompile contract interface for "IStaking.sol". This
+	// precompile enables EVM accounts to delegate, undelegate, redelegate, and
+	// claim staking rewards. Only the ABI is used.
+	Contract_Staking CompiledEvmContract
+	//go:embed IStakingCompiled.json
+	stakingContractJSON []byte
 )
 
 func init() {
 	Contract_ERC20Minter = SmartContract_ERC20Minter.MustLoad()
 	Contract_Funtoken = SmartContract_FunToken.MustLoad()
+	Contract_Oracle = SmartContract_Oracle.MustLoad()
+	Contract_Wasm = SmartContract_Wasm.MustLoad()
+	Contract_Staking = SmartContract_Staking.MustLoad()
 }
 
 var (
@@ -57,6 +81,21 @@ var (
 		FixtureType: FixtueType_Prod,
 		EmbedJSON:   &funtokenContractJSON,
 	}
+	SmartContract_Oracle = SmartContractFixture{
+		Name:        "IOracle.sol",
+		FixtureType: FixtueType_Prod,
+		EmbedJSON:   &oracleContractJSON,
+	}
+	SmartContract_Wasm = SmartContractFixture{
+		Name:        "IWasm.sol",
+		FixtureType: FixtueType_Prod,
+		EmbedJSON:   &wasmContractJSON,
+	}
+	SmartContract_Staking = SmartContractFixture{
+		Name:        "IStaking.sol",
+		FixtureType: FixtueType_Prod,
+		EmbedJSON:   &stakingContractJSON,
+	}
 )
 
 // CompiledEvmContract: EVM contract that can be deployed into the EVM state and
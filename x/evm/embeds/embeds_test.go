@@ -13,6 +13,9 @@ func TestLoadContracts(t *testing.T) {
 		embeds.SmartContract_TestERC20,
 		embeds.SmartContract_ERC20Minter,
 		embeds.SmartContract_FunToken,
+		embeds.SmartContract_Oracle,
+		embeds.SmartContract_Wasm,
+		embeds.SmartContract_Staking,
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			_, err := tc.Load()
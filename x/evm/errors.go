@@ -29,6 +29,7 @@ const (
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
 	codeErrInactivePrecompile
+	codeErrCreateNotAllowed
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -81,6 +82,10 @@ var (
 
 	// ErrInactivePrecompile returns an error if a call is made to an inactive precompile
 	ErrInactivePrecompile = errorsmod.Register(ModuleName, codeErrInactivePrecompile, "precompile not enabled")
+
+	// ErrCreateNotAllowed returns an error if the create policy param forbids a
+	// contract deployment.
+	ErrCreateNotAllowed = errorsmod.Register(ModuleName, codeErrCreateNotAllowed, "contract deployment not allowed by the create policy")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
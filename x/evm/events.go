@@ -1,11 +1,21 @@
 // Copyright (c) 2023-2024 Nibi, Inc.
 package evm
 
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+)
+
 // Evm module events
 const (
 	EventTypeEthereumTx = TypeMsgEthereumTx
-	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
+	EventTypeFeeMarket  = "fee_market"
 
 	AttributeKeyRecipient      = "recipient"
 	AttributeKeyTxHash         = "txHash"
@@ -17,5 +27,34 @@ const (
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
-	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyBaseFee          = "base_fee"
 )
+
+// NewEventBlockBloom returns the typed event that carries the bloom filter of
+// all the logs of a block, hex encoded.
+func NewEventBlockBloom(bloom gethcore.Bloom) *EventBlockBloom {
+	return &EventBlockBloom{Bloom: hexutil.Encode(bloom.Bytes())}
+}
+
+// EventBlockBloomFromABCIEvent parses the bloom filter of a block out of the
+// ABCI event of a typed [EventBlockBloom].
+func EventBlockBloomFromABCIEvent(event abci.Event) (gethcore.Bloom, error) {
+	if eventType := proto.MessageName(new(EventBlockBloom)); event.Type != eventType {
+		return gethcore.Bloom{}, fmt.Errorf(
+			"event type %s is not %s", event.Type, eventType,
+		)
+	}
+	typedEvent, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return gethcore.Bloom{}, err
+	}
+	blockBloom, ok := typedEvent.(*EventBlockBloom)
+	if !ok {
+		return gethcore.Bloom{}, fmt.Errorf("failed to parse %s", event.Type)
+	}
+	bz, err := hexutil.Decode(blockBloom.Bloom)
+	if err != nil {
+		return gethcore.Bloom{}, fmt.Errorf("invalid block bloom: %w", err)
+	}
+	return gethcore.BytesToBloom(bz), nil
+}
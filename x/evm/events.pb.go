@@ -410,6 +410,76 @@ func (m *EventSendFunTokenToEvm) GetBankCoin() types.Coin {
 	return types.Coin{}
 }
 
+// EventConvertEvmToCoin defines the event for converting ERC20 tokens into
+// their bank coin representation.
+type EventConvertEvmToCoin struct {
+	Sender               string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20ContractAddress string     `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	ToBankAddr           string     `protobuf:"bytes,3,opt,name=to_bank_addr,json=toBankAddr,proto3" json:"to_bank_addr,omitempty"`
+	BankCoin             types.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
+}
+
+func (m *EventConvertEvmToCoin) Reset()         { *m = EventConvertEvmToCoin{} }
+func (m *EventConvertEvmToCoin) String() string { return proto.CompactTextString(m) }
+func (*EventConvertEvmToCoin) ProtoMessage()    {}
+func (*EventConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{6}
+}
+func (m *EventConvertEvmToCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConvertEvmToCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConvertEvmToCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConvertEvmToCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConvertEvmToCoin.Merge(m, src)
+}
+func (m *EventConvertEvmToCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConvertEvmToCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConvertEvmToCoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConvertEvmToCoin proto.InternalMessageInfo
+
+func (m *EventConvertEvmToCoin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetErc20ContractAddress() string {
+	if m != nil {
+		return m.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetToBankAddr() string {
+	if m != nil {
+		return m.ToBankAddr
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetBankCoin() types.Coin {
+	if m != nil {
+		return m.BankCoin
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventEthereumTx)(nil), "eth.evm.v1.EventEthereumTx")
 	proto.RegisterType((*EventTxLog)(nil), "eth.evm.v1.EventTxLog")
@@ -417,48 +487,51 @@ func init() {
 	proto.RegisterType((*EventBlockBloom)(nil), "eth.evm.v1.EventBlockBloom")
 	proto.RegisterType((*EventFunTokenFromBankCoin)(nil), "eth.evm.v1.EventFunTokenFromBankCoin")
 	proto.RegisterType((*EventSendFunTokenToEvm)(nil), "eth.evm.v1.EventSendFunTokenToEvm")
+	proto.RegisterType((*EventConvertEvmToCoin)(nil), "eth.evm.v1.EventConvertEvmToCoin")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/events.proto", fileDescriptor_f8bc26b53c788f17) }
 
 var fileDescriptor_f8bc26b53c788f17 = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x69, 0x9b, 0x34, 0x1b, 0x10, 0x68, 0x15, 0xa5, 0x4e, 0x05, 0x6e, 0x65, 0x84, 0xe0,
-	0x64, 0x93, 0xc2, 0x09, 0x89, 0x03, 0x09, 0x89, 0x38, 0x00, 0x42, 0xc5, 0x08, 0x89, 0x8b, 0xb5,
-	0xb6, 0x07, 0xaf, 0x15, 0x7b, 0x37, 0xf2, 0xae, 0x2d, 0xe7, 0x15, 0x38, 0xf1, 0x58, 0x95, 0xb8,
-	0xf4, 0xd8, 0x53, 0x85, 0x92, 0x37, 0xe0, 0x09, 0xd0, 0xae, 0x37, 0x05, 0xae, 0xdc, 0x66, 0xbe,
-	0xf9, 0xf1, 0xf7, 0x7d, 0x9e, 0x45, 0x47, 0x20, 0xa9, 0x0f, 0x75, 0xe1, 0xd7, 0x13, 0x1f, 0x6a,
-	0x60, 0x52, 0x78, 0xab, 0x92, 0x4b, 0x8e, 0x11, 0x48, 0xea, 0x41, 0x5d, 0x78, 0xf5, 0xe4, 0xd8,
-	0x89, 0xb9, 0x28, 0xb8, 0xf0, 0x23, 0x22, 0xc0, 0xaf, 0x27, 0x11, 0x48, 0x32, 0xf1, 0x63, 0x9e,
-	0xb1, 0xb6, 0xf7, 0x78, 0x98, 0xf2, 0x94, 0xeb, 0xd0, 0x57, 0x51, 0x8b, 0xba, 0x3f, 0x2c, 0x74,
-	0x77, 0xae, 0x56, 0xce, 0x25, 0x85, 0x12, 0xaa, 0x22, 0x68, 0xf0, 0x08, 0x75, 0x49, 0xc1, 0x2b,
-	0x26, 0x6d, 0xeb, 0xd4, 0x7a, 0xd2, 0x3f, 0x37, 0x19, 0x1e, 0xa3, 0x43, 0x90, 0x34, 0xa4, 0x44,
-	0x50, 0xfb, 0x96, 0xae, 0xf4, 0x40, 0xd2, 0x37, 0x44, 0x50, 0x3c, 0x44, 0x07, 0x19, 0x4b, 0xa0,
-	0xb1, 0xf7, 0x34, 0xde, 0x26, 0x6a, 0x20, 0x25, 0x22, 0xac, 0x04, 0x24, 0xf6, 0x7e, 0x3b, 0x90,
-	0x12, 0xf1, 0x49, 0x40, 0x82, 0x31, 0xda, 0xd7, 0x7b, 0x0e, 0x34, 0xac, 0x63, 0x7c, 0x1f, 0xf5,
-	0x4b, 0x88, 0xb3, 0x55, 0x06, 0x4c, 0xda, 0x5d, 0x5d, 0xf8, 0x03, 0x60, 0x17, 0xdd, 0x51, 0x5f,
-	0x97, 0x4d, 0xf8, 0x95, 0x64, 0x39, 0x24, 0x76, 0x4f, 0x77, 0x0c, 0x40, 0xd2, 0xa0, 0x59, 0x68,
-	0xc8, 0x7d, 0x84, 0x90, 0x16, 0x13, 0x34, 0x6f, 0x79, 0x8a, 0x8f, 0x50, 0x4f, 0x36, 0x61, 0xce,
-	0x53, 0x61, 0x5b, 0xa7, 0x7b, 0x4a, 0x88, 0x54, 0xb8, 0x70, 0x3f, 0xa3, 0xdb, 0xba, 0xed, 0x1d,
-	0x08, 0x41, 0x52, 0x50, 0x82, 0x0b, 0x9e, 0x54, 0x39, 0xec, 0x04, 0xb7, 0x99, 0xc2, 0x05, 0xb0,
-	0x04, 0x4a, 0x23, 0xd7, 0x64, 0x66, 0xb1, 0x5c, 0xaf, 0xc0, 0xe8, 0xed, 0xca, 0x26, 0x58, 0xaf,
-	0xc0, 0x7d, 0x6c, 0xcc, 0x9c, 0xe6, 0x3c, 0x5e, 0x4e, 0x73, 0xce, 0x0b, 0xe5, 0x4c, 0xa4, 0x02,
-	0xb3, 0xba, 0x4d, 0xdc, 0x6f, 0x16, 0x1a, 0xeb, 0xce, 0x45, 0xc5, 0x02, 0xbe, 0x04, 0xb6, 0x28,
-	0x79, 0x31, 0x25, 0x6c, 0x39, 0xe3, 0x19, 0xc3, 0x0f, 0x10, 0x8a, 0x08, 0x5b, 0x86, 0x09, 0xb0,
-	0x9b, 0xc1, 0xbe, 0x42, 0x5e, 0x2b, 0x00, 0x3f, 0x47, 0x23, 0x28, 0xe3, 0xb3, 0xa7, 0x61, 0xcc,
-	0x99, 0x2c, 0x49, 0x2c, 0x43, 0x92, 0x24, 0x25, 0x08, 0x61, 0x68, 0x0e, 0x75, 0x75, 0x66, 0x8a,
-	0xaf, 0xda, 0x1a, 0xb6, 0x51, 0x2f, 0x2e, 0x81, 0x48, 0x5e, 0x1a, 0xd2, 0xbb, 0xd4, 0xbd, 0xb2,
-	0xd0, 0x48, 0x93, 0xf9, 0x08, 0x2c, 0xd9, 0x11, 0x0a, 0xf8, 0xbc, 0x2e, 0xfe, 0x72, 0xc0, 0xfa,
-	0xc7, 0x81, 0xff, 0xa3, 0xe0, 0xa0, 0x81, 0xe4, 0xa1, 0xfa, 0x8b, 0xaa, 0xdb, 0xd0, 0xe8, 0x4b,
-	0x3e, 0x97, 0x54, 0xb5, 0xe0, 0x0f, 0x48, 0xab, 0x0c, 0xd5, 0xd5, 0xea, 0x83, 0x19, 0x9c, 0x8d,
-	0xbd, 0xf6, 0xac, 0x3d, 0x75, 0xd6, 0x9e, 0x39, 0x6b, 0x4f, 0xb9, 0x34, 0xb5, 0x2f, 0xae, 0x4f,
-	0x3a, 0xbf, 0xae, 0x4f, 0xee, 0xad, 0x49, 0x91, 0xbf, 0x70, 0x6f, 0x26, 0xdd, 0xf3, 0xc3, 0xc8,
-	0x38, 0x39, 0x7d, 0x79, 0xb1, 0x71, 0xac, 0xcb, 0x8d, 0x63, 0xfd, 0xdc, 0x38, 0xd6, 0xf7, 0xad,
-	0xd3, 0xb9, 0xdc, 0x3a, 0x9d, 0xab, 0xad, 0xd3, 0xf9, 0xf2, 0x30, 0xcd, 0x24, 0xad, 0x22, 0x2f,
-	0xe6, 0x85, 0xff, 0x3e, 0x8b, 0xb2, 0xb2, 0x9a, 0x51, 0x92, 0x31, 0x9f, 0xe9, 0xd8, 0x6f, 0xd4,
-	0x7b, 0x8b, 0xba, 0xfa, 0x91, 0x3c, 0xfb, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x93, 0xb4, 0x8f, 0x71,
-	0x81, 0x03, 0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x4e, 0xd4, 0x50,
+	0x14, 0x9e, 0x0a, 0xcc, 0x30, 0x17, 0x8c, 0xa6, 0x41, 0x28, 0x44, 0x0b, 0xa9, 0x31, 0xba, 0x6a,
+	0x1d, 0x74, 0x65, 0xe2, 0xc2, 0x19, 0x87, 0xb8, 0x50, 0x63, 0xb0, 0xc6, 0xc4, 0x4d, 0x73, 0xdb,
+	0x1e, 0xdb, 0x66, 0xa6, 0xf7, 0x4c, 0x7a, 0x4f, 0x9b, 0xf2, 0x0a, 0xae, 0x7c, 0x2c, 0x12, 0x37,
+	0x2c, 0x71, 0x43, 0x0c, 0xbc, 0x81, 0x4f, 0x60, 0xee, 0xed, 0x05, 0x71, 0x6b, 0x74, 0x77, 0xce,
+	0x77, 0x7e, 0xfa, 0x7d, 0x5f, 0x4f, 0x2e, 0xdb, 0x02, 0xca, 0x03, 0x68, 0xca, 0xa0, 0x19, 0x05,
+	0xd0, 0x80, 0x20, 0xe9, 0x2f, 0x2a, 0x24, 0xb4, 0x19, 0x50, 0xee, 0x43, 0x53, 0xfa, 0xcd, 0x68,
+	0xc7, 0x4d, 0x50, 0x96, 0x28, 0x83, 0x98, 0x4b, 0x08, 0x9a, 0x51, 0x0c, 0xc4, 0x47, 0x41, 0x82,
+	0x85, 0xe8, 0x7a, 0x77, 0x36, 0x32, 0xcc, 0x50, 0x87, 0x81, 0x8a, 0x3a, 0xd4, 0xfb, 0x66, 0xb1,
+	0x5b, 0x53, 0xb5, 0x72, 0x4a, 0x39, 0x54, 0x50, 0x97, 0x61, 0x6b, 0x6f, 0xb2, 0x3e, 0x2f, 0xb1,
+	0x16, 0xe4, 0x58, 0x7b, 0xd6, 0xa3, 0xe1, 0xa1, 0xc9, 0xec, 0x6d, 0xb6, 0x0a, 0x94, 0x47, 0x39,
+	0x97, 0xb9, 0x73, 0x43, 0x57, 0x06, 0x40, 0xf9, 0x2b, 0x2e, 0x73, 0x7b, 0x83, 0xad, 0x14, 0x22,
+	0x85, 0xd6, 0x59, 0xd2, 0x78, 0x97, 0xa8, 0x81, 0x8c, 0xcb, 0xa8, 0x96, 0x90, 0x3a, 0xcb, 0xdd,
+	0x40, 0xc6, 0xe5, 0x07, 0x09, 0xa9, 0x6d, 0xb3, 0x65, 0xbd, 0x67, 0x45, 0xc3, 0x3a, 0xb6, 0xef,
+	0xb2, 0x61, 0x05, 0x49, 0xb1, 0x28, 0x40, 0x90, 0xd3, 0xd7, 0x85, 0xdf, 0x80, 0xed, 0xb1, 0x9b,
+	0xea, 0xeb, 0xd4, 0x46, 0x9f, 0x79, 0x31, 0x87, 0xd4, 0x19, 0xe8, 0x8e, 0x35, 0xa0, 0x3c, 0x6c,
+	0x0f, 0x34, 0xe4, 0x3d, 0x60, 0x4c, 0x8b, 0x09, 0xdb, 0xd7, 0x98, 0xd9, 0x5b, 0x6c, 0x40, 0x6d,
+	0x34, 0xc7, 0x4c, 0x3a, 0xd6, 0xde, 0x92, 0x12, 0x42, 0x0a, 0x97, 0xde, 0x47, 0xb6, 0xae, 0xdb,
+	0xde, 0x80, 0x94, 0x3c, 0x03, 0x25, 0xb8, 0xc4, 0xb4, 0x9e, 0xc3, 0xa5, 0xe0, 0x2e, 0x53, 0xb8,
+	0x04, 0x91, 0x42, 0x65, 0xe4, 0x9a, 0xcc, 0x2c, 0xa6, 0xa3, 0x05, 0x18, 0xbd, 0x7d, 0x6a, 0xc3,
+	0xa3, 0x05, 0x78, 0x0f, 0x8d, 0x99, 0xe3, 0x39, 0x26, 0xb3, 0xf1, 0x1c, 0xb1, 0x54, 0xce, 0xc4,
+	0x2a, 0x30, 0xab, 0xbb, 0xc4, 0xfb, 0x62, 0xb1, 0x6d, 0xdd, 0x79, 0x50, 0x8b, 0x10, 0x67, 0x20,
+	0x0e, 0x2a, 0x2c, 0xc7, 0x5c, 0xcc, 0x26, 0x58, 0x08, 0xfb, 0x1e, 0x63, 0x31, 0x17, 0xb3, 0x28,
+	0x05, 0x71, 0x35, 0x38, 0x54, 0xc8, 0x4b, 0x05, 0xd8, 0x4f, 0xd9, 0x26, 0x54, 0xc9, 0xfe, 0xe3,
+	0x28, 0x41, 0x41, 0x15, 0x4f, 0x28, 0xe2, 0x69, 0x5a, 0x81, 0x94, 0x86, 0xe6, 0x86, 0xae, 0x4e,
+	0x4c, 0xf1, 0x45, 0x57, 0xb3, 0x1d, 0x36, 0x48, 0x2a, 0xe0, 0x84, 0x95, 0x21, 0x7d, 0x99, 0x7a,
+	0xa7, 0x16, 0xdb, 0xd4, 0x64, 0xde, 0x83, 0x48, 0x2f, 0x09, 0x85, 0x38, 0x6d, 0xca, 0x6b, 0x0e,
+	0x58, 0x7f, 0x38, 0xf0, 0x77, 0x14, 0x5c, 0xb6, 0x46, 0x18, 0xa9, 0xbf, 0xa8, 0xba, 0x0d, 0x8d,
+	0x21, 0xe1, 0x94, 0x72, 0xd5, 0x62, 0xbf, 0x63, 0x5a, 0x65, 0xa4, 0xae, 0x56, 0x1f, 0xcc, 0xda,
+	0xfe, 0xb6, 0xdf, 0x9d, 0xb5, 0xaf, 0xce, 0xda, 0x37, 0x67, 0xed, 0x2b, 0x97, 0xc6, 0xce, 0xf1,
+	0xd9, 0x6e, 0xef, 0xe7, 0xd9, 0xee, 0xed, 0x23, 0x5e, 0xce, 0x9f, 0x79, 0x57, 0x93, 0xde, 0xe1,
+	0x6a, 0x6c, 0x9c, 0xf4, 0xbe, 0x5b, 0xec, 0x8e, 0x96, 0x36, 0x41, 0xd1, 0x40, 0x45, 0xd3, 0xa6,
+	0x0c, 0x51, 0x7b, 0xfc, 0x6f, 0x95, 0xed, 0xb1, 0x75, 0xc2, 0x48, 0x53, 0xb8, 0x26, 0x8d, 0x11,
+	0xaa, 0x7f, 0xfa, 0x7f, 0xb4, 0x8d, 0x9f, 0x1f, 0x9f, 0xbb, 0xd6, 0xc9, 0xb9, 0x6b, 0xfd, 0x38,
+	0x77, 0xad, 0xaf, 0x17, 0x6e, 0xef, 0xe4, 0xc2, 0xed, 0x9d, 0x5e, 0xb8, 0xbd, 0x4f, 0xf7, 0xb3,
+	0x82, 0xf2, 0x3a, 0xf6, 0x13, 0x2c, 0x83, 0xb7, 0x45, 0x5c, 0x54, 0xf5, 0x24, 0xe7, 0x85, 0x08,
+	0x84, 0x8e, 0x83, 0x56, 0xbd, 0x25, 0x71, 0x5f, 0x3f, 0x00, 0x4f, 0x7e, 0x0d, 0x00, 0x54, 0x1f,
+	0x41, 0xe0, 0x5d, 0x04, 0x00, 0x00,
 }
 
 func (m *EventEthereumTx) Marshal() (dAtA []byte, err error) {
@@ -737,6 +810,60 @@ func (m *EventSendFunTokenToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventConvertEvmToCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConvertEvmToCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConvertEvmToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToBankAddr) > 0 {
+		i -= len(m.ToBankAddr)
+		copy(dAtA[i:], m.ToBankAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToBankAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc20ContractAddress) > 0 {
+		i -= len(m.Erc20ContractAddress)
+		copy(dAtA[i:], m.Erc20ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -878,6 +1005,29 @@ func (m *EventSendFunTokenToEvm) Size() (n int) {
 	return n
 }
 
+func (m *EventConvertEvmToCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Erc20ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToBankAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BankCoin.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1793,6 +1943,185 @@ func (m *EventSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventConvertEvmToCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConvertEvmToCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBankAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToBankAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
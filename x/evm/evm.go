@@ -50,3 +50,15 @@ func NewFunToken(
 		IsMadeFromCoin: isMadeFromCoin,
 	}
 }
+
+// AssertNotPaused errors if the x/sudo root paused the conversions of the
+// [FunToken] mapping.
+func (fun FunToken) AssertNotPaused() error {
+	if fun.Paused {
+		return fmt.Errorf(
+			"conversions are paused for the FunToken mapping between ERC20 \"%s\" and bank denom \"%s\"",
+			fun.Erc20Addr, fun.BankDenom,
+		)
+	}
+	return nil
+}
@@ -4,8 +4,11 @@
 package evm
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_NibiruChain_nibiru_eth "github.com/NibiruChain/nibiru/eth"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -24,6 +27,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CreatePolicyType is the kind of permission required to deploy a contract.
+type CreatePolicyType int32
+
+const (
+	// CREATE_POLICY_TYPE_PERMISSIONLESS: Any account can deploy contracts.
+	CreatePolicyType_CREATE_POLICY_TYPE_PERMISSIONLESS CreatePolicyType = 0
+	// CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST: Only txs signed by an account of
+	// the allowlist can deploy contracts, either directly or through contracts
+	// that call CREATE or CREATE2.
+	CreatePolicyType_CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST CreatePolicyType = 1
+	// CREATE_POLICY_TYPE_FACTORY_ALLOWLIST: Only the factory contracts of the
+	// allowlist can deploy contracts, with CREATE or CREATE2. Contract creation
+	// txs are rejected.
+	CreatePolicyType_CREATE_POLICY_TYPE_FACTORY_ALLOWLIST CreatePolicyType = 2
+)
+
+var CreatePolicyType_name = map[int32]string{
+	0: "CREATE_POLICY_TYPE_PERMISSIONLESS",
+	1: "CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST",
+	2: "CREATE_POLICY_TYPE_FACTORY_ALLOWLIST",
+}
+
+var CreatePolicyType_value = map[string]int32{
+	"CREATE_POLICY_TYPE_PERMISSIONLESS":     0,
+	"CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST": 1,
+	"CREATE_POLICY_TYPE_FACTORY_ALLOWLIST":  2,
+}
+
+func (x CreatePolicyType) String() string {
+	return proto.EnumName(CreatePolicyType_name, int32(x))
+}
+
+func (CreatePolicyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98abbdadb327b7d0, []int{0}
+}
+
 // FunToken is a fungible token mapping between a bank coin and a corresponding
 // ERC-20 smart contract. Bank coins here refer to tokens like NIBI, IBC
 // coins (ICS-20), and token factory coins, which are each represented by the
@@ -37,6 +76,9 @@ type FunToken struct {
 	// the ERC-20 contract gets deployed by the module account. False if the
 	// mapping was created from an externally owned ERC-20 contract.
 	IsMadeFromCoin bool `protobuf:"varint,3,opt,name=is_made_from_coin,json=isMadeFromCoin,proto3" json:"is_made_from_coin,omitempty"`
+	// True if the x/sudo root paused conversions between the bank coin and the
+	// ERC20 of the mapping.
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *FunToken) Reset()         { *m = FunToken{} }
@@ -86,6 +128,13 @@ func (m *FunToken) GetIsMadeFromCoin() bool {
 	return false
 }
 
+func (m *FunToken) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// evm_denom represents the token denomination used to run the EVM state
@@ -105,6 +154,29 @@ type Params struct {
 	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty"`
 	// evm_channels is the list of channel identifiers from EVM compatible chains
 	EVMChannels []string `protobuf:"bytes,8,rep,name=evm_channels,json=evmChannels,proto3" json:"evm_channels,omitempty"`
+	// precompile_gas is the gas schedule charged by the custom Nibiru
+	// precompiled contracts, such as the FunToken precompile.
+	PrecompileGas PrecompileGasSchedule `protobuf:"bytes,9,opt,name=precompile_gas,json=precompileGas,proto3" json:"precompile_gas"`
+	// create_funtoken_fee is the fee paid by the sender of a
+	// "MsgCreateFunToken" to register a new FunToken mapping.
+	CreateFuntokenFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=create_funtoken_fee,json=createFuntokenFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"create_funtoken_fee"`
+	// burn_create_funtoken_fee: If true, the "create_funtoken_fee" is burned.
+	// Otherwise, it funds the community pool.
+	BurnCreateFuntokenFee bool `protobuf:"varint,11,opt,name=burn_create_funtoken_fee,json=burnCreateFuntokenFee,proto3" json:"burn_create_funtoken_fee,omitempty"`
+	// base_fee_change_denominator bounds the change of the EIP-1559 base fee
+	// between blocks: the base fee moves by at most 1/denominator per block.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,12,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// elasticity_multiplier is the ratio of the block gas limit to the gas
+	// target of a block. The base fee rises when a block uses more gas than the
+	// target and falls when it uses less.
+	ElasticityMultiplier uint32 `protobuf:"varint,13,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
+	// min_base_fee is the floor of the base fee, in units of the EVM denom per
+	// gas.
+	MinBaseFee cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_base_fee"`
+	// create_policy sets who may deploy contracts, both with contract creation
+	// txs and with the CREATE and CREATE2 opcodes. It only applies when
+	// "enable_create" is true.
+	CreatePolicy CreatePolicy `protobuf:"bytes,15,opt,name=create_policy,json=createPolicy,proto3" json:"create_policy"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -189,6 +261,180 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetPrecompileGas() PrecompileGasSchedule {
+	if m != nil {
+		return m.PrecompileGas
+	}
+	return PrecompileGasSchedule{}
+}
+
+func (m *Params) GetCreateFuntokenFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreateFuntokenFee
+	}
+	return nil
+}
+
+func (m *Params) GetBurnCreateFuntokenFee() bool {
+	if m != nil {
+		return m.BurnCreateFuntokenFee
+	}
+	return false
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.ElasticityMultiplier
+	}
+	return 0
+}
+
+func (m *Params) GetCreatePolicy() CreatePolicy {
+	if m != nil {
+		return m.CreatePolicy
+	}
+	return CreatePolicy{}
+}
+
+// PrecompileGasSchedule defines the gas charged by the custom Nibiru
+// precompiled contracts. A call to a precompile costs the base gas of the
+// method, plus a per-byte cost on the call input, plus all of the gas consumed
+// by the nested EVM calls and store operations made during execution.
+type PrecompileGasSchedule struct {
+	// base_gas is the base cost of a precompile method that has no entry in
+	// method_gas.
+	BaseGas uint64 `protobuf:"varint,1,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+	// gas_per_input_byte is the cost charged for each byte of call input.
+	GasPerInputByte uint64 `protobuf:"varint,2,opt,name=gas_per_input_byte,json=gasPerInputByte,proto3" json:"gas_per_input_byte,omitempty"`
+	// method_gas overrides base_gas for specific precompile methods.
+	MethodGas []PrecompileMethodGas `protobuf:"bytes,3,rep,name=method_gas,json=methodGas,proto3" json:"method_gas"`
+}
+
+func (m *PrecompileGasSchedule) Reset()         { *m = PrecompileGasSchedule{} }
+func (m *PrecompileGasSchedule) String() string { return proto.CompactTextString(m) }
+func (*PrecompileGasSchedule) ProtoMessage()    {}
+func (*PrecompileGasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98abbdadb327b7d0, []int{2}
+}
+func (m *PrecompileGasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileGasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileGasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileGasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileGasSchedule.Merge(m, src)
+}
+func (m *PrecompileGasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileGasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileGasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileGasSchedule proto.InternalMessageInfo
+
+func (m *PrecompileGasSchedule) GetBaseGas() uint64 {
+	if m != nil {
+		return m.BaseGas
+	}
+	return 0
+}
+
+func (m *PrecompileGasSchedule) GetGasPerInputByte() uint64 {
+	if m != nil {
+		return m.GasPerInputByte
+	}
+	return 0
+}
+
+func (m *PrecompileGasSchedule) GetMethodGas() []PrecompileMethodGas {
+	if m != nil {
+		return m.MethodGas
+	}
+	return nil
+}
+
+// PrecompileMethodGas is the base gas of a single precompile method.
+type PrecompileMethodGas struct {
+	// precompile is the hex address of the precompiled contract
+	Precompile string `protobuf:"bytes,1,opt,name=precompile,proto3" json:"precompile,omitempty"`
+	// method is the name of the method in the precompile's ABI
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// gas is the base cost of calling the method
+	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *PrecompileMethodGas) Reset()         { *m = PrecompileMethodGas{} }
+func (m *PrecompileMethodGas) String() string { return proto.CompactTextString(m) }
+func (*PrecompileMethodGas) ProtoMessage()    {}
+func (*PrecompileMethodGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98abbdadb327b7d0, []int{3}
+}
+func (m *PrecompileMethodGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileMethodGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileMethodGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileMethodGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileMethodGas.Merge(m, src)
+}
+func (m *PrecompileMethodGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileMethodGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileMethodGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileMethodGas proto.InternalMessageInfo
+
+func (m *PrecompileMethodGas) GetPrecompile() string {
+	if m != nil {
+		return m.Precompile
+	}
+	return ""
+}
+
+func (m *PrecompileMethodGas) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *PrecompileMethodGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 // State represents a single Storage key value pair item.
 type State struct {
 	// key is the stored key
@@ -201,7 +447,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{2}
+	return fileDescriptor_98abbdadb327b7d0, []int{4}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,7 +504,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{3}
+	return fileDescriptor_98abbdadb327b7d0, []int{5}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -334,7 +580,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{4}
+	return fileDescriptor_98abbdadb327b7d0, []int{6}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,7 +695,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{5}
+	return fileDescriptor_98abbdadb327b7d0, []int{7}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +736,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{6}
+	return fileDescriptor_98abbdadb327b7d0, []int{8}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +767,8 @@ var xxx_messageInfo_AccessTuple proto.InternalMessageInfo
 
 // TraceConfig holds extra parameters to trace functions.
 type TraceConfig struct {
-	// tracer is a custom javascript tracer
+	// tracer is the name of the tracer to use: "callTracer", "prestateTracer"
+	// or "4byteTracer". The struct logger is used if empty.
 	Tracer string `protobuf:"bytes,1,opt,name=tracer,proto3" json:"tracer,omitempty"`
 	// timeout overrides the default timeout of 5 seconds for JavaScript-based tracing
 	// calls
@@ -540,7 +787,9 @@ type TraceConfig struct {
 	EnableMemory bool `protobuf:"varint,11,opt,name=enable_memory,json=enableMemory,proto3" json:"enableMemory"`
 	// enable_return_data switches the capture of return data
 	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
-	// tracer_json_config configures the tracer using a JSON string
+	// tracer_json_config configures the tracer using a JSON string, such as
+	// {"onlyTopCall":true,"withLog":true} for the callTracer or
+	// {"diffMode":true} for the prestateTracer
 	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerConfig"`
 }
 
@@ -548,7 +797,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{7}
+	return fileDescriptor_98abbdadb327b7d0, []int{9}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,92 +896,183 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// CreatePolicy sets who may deploy contracts on the Nibiru EVM.
+type CreatePolicy struct {
+	// type is the kind of permission required to deploy a contract.
+	Type CreatePolicyType `protobuf:"varint,1,opt,name=type,proto3,enum=eth.evm.v1.CreatePolicyType" json:"type,omitempty"`
+	// allowlist holds the addresses of the allowed deployers or factory
+	// contracts, depending on the "type". Addresses can be given in hex (0x) or
+	// Bech32 (nibi) format. The allowlist is ignored by the permissionless
+	// policy.
+	Allowlist []string `protobuf:"bytes,2,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (m *CreatePolicy) Reset()         { *m = CreatePolicy{} }
+func (m *CreatePolicy) String() string { return proto.CompactTextString(m) }
+func (*CreatePolicy) ProtoMessage()    {}
+func (*CreatePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98abbdadb327b7d0, []int{10}
+}
+func (m *CreatePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreatePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePolicy.Merge(m, src)
+}
+func (m *CreatePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePolicy proto.InternalMessageInfo
+
+func (m *CreatePolicy) GetType() CreatePolicyType {
+	if m != nil {
+		return m.Type
+	}
+	return CreatePolicyType_CREATE_POLICY_TYPE_PERMISSIONLESS
+}
+
+func (m *CreatePolicy) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("eth.evm.v1.CreatePolicyType", CreatePolicyType_name, CreatePolicyType_value)
 	proto.RegisterType((*FunToken)(nil), "eth.evm.v1.FunToken")
 	proto.RegisterType((*Params)(nil), "eth.evm.v1.Params")
+	proto.RegisterType((*PrecompileGasSchedule)(nil), "eth.evm.v1.PrecompileGasSchedule")
+	proto.RegisterType((*PrecompileMethodGas)(nil), "eth.evm.v1.PrecompileMethodGas")
 	proto.RegisterType((*State)(nil), "eth.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "eth.evm.v1.TransactionLogs")
 	proto.RegisterType((*Log)(nil), "eth.evm.v1.Log")
 	proto.RegisterType((*TxResult)(nil), "eth.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "eth.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "eth.evm.v1.TraceConfig")
+	proto.RegisterType((*CreatePolicy)(nil), "eth.evm.v1.CreatePolicy")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/evm.proto", fileDescriptor_98abbdadb327b7d0) }
 
 var fileDescriptor_98abbdadb327b7d0 = []byte{
-	// 1135 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0xb6, 0x2c, 0xca, 0xa2, 0x56, 0x72, 0xa4, 0x6c, 0xf4, 0xcb, 0x8f, 0x4d, 0x50, 0xd3, 0x60,
-	0x2e, 0x0e, 0x90, 0x5a, 0xb5, 0x8b, 0xa2, 0x40, 0x8a, 0x14, 0x30, 0x1d, 0x07, 0x89, 0xeb, 0x04,
-	0xc6, 0xc6, 0xe9, 0xa1, 0x17, 0x62, 0x45, 0x4e, 0x28, 0xd6, 0x24, 0x57, 0xd8, 0x5d, 0xaa, 0xf4,
-	0x03, 0x14, 0xe8, 0xb1, 0xd7, 0xde, 0x72, 0xea, 0xb3, 0x04, 0x3d, 0xe5, 0x58, 0xf4, 0x40, 0x14,
-	0xce, 0xa5, 0xd5, 0xd1, 0x4f, 0x50, 0xec, 0x2e, 0x65, 0xd9, 0x29, 0x90, 0x13, 0xe7, 0xfb, 0xbe,
-	0x9d, 0x3f, 0x9c, 0x19, 0x2e, 0xd1, 0x10, 0xe4, 0x64, 0x04, 0xb3, 0x6c, 0x34, 0xdb, 0x51, 0x8f,
-	0xed, 0x29, 0x67, 0x92, 0x61, 0x04, 0x72, 0xb2, 0xad, 0xe0, 0x6c, 0xe7, 0xce, 0x30, 0x66, 0x31,
-	0xd3, 0xf4, 0x48, 0x59, 0xe6, 0x84, 0xf7, 0x5b, 0x03, 0xd9, 0x4f, 0x8a, 0xfc, 0x84, 0x9d, 0x42,
-	0x8e, 0x8f, 0x11, 0x02, 0x1e, 0xee, 0x7e, 0x1e, 0xd0, 0x28, 0xe2, 0x4e, 0x63, 0xb3, 0xb1, 0xd5,
-	0xf1, 0x77, 0xde, 0x56, 0xee, 0xca, 0x9f, 0x95, 0x7b, 0x3f, 0x4e, 0xe4, 0xa4, 0x18, 0x6f, 0x87,
-	0x2c, 0x1b, 0xbd, 0x48, 0xc6, 0x09, 0x2f, 0xf6, 0x27, 0x34, 0xc9, 0x47, 0xb9, 0xb6, 0x47, 0x2a,
-	0xd1, 0x53, 0x28, 0xf7, 0xa2, 0x88, 0x93, 0x8e, 0x0e, 0xa2, 0x4c, 0xfc, 0x29, 0x42, 0x63, 0x9a,
-	0x9f, 0x06, 0x11, 0xe4, 0x2c, 0x73, 0x56, 0x55, 0x44, 0xd2, 0x51, 0xcc, 0x63, 0x45, 0xe0, 0xfb,
-	0xe8, 0x66, 0x22, 0x82, 0x8c, 0x46, 0x10, 0xbc, 0xe6, 0x2c, 0x0b, 0x42, 0x96, 0xe4, 0x4e, 0x73,
-	0xb3, 0xb1, 0x65, 0x93, 0x1b, 0x89, 0x78, 0x4e, 0x23, 0x78, 0xc2, 0x59, 0xb6, 0xcf, 0x92, 0xdc,
-	0xfb, 0xb5, 0x89, 0xd6, 0x8e, 0x29, 0xa7, 0x99, 0xc0, 0x3b, 0xa8, 0x03, 0xb3, 0xac, 0x8e, 0x69,
-	0xaa, 0x1c, 0x5e, 0x54, 0xee, 0xe0, 0x8c, 0x66, 0xe9, 0x43, 0xef, 0x52, 0xf2, 0x88, 0x0d, 0xb3,
-	0xcc, 0x24, 0x7a, 0x84, 0xd6, 0x21, 0xa7, 0xe3, 0x14, 0x82, 0x90, 0x03, 0x95, 0xa0, 0x4b, 0xb1,
-	0x7d, 0xe7, 0xa2, 0x72, 0x87, 0xb5, 0xdb, 0x55, 0xd9, 0x23, 0x3d, 0x83, 0xf7, 0x35, 0xc4, 0x5f,
-	0xa1, 0xee, 0x42, 0xa7, 0x69, 0x6a, 0x2a, 0xf4, 0x6f, 0x5f, 0x54, 0x2e, 0xbe, 0xee, 0x4c, 0xd3,
-	0xd4, 0x23, 0xa8, 0x76, 0xa5, 0x69, 0x8a, 0xf7, 0x10, 0x82, 0x52, 0x72, 0x1a, 0x40, 0x32, 0x15,
-	0x8e, 0xb5, 0xd9, 0xdc, 0x6a, 0xfa, 0xde, 0x79, 0xe5, 0x76, 0x0e, 0x14, 0x7b, 0xf0, 0xec, 0x58,
-	0x5c, 0x54, 0xee, 0xcd, 0x3a, 0xc8, 0xe5, 0x41, 0x8f, 0x74, 0x34, 0x38, 0x48, 0xa6, 0x02, 0xef,
-	0xa2, 0xff, 0xd1, 0x34, 0x65, 0x3f, 0x06, 0x45, 0xae, 0x46, 0x06, 0xa1, 0x84, 0x28, 0x90, 0xa5,
-	0x70, 0xd6, 0x74, 0x9f, 0x6e, 0x69, 0xf1, 0xd5, 0x52, 0x3b, 0x29, 0x05, 0xfe, 0x0c, 0x61, 0x1a,
-	0xca, 0x64, 0x06, 0xc1, 0x94, 0x43, 0xc8, 0xb2, 0x69, 0x92, 0x82, 0x70, 0xda, 0x9b, 0xcd, 0xad,
-	0x0e, 0xb9, 0x69, 0x94, 0xe3, 0xa5, 0x80, 0x77, 0x51, 0x4f, 0x75, 0x2d, 0x9c, 0xd0, 0x3c, 0x87,
-	0x54, 0x38, 0xb6, 0x3a, 0xe8, 0xf7, 0xcf, 0x2b, 0xb7, 0x7b, 0xf0, 0xdd, 0xf3, 0xfd, 0x9a, 0x26,
-	0x5d, 0x98, 0x65, 0x0b, 0xf0, 0xd0, 0xfa, 0xfb, 0x8d, 0xdb, 0x38, 0xb4, 0xec, 0xd6, 0x60, 0xcd,
-	0x1b, 0xa1, 0xd6, 0x4b, 0xa9, 0xfa, 0x34, 0x40, 0xcd, 0x53, 0x38, 0x33, 0x33, 0x21, 0xca, 0xc4,
-	0x43, 0xd4, 0x9a, 0xd1, 0xb4, 0x80, 0x7a, 0xf6, 0x06, 0x78, 0x87, 0xa8, 0x7f, 0xc2, 0x69, 0x2e,
-	0x54, 0x29, 0x2c, 0x3f, 0x62, 0xb1, 0xc0, 0x18, 0x59, 0x13, 0x2a, 0x26, 0xb5, 0xaf, 0xb6, 0xf1,
-	0x3d, 0x64, 0xa5, 0x2c, 0x16, 0xce, 0xea, 0x66, 0x73, 0xab, 0xbb, 0xdb, 0xdf, 0x5e, 0x6e, 0xf3,
-	0xf6, 0x11, 0x8b, 0x89, 0x16, 0xbd, 0xdf, 0x57, 0x51, 0xf3, 0x88, 0xc5, 0xd8, 0x41, 0x6d, 0xb5,
-	0xb6, 0x20, 0x44, 0x1d, 0x63, 0x01, 0xf1, 0x6d, 0xb4, 0x26, 0xd9, 0x34, 0x09, 0x4d, 0xa0, 0x0e,
-	0xa9, 0x91, 0x4a, 0x19, 0x51, 0x49, 0xf5, 0x38, 0x7b, 0x44, 0xdb, 0xaa, 0x15, 0xe3, 0x94, 0x85,
-	0xa7, 0x41, 0x5e, 0x64, 0x63, 0xe0, 0x8e, 0xb5, 0xd9, 0xd8, 0xb2, 0xfc, 0xfe, 0xbc, 0x72, 0xbb,
-	0x9a, 0x7f, 0xa1, 0x69, 0x72, 0x15, 0xe0, 0x07, 0xa8, 0x2d, 0xcb, 0x40, 0x57, 0xdf, 0xd2, 0xdb,
-	0x78, 0x6b, 0x5e, 0xb9, 0x7d, 0xb9, 0x7c, 0xc1, 0xa7, 0x54, 0x4c, 0xc8, 0x9a, 0x2c, 0xd5, 0x13,
-	0x8f, 0x90, 0x2d, 0xcb, 0x20, 0xc9, 0x23, 0x28, 0xf5, 0x08, 0x2d, 0x7f, 0x38, 0xaf, 0xdc, 0xc1,
-	0x95, 0xe3, 0xcf, 0x94, 0x46, 0xda, 0xb2, 0xd4, 0x06, 0x7e, 0x80, 0x90, 0x29, 0x49, 0x67, 0x68,
-	0xeb, 0x0c, 0xeb, 0xf3, 0xca, 0xed, 0x68, 0x56, 0xc7, 0x5e, 0x9a, 0xd8, 0x43, 0x2d, 0x13, 0xdb,
-	0xd6, 0xb1, 0x7b, 0xf3, 0xca, 0xb5, 0x53, 0x16, 0x9b, 0x98, 0x46, 0x52, 0xad, 0xe2, 0x90, 0xb1,
-	0x19, 0x44, 0x4e, 0x47, 0x2f, 0xd1, 0x02, 0x7a, 0x3f, 0xad, 0x22, 0xfb, 0xa4, 0x24, 0x20, 0x8a,
-	0x54, 0xe2, 0x27, 0x68, 0x10, 0xb2, 0x5c, 0x72, 0x1a, 0xca, 0xe0, 0x5a, 0x6b, 0xfd, 0xbb, 0x17,
-	0x95, 0xfb, 0x7f, 0xb3, 0xb5, 0x1f, 0x9e, 0xf0, 0x48, 0x7f, 0x41, 0xed, 0xd5, 0xfd, 0x1f, 0xa2,
-	0xd6, 0x38, 0x65, 0xf5, 0xf7, 0xdf, 0x23, 0x06, 0xe0, 0x23, 0xdd, 0x35, 0x3d, 0x5f, 0x35, 0x80,
-	0xee, 0xee, 0xdd, 0xab, 0xf3, 0xfd, 0x60, 0x3d, 0xfc, 0xdb, 0xea, 0x1a, 0xba, 0xa8, 0xdc, 0x1b,
-	0x26, 0x6b, 0xed, 0xe9, 0xa9, 0xae, 0xea, 0xf5, 0x19, 0xa0, 0x26, 0x07, 0xa9, 0xc7, 0xd5, 0x23,
-	0xca, 0xc4, 0x77, 0x90, 0xcd, 0x61, 0x06, 0x5c, 0x42, 0xa4, 0xc7, 0x62, 0x93, 0x4b, 0x8c, 0x3f,
-	0x41, 0x76, 0x4c, 0x45, 0x50, 0x08, 0x88, 0xcc, 0x0c, 0x48, 0x3b, 0xa6, 0xe2, 0x95, 0x80, 0xe8,
-	0xa1, 0xf5, 0xf3, 0x1b, 0x77, 0xc5, 0xa3, 0xa8, 0xbb, 0x17, 0x86, 0x20, 0xc4, 0x49, 0x31, 0x4d,
-	0xe1, 0x23, 0xbb, 0xb5, 0x8b, 0x7a, 0x42, 0x32, 0x4e, 0x63, 0x08, 0x4e, 0xe1, 0xac, 0xde, 0x30,
-	0xb3, 0x2f, 0x35, 0xff, 0x2d, 0x9c, 0x09, 0x72, 0x15, 0xd4, 0x29, 0xfe, 0x69, 0xa2, 0xee, 0x09,
-	0xa7, 0x21, 0xec, 0xb3, 0xfc, 0x75, 0x12, 0xeb, 0x2d, 0x55, 0xb0, 0xbe, 0x78, 0x49, 0x8d, 0x54,
-	0x6e, 0x99, 0x64, 0xc0, 0x0a, 0x59, 0x7f, 0x43, 0x0b, 0xa8, 0x3c, 0x38, 0x40, 0x09, 0xa1, 0x6e,
-	0xa0, 0x45, 0x6a, 0x84, 0xbf, 0x44, 0xeb, 0x51, 0x22, 0xf4, 0x8d, 0x24, 0x24, 0x0d, 0x4f, 0xcd,
-	0xeb, 0xfb, 0x83, 0x79, 0xe5, 0xf6, 0x6a, 0xe1, 0xa5, 0xe2, 0xc9, 0x35, 0x84, 0xbf, 0x46, 0xfd,
-	0xa5, 0x9b, 0xae, 0xd6, 0x5c, 0x31, 0x3e, 0x9e, 0x57, 0xee, 0x8d, 0xcb, 0xa3, 0x5a, 0x21, 0x1f,
-	0x60, 0x35, 0xe3, 0x08, 0xc6, 0x45, 0xac, 0xd7, 0xce, 0x26, 0x06, 0x28, 0x36, 0x4d, 0xb2, 0x44,
-	0xea, 0x35, 0x6b, 0x11, 0x03, 0x54, 0x7d, 0xf5, 0x85, 0x99, 0x41, 0xc6, 0xf8, 0x99, 0xd3, 0x5d,
-	0xd6, 0x67, 0x84, 0xe7, 0x9a, 0x27, 0xd7, 0x10, 0xf6, 0x11, 0xae, 0xdd, 0x38, 0xc8, 0x82, 0xe7,
-	0x81, 0xfe, 0x78, 0x7b, 0xda, 0x57, 0x7f, 0x42, 0x46, 0x25, 0x5a, 0x7c, 0x4c, 0x25, 0x25, 0xff,
-	0x61, 0xf0, 0x37, 0x08, 0x9b, 0xb6, 0x06, 0x3f, 0x08, 0x96, 0x07, 0xa1, 0x6e, 0xbd, 0xb3, 0xae,
-	0x97, 0x5a, 0xe7, 0x37, 0xaa, 0x19, 0x09, 0x19, 0x18, 0x74, 0x28, 0x58, 0x6e, 0x98, 0x43, 0xcb,
-	0xb6, 0x06, 0xad, 0x43, 0xcb, 0x6e, 0x0f, 0xec, 0x43, 0xcb, 0x46, 0x83, 0xee, 0x65, 0x23, 0xea,
-	0x77, 0x21, 0xb7, 0x16, 0xf8, 0x4a, 0x91, 0xfe, 0xa3, 0xb7, 0xe7, 0x1b, 0x8d, 0x77, 0xe7, 0x1b,
-	0x8d, 0xbf, 0xce, 0x37, 0x1a, 0xbf, 0xbc, 0xdf, 0x58, 0x79, 0xf7, 0x7e, 0x63, 0xe5, 0x8f, 0xf7,
-	0x1b, 0x2b, 0xdf, 0xdf, 0xfb, 0xf8, 0x6f, 0xb5, 0x54, 0x3f, 0xf3, 0xf1, 0x9a, 0xfe, 0x57, 0x7f,
-	0xf1, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x04, 0x70, 0x58, 0xe5, 0x07, 0x00, 0x00,
+	// 1589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7d, 0x57, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0x8f, 0x63, 0x27, 0xb1, 0xc7, 0x4e, 0xec, 0x4c, 0x92, 0xb2, 0x7d, 0xc5, 0x74, 0x4b, 0xa5,
+	0x14, 0xa8, 0xdd, 0xa4, 0x42, 0x48, 0x45, 0x05, 0x65, 0x1d, 0x87, 0xba, 0x38, 0x89, 0x35, 0x76,
+	0x41, 0xe1, 0xb2, 0x5a, 0xaf, 0x27, 0xce, 0x92, 0x7d, 0x58, 0xfb, 0x08, 0x8e, 0x38, 0x23, 0x71,
+	0x42, 0x5c, 0xb9, 0xf5, 0x0c, 0xff, 0x03, 0xe7, 0x8a, 0x53, 0x8f, 0x88, 0x43, 0x40, 0xe5, 0x42,
+	0x39, 0xf2, 0x17, 0xf0, 0xcd, 0x37, 0xe3, 0xd8, 0x49, 0x53, 0x0e, 0xeb, 0x9d, 0xdf, 0xf7, 0x9e,
+	0xef, 0x31, 0xb3, 0x26, 0xcb, 0x3c, 0x3e, 0xac, 0xf2, 0x63, 0xaf, 0x7a, 0xbc, 0x2e, 0x5e, 0x95,
+	0x41, 0x18, 0xc4, 0x01, 0x25, 0x40, 0xad, 0x08, 0x78, 0xbc, 0x7e, 0x6d, 0xd5, 0x0e, 0x22, 0x2f,
+	0x88, 0xaa, 0x5d, 0x2b, 0xe2, 0x20, 0xd5, 0xe5, 0xb1, 0xb5, 0x5e, 0xb5, 0x03, 0xc7, 0x97, 0xb2,
+	0xd7, 0x96, 0xfb, 0x41, 0x3f, 0xc0, 0x65, 0x55, 0xac, 0x24, 0x55, 0xff, 0x25, 0x45, 0xb2, 0xdb,
+	0x89, 0xdf, 0x09, 0x8e, 0xb8, 0x4f, 0x5b, 0x84, 0xf0, 0xd0, 0xde, 0xb8, 0x6f, 0x5a, 0xbd, 0x5e,
+	0xa8, 0xa5, 0xde, 0x4e, 0xad, 0xe5, 0x8c, 0xf5, 0xe7, 0xa7, 0xe5, 0xa9, 0xdf, 0x4f, 0xcb, 0x77,
+	0xfb, 0x4e, 0x7c, 0x98, 0x74, 0x2b, 0x76, 0xe0, 0x55, 0x77, 0x9d, 0xae, 0x13, 0x26, 0xb5, 0x43,
+	0xcb, 0xf1, 0xab, 0x3e, 0xae, 0xab, 0x22, 0x90, 0xc7, 0x7c, 0xb8, 0x09, 0x8a, 0x2c, 0x87, 0x46,
+	0xc4, 0x92, 0xde, 0x24, 0xa4, 0x6b, 0xf9, 0x47, 0x66, 0x8f, 0xfb, 0x81, 0xa7, 0x4d, 0x0b, 0x8b,
+	0x2c, 0x27, 0x28, 0x5b, 0x82, 0x40, 0xef, 0x92, 0x45, 0x27, 0x32, 0x3d, 0xab, 0xc7, 0xcd, 0x83,
+	0x30, 0xf0, 0x4c, 0x11, 0xae, 0x96, 0x06, 0xa9, 0x2c, 0x5b, 0x70, 0xa2, 0x1d, 0xa0, 0x6f, 0x03,
+	0xb9, 0x06, 0x54, 0x7a, 0x85, 0xcc, 0x0e, 0xac, 0x24, 0xe2, 0x3d, 0x2d, 0x83, 0x7c, 0x85, 0xf4,
+	0x1f, 0xe7, 0xc8, 0x6c, 0xcb, 0x0a, 0x2d, 0x2f, 0xa2, 0xeb, 0x24, 0x07, 0xb9, 0x50, 0xbe, 0x64,
+	0xf4, 0xcb, 0xff, 0x9e, 0x96, 0x4b, 0x27, 0x96, 0xe7, 0x3e, 0xd4, 0xcf, 0x58, 0x3a, 0xcb, 0xc2,
+	0x5a, 0x06, 0xf0, 0x88, 0xcc, 0x73, 0xdf, 0xea, 0xba, 0xdc, 0xb4, 0x43, 0x6e, 0xc5, 0x1c, 0x43,
+	0xcc, 0x1a, 0x1a, 0xa8, 0x2d, 0x2b, 0xb5, 0x49, 0xb6, 0xce, 0x0a, 0x12, 0xd7, 0x10, 0xd2, 0x0f,
+	0x49, 0x7e, 0xc4, 0xb7, 0x5c, 0x57, 0x46, 0x6e, 0x5c, 0x01, 0x65, 0x7a, 0x5e, 0x19, 0x98, 0x3a,
+	0x23, 0x4a, 0x15, 0x00, 0xdd, 0x84, 0x4c, 0x0f, 0xe3, 0xd0, 0x32, 0xb9, 0x33, 0x88, 0x60, 0x47,
+	0xe9, 0xb5, 0xb4, 0xa1, 0xbf, 0x3c, 0x2d, 0xe7, 0xea, 0x82, 0x5a, 0x6f, 0xb4, 0x22, 0x30, 0xb2,
+	0xa8, 0x8c, 0x9c, 0x09, 0xea, 0x90, 0x5a, 0xe4, 0xc3, 0x9a, 0x6e, 0x90, 0x15, 0xb0, 0x14, 0x7c,
+	0x6d, 0x26, 0xbe, 0x28, 0x25, 0xb7, 0x63, 0xde, 0x33, 0xe3, 0x61, 0xa4, 0xcd, 0x62, 0x7e, 0x96,
+	0x90, 0xf9, 0x74, 0xcc, 0xeb, 0x0c, 0x23, 0x7a, 0x8f, 0x50, 0xcb, 0x8e, 0x9d, 0x63, 0x6e, 0x0e,
+	0x42, 0x0e, 0x95, 0x1c, 0x38, 0x2e, 0x8f, 0xb4, 0x39, 0x70, 0x9f, 0x63, 0x8b, 0x92, 0xd3, 0x1a,
+	0x33, 0xc0, 0x45, 0x41, 0x64, 0xcd, 0x3e, 0xb4, 0x7c, 0x9f, 0xbb, 0x91, 0x96, 0x15, 0x82, 0x46,
+	0x11, 0xe2, 0xcc, 0xd7, 0x3f, 0xdf, 0xa9, 0x29, 0x32, 0xcb, 0x83, 0xd0, 0x08, 0xd0, 0x5d, 0xb2,
+	0x30, 0xb6, 0x6d, 0xf6, 0xad, 0x48, 0xcb, 0x41, 0x3c, 0xf9, 0x8d, 0x5b, 0x95, 0x71, 0xaf, 0x56,
+	0xc6, 0x4e, 0x3e, 0xb5, 0xa2, 0xb6, 0x7d, 0xc8, 0x7b, 0x89, 0xcb, 0x8d, 0x8c, 0x68, 0x35, 0x36,
+	0x3f, 0x98, 0x64, 0xd2, 0x6f, 0xc8, 0x92, 0xcc, 0xbd, 0x79, 0x90, 0xf8, 0xb1, 0x68, 0x53, 0xf3,
+	0x80, 0x73, 0x8d, 0x40, 0x28, 0xf9, 0x8d, 0xab, 0x15, 0xd9, 0xf4, 0x15, 0xd1, 0xf4, 0x15, 0xd5,
+	0xf4, 0x15, 0xd1, 0x2f, 0xc6, 0x7d, 0x61, 0xec, 0xa7, 0x3f, 0xca, 0x6b, 0x13, 0x7d, 0xab, 0x26,
+	0x44, 0xbe, 0xee, 0x45, 0xbd, 0xa3, 0x6a, 0x7c, 0x32, 0xe0, 0x11, 0x2a, 0x44, 0x6c, 0x51, 0xfa,
+	0xd9, 0x56, 0x6e, 0xb6, 0xb9, 0xa8, 0xaf, 0xd6, 0x4d, 0x42, 0xdf, 0xbc, 0x2c, 0x82, 0x3c, 0xa6,
+	0x79, 0x45, 0xf0, 0x6b, 0xaf, 0x29, 0x3e, 0x22, 0xd7, 0x45, 0x48, 0x42, 0x10, 0xd3, 0xd7, 0xe7,
+	0xb2, 0xf7, 0x1c, 0xdf, 0x8a, 0x83, 0x50, 0x2b, 0x80, 0xee, 0x3c, 0xd3, 0x84, 0x08, 0x48, 0xd7,
+	0x50, 0x60, 0x6b, 0xcc, 0xa7, 0x0f, 0xc8, 0x0a, 0x77, 0xad, 0x28, 0x76, 0x6c, 0x27, 0x3e, 0x31,
+	0xbd, 0xc4, 0x8d, 0x9d, 0x81, 0xeb, 0xf0, 0x50, 0x9b, 0x47, 0xc5, 0xe5, 0x31, 0x73, 0xe7, 0x8c,
+	0x47, 0x3f, 0x21, 0x05, 0xd0, 0x37, 0x47, 0x7e, 0xb5, 0x05, 0x9c, 0x80, 0x9b, 0x6a, 0x7e, 0x57,
+	0xe4, 0xae, 0x61, 0xd3, 0x15, 0x27, 0xa8, 0x7a, 0x16, 0x14, 0xa3, 0xe1, 0xc7, 0x8c, 0x80, 0x8a,
+	0x21, 0xc3, 0xa0, 0x35, 0x32, 0xaf, 0x36, 0x3a, 0x08, 0x5c, 0xc7, 0x3e, 0xd1, 0x8a, 0x58, 0x39,
+	0x6d, 0xb2, 0x72, 0x72, 0xab, 0x2d, 0xe4, 0xab, 0x82, 0x15, 0xec, 0x09, 0xda, 0xc3, 0xcc, 0xdf,
+	0xcf, 0xca, 0xa9, 0x27, 0x99, 0xec, 0x4c, 0x69, 0x56, 0xff, 0x39, 0x45, 0x56, 0x2e, 0x2d, 0x35,
+	0xbd, 0x4a, 0xb2, 0x18, 0xa7, 0xe8, 0x0f, 0x31, 0xa9, 0x19, 0x36, 0x27, 0xb0, 0x28, 0xf8, 0x7b,
+	0x84, 0x02, 0xd5, 0x1c, 0xf0, 0xd0, 0x74, 0xfc, 0x41, 0x12, 0x9b, 0xdd, 0x13, 0x35, 0x97, 0x19,
+	0x56, 0x04, 0x4e, 0x8b, 0x87, 0x0d, 0x41, 0x37, 0x80, 0x4c, 0xb7, 0x08, 0xf1, 0x20, 0xba, 0xa0,
+	0x87, 0x96, 0xd2, 0xd8, 0x14, 0xe5, 0xcb, 0x3b, 0x6d, 0x07, 0xe5, 0xc0, 0x83, 0x0a, 0x3b, 0xe7,
+	0x8d, 0x08, 0x32, 0x66, 0x9d, 0x93, 0xa5, 0x4b, 0xa4, 0xe9, 0x2a, 0x21, 0xe3, 0x8e, 0x94, 0xc7,
+	0x0a, 0x9b, 0xa0, 0x88, 0x83, 0x49, 0x5a, 0x52, 0xc7, 0x9b, 0x42, 0xb4, 0x44, 0xd2, 0x32, 0x26,
+	0x11, 0xb8, 0x58, 0x2a, 0x37, 0x55, 0x32, 0xd3, 0x8e, 0xc5, 0xe1, 0x01, 0x02, 0x47, 0xfc, 0x44,
+	0x59, 0x14, 0x4b, 0xba, 0x4c, 0x66, 0x8e, 0x2d, 0x37, 0xe1, 0xca, 0x92, 0x04, 0xfa, 0x13, 0x52,
+	0xec, 0x84, 0x96, 0x1f, 0x89, 0xf9, 0x0c, 0xfc, 0x66, 0xd0, 0x8f, 0x28, 0x25, 0x99, 0x43, 0x2b,
+	0x3a, 0x54, 0xba, 0xb8, 0xa6, 0xb7, 0x49, 0xc6, 0x05, 0x1e, 0xe8, 0x8a, 0x24, 0x14, 0x27, 0x93,
+	0x00, 0x3a, 0x0c, 0x99, 0xfa, 0xaf, 0xd3, 0x24, 0x0d, 0x88, 0x6a, 0x64, 0x4e, 0x9c, 0xf1, 0x3c,
+	0x8a, 0x94, 0x8d, 0x11, 0x14, 0xdb, 0x89, 0x83, 0x81, 0x63, 0x4b, 0x43, 0xb0, 0x1d, 0x89, 0x84,
+	0xcb, 0x9e, 0x15, 0x5b, 0xb8, 0x9f, 0x02, 0xc3, 0xb5, 0x38, 0x1f, 0xba, 0x6e, 0x60, 0x1f, 0x99,
+	0x7e, 0xe2, 0x75, 0xa1, 0x3b, 0xc5, 0xc9, 0x9c, 0x31, 0x8a, 0xff, 0xc0, 0xf9, 0x80, 0xf4, 0x5d,
+	0x24, 0xb3, 0x49, 0x40, 0xdf, 0x27, 0x73, 0xf1, 0xd0, 0xc4, 0xe8, 0x67, 0xb0, 0x41, 0x97, 0x40,
+	0xbc, 0x18, 0x8f, 0x37, 0xf8, 0x18, 0x58, 0xe0, 0x75, 0x28, 0xde, 0xb4, 0x4a, 0xb2, 0x20, 0xed,
+	0xf8, 0x3d, 0x3e, 0xc4, 0x73, 0x2d, 0x63, 0x2c, 0x83, 0x78, 0x69, 0x42, 0xbc, 0x21, 0x78, 0x0c,
+	0x6c, 0xe2, 0x02, 0xcc, 0x13, 0x19, 0x12, 0x7a, 0x98, 0x43, 0x0f, 0xf3, 0xa0, 0x92, 0x43, 0x2a,
+	0xda, 0x1e, 0x2f, 0xa9, 0x4e, 0x66, 0xa4, 0xed, 0x2c, 0xda, 0x2e, 0x80, 0x60, 0x16, 0xf2, 0x24,
+	0x6d, 0x4a, 0x96, 0x48, 0x55, 0xc8, 0xbd, 0xe0, 0x18, 0x6e, 0x9e, 0x1c, 0x8e, 0xfc, 0x08, 0xea,
+	0xdf, 0x4e, 0x93, 0x6c, 0x67, 0xc8, 0x78, 0x04, 0x33, 0x48, 0xb7, 0x49, 0xc9, 0x0e, 0x7c, 0x08,
+	0xcc, 0x8e, 0xcd, 0x73, 0xa9, 0x35, 0xae, 0xc3, 0x51, 0xfe, 0x96, 0x3c, 0xca, 0x2f, 0x4a, 0xe8,
+	0xac, 0x38, 0x22, 0x6d, 0xaa, 0xfc, 0x43, 0x0f, 0x40, 0x7c, 0xea, 0xb2, 0x2c, 0x30, 0x09, 0x68,
+	0x13, 0xb3, 0x86, 0xf5, 0x4d, 0xe3, 0x50, 0x5e, 0x9f, 0xac, 0xef, 0x85, 0xf6, 0x30, 0xae, 0x88,
+	0x06, 0x07, 0xaf, 0x0b, 0xd2, 0xab, 0xd2, 0xd4, 0x45, 0x56, 0xb1, 0x7d, 0xa0, 0xf3, 0x42, 0x1e,
+	0x63, 0xb9, 0x0a, 0x4c, 0x2c, 0xe9, 0x35, 0x92, 0x0d, 0xf9, 0x31, 0x0f, 0xe1, 0x9e, 0xc0, 0xb2,
+	0x64, 0xd9, 0x19, 0x16, 0xb3, 0x2a, 0x06, 0x12, 0xef, 0xde, 0x59, 0x39, 0xab, 0x80, 0x9f, 0x02,
+	0x7c, 0x98, 0xf9, 0xee, 0x59, 0x79, 0x4a, 0xb7, 0x48, 0x7e, 0xd3, 0xb6, 0x21, 0xf8, 0x4e, 0x32,
+	0x80, 0x81, 0x78, 0x73, 0x6f, 0x41, 0xbf, 0x44, 0x70, 0xbc, 0x59, 0x70, 0x1a, 0x42, 0xbb, 0xab,
+	0x0e, 0x93, 0xfd, 0xa2, 0xe8, 0x9f, 0x01, 0x99, 0x4d, 0x02, 0xe5, 0xe2, 0x55, 0x9a, 0xe4, 0x61,
+	0x97, 0x36, 0xaf, 0x05, 0xfe, 0x81, 0xd3, 0xc7, 0x2e, 0x15, 0x50, 0x7d, 0xa5, 0x30, 0x85, 0x84,
+	0xef, 0xd8, 0xf1, 0x78, 0x90, 0xc4, 0x6a, 0x86, 0x46, 0x50, 0x68, 0x84, 0x9c, 0x0f, 0xb9, 0xad,
+	0x26, 0x52, 0x21, 0xfa, 0x01, 0x99, 0xef, 0x39, 0x11, 0x5e, 0xd3, 0x51, 0x6c, 0xd9, 0x47, 0x72,
+	0xfb, 0x46, 0x09, 0x82, 0x2a, 0x28, 0x46, 0x5b, 0xd0, 0xd9, 0x39, 0x44, 0x3f, 0x22, 0xc5, 0xb1,
+	0x1a, 0x46, 0x2b, 0xef, 0x5d, 0x83, 0x82, 0xe2, 0xc2, 0x99, 0x28, 0x72, 0xd8, 0x05, 0x2c, 0x6a,
+	0xdc, 0xe3, 0xdd, 0xa4, 0x8f, 0x6d, 0x97, 0x65, 0x12, 0x08, 0xaa, 0xeb, 0x78, 0x4e, 0x8c, 0x6d,
+	0x36, 0xc3, 0x24, 0x10, 0xf1, 0xa9, 0xaf, 0x08, 0x0f, 0xda, 0x2e, 0x3c, 0x91, 0xf7, 0x8e, 0x8c,
+	0x4f, 0x32, 0x76, 0x90, 0xce, 0xce, 0x21, 0x6a, 0x10, 0xaa, 0xd4, 0xa0, 0xbc, 0xe2, 0x0a, 0xc3,
+	0xe1, 0x2d, 0xa0, 0x2e, 0x8e, 0x90, 0xe4, 0x32, 0x64, 0x6e, 0x01, 0x8f, 0xbd, 0x46, 0xa1, 0x1f,
+	0x13, 0x2a, 0xd3, 0x6a, 0x7e, 0x15, 0x05, 0x70, 0x09, 0x62, 0xea, 0xf1, 0x0a, 0xca, 0x49, 0xff,
+	0x92, 0x2b, 0x4b, 0xc2, 0x4a, 0x12, 0x3d, 0x01, 0x51, 0x49, 0x81, 0x4b, 0x20, 0x53, 0x9a, 0x81,
+	0xdf, 0xb9, 0x52, 0x16, 0x7e, 0x49, 0x29, 0x7f, 0x96, 0x08, 0xb5, 0x17, 0xb6, 0x34, 0xc2, 0x13,
+	0x41, 0xea, 0x3d, 0x52, 0x98, 0xbc, 0x65, 0xe8, 0x7d, 0x92, 0x11, 0xd7, 0x34, 0x56, 0x7a, 0x61,
+	0xe3, 0xc6, 0x9b, 0x6e, 0xa3, 0x0e, 0xc8, 0x30, 0x94, 0xa4, 0x37, 0x48, 0x0e, 0xbf, 0x7e, 0x5c,
+	0x27, 0x8a, 0xd5, 0x31, 0x36, 0x26, 0xc8, 0x63, 0xf8, 0xdd, 0xef, 0x53, 0xa4, 0x74, 0x51, 0x9d,
+	0xde, 0x21, 0xb7, 0x6a, 0xac, 0xbe, 0xd9, 0xa9, 0x9b, 0xad, 0xbd, 0x66, 0xa3, 0xb6, 0x6f, 0x76,
+	0xf6, 0x5b, 0xb0, 0xae, 0xb3, 0x9d, 0x46, 0xbb, 0xdd, 0xd8, 0xdb, 0x6d, 0xd6, 0xdb, 0xed, 0xd2,
+	0x14, 0x7c, 0xb6, 0xde, 0xb9, 0x44, 0x6c, 0xab, 0xde, 0x6a, 0xee, 0xed, 0xd7, 0x99, 0xb9, 0xd9,
+	0x6c, 0xee, 0x7d, 0xd1, 0x6c, 0xb4, 0x3b, 0xa5, 0x14, 0x5d, 0x23, 0xef, 0x5c, 0x22, 0xba, 0xbd,
+	0x59, 0xeb, 0xec, 0xb1, 0xfd, 0x09, 0xc9, 0x69, 0xe3, 0xd1, 0xf3, 0x97, 0xab, 0xa9, 0x17, 0xf0,
+	0xfc, 0x09, 0xcf, 0x0f, 0x7f, 0xad, 0x4e, 0xbd, 0x80, 0xe7, 0x37, 0x78, 0xbe, 0xbc, 0xfd, 0xff,
+	0x9f, 0xde, 0x43, 0xf1, 0x87, 0xa0, 0x3b, 0x8b, 0xdf, 0xf3, 0x0f, 0xfe, 0x03, 0xdb, 0x14, 0x52,
+	0x85, 0x29, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -790,6 +1130,129 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.PrecompileGas.Equal(&that1.PrecompileGas) {
+		return false
+	}
+	if len(this.CreateFuntokenFee) != len(that1.CreateFuntokenFee) {
+		return false
+	}
+	for i := range this.CreateFuntokenFee {
+		if !this.CreateFuntokenFee[i].Equal(&that1.CreateFuntokenFee[i]) {
+			return false
+		}
+	}
+	if this.BurnCreateFuntokenFee != that1.BurnCreateFuntokenFee {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if this.ElasticityMultiplier != that1.ElasticityMultiplier {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if !this.CreatePolicy.Equal(&that1.CreatePolicy) {
+		return false
+	}
+	return true
+}
+func (this *PrecompileGasSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrecompileGasSchedule)
+	if !ok {
+		that2, ok := that.(PrecompileGasSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BaseGas != that1.BaseGas {
+		return false
+	}
+	if this.GasPerInputByte != that1.GasPerInputByte {
+		return false
+	}
+	if len(this.MethodGas) != len(that1.MethodGas) {
+		return false
+	}
+	for i := range this.MethodGas {
+		if !this.MethodGas[i].Equal(&that1.MethodGas[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PrecompileMethodGas) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrecompileMethodGas)
+	if !ok {
+		that2, ok := that.(PrecompileMethodGas)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Precompile != that1.Precompile {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if this.Gas != that1.Gas {
+		return false
+	}
+	return true
+}
+func (this *CreatePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreatePolicy)
+	if !ok {
+		that2, ok := that.(CreatePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if len(this.Allowlist) != len(that1.Allowlist) {
+		return false
+	}
+	for i := range this.Allowlist {
+		if this.Allowlist[i] != that1.Allowlist[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *FunToken) Marshal() (dAtA []byte, err error) {
@@ -812,15 +1275,25 @@ func (m *FunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.IsMadeFromCoin {
+	if m.Paused {
 		i--
-		if m.IsMadeFromCoin {
+		if m.Paused {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
+	}
+	if m.IsMadeFromCoin {
+		i--
+		if m.IsMadeFromCoin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.BankDenom) > 0 {
 		i -= len(m.BankDenom)
@@ -862,6 +1335,70 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CreatePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.ElasticityMultiplier != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ElasticityMultiplier))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BurnCreateFuntokenFee {
+		i--
+		if m.BurnCreateFuntokenFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CreateFuntokenFee) > 0 {
+		for iNdEx := len(m.CreateFuntokenFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateFuntokenFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.PrecompileGas.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if len(m.EVMChannels) > 0 {
 		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EVMChannels[iNdEx])
@@ -891,21 +1428,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if len(m.ExtraEIPs) > 0 {
-		dAtA2 := make([]byte, len(m.ExtraEIPs)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
+		var j2 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintEvm(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintEvm(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -939,6 +1476,95 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PrecompileGasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileGasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileGasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MethodGas) > 0 {
+		for iNdEx := len(m.MethodGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MethodGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasPerInputByte != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasPerInputByte))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BaseGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BaseGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrecompileMethodGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileMethodGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileMethodGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Precompile) > 0 {
+		i -= len(m.Precompile)
+		copy(dAtA[i:], m.Precompile)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Precompile)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *State) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1317,6 +1943,43 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreatePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvm(v)
 	base := offset
@@ -1343,6 +2006,9 @@ func (m *FunToken) Size() (n int) {
 	if m.IsMadeFromCoin {
 		n += 2
 	}
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -1384,6 +2050,68 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	l = m.PrecompileGas.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if len(m.CreateFuntokenFee) > 0 {
+		for _, e := range m.CreateFuntokenFee {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.BurnCreateFuntokenFee {
+		n += 2
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovEvm(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovEvm(uint64(m.ElasticityMultiplier))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.CreatePolicy.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *PrecompileGasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseGas != 0 {
+		n += 1 + sovEvm(uint64(m.BaseGas))
+	}
+	if m.GasPerInputByte != 0 {
+		n += 1 + sovEvm(uint64(m.GasPerInputByte))
+	}
+	if len(m.MethodGas) > 0 {
+		for _, e := range m.MethodGas {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrecompileMethodGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Precompile)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovEvm(uint64(m.Gas))
+	}
 	return n
 }
 
@@ -1556,6 +2284,24 @@ func (m *TraceConfig) Size() (n int) {
 	return n
 }
 
+func (m *CreatePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovEvm(uint64(m.Type))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1677,6 +2423,622 @@ func (m *FunToken) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsMadeFromCoin = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCreate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCreate = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCall = bool(v != 0)
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExtraEIPs = append(m.ExtraEIPs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvm
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvm
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
+					m.ExtraEIPs = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExtraEIPs = append(m.ExtraEIPs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EVMChannels = append(m.EVMChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrecompileGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateFuntokenFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateFuntokenFee = append(m.CreateFuntokenFee, types.Coin{})
+			if err := m.CreateFuntokenFee[len(m.CreateFuntokenFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnCreateFuntokenFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnCreateFuntokenFee = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreatePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileGasSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileGasSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileGasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
+			}
+			m.BaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerInputByte", wireType)
+			}
+			m.GasPerInputByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerInputByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodGas = append(m.MethodGas, PrecompileMethodGas{})
+			if err := m.MethodGas[len(m.MethodGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -1698,7 +3060,7 @@ func (m *FunToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PrecompileMethodGas) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1721,15 +3083,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrecompileMethodGas: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrecompileMethodGas: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1757,147 +3119,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			m.Precompile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCreate", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableCreate = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCall", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableCall = bool(v != 0)
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ExtraEIPs = append(m.ExtraEIPs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvm
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvm
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
-					m.ExtraEIPs = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ExtraEIPs = append(m.ExtraEIPs, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowUnprotectedTxs = bool(v != 0)
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1925,13 +3151,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EVMChannels", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			var stringLen uint64
+			m.Gas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -1941,24 +3167,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Gas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EVMChannels = append(m.EVMChannels, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3119,6 +4332,107 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CreatePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CreatePolicyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@
 package evm_test
 
 import (
+	"encoding/json"
 	"strconv"
 	"testing"
 
@@ -140,3 +141,39 @@ func (s *TestSuite) TestModuleAddressEVM() {
 		s.Equal(nibiAddr.String(), resp.Address)
 	}
 }
+
+func (s *TestSuite) TestTraceConfigUnmarshalJSON() {
+	for _, tc := range []struct {
+		name             string
+		json             string
+		wantTracerConfig string
+	}{
+		{
+			name:             "tracer config as object",
+			json:             `{"tracer":"callTracer","tracerConfig":{"withLog":true}}`,
+			wantTracerConfig: `{"withLog":true}`,
+		},
+		{
+			name:             "tracer config as string",
+			json:             `{"tracer":"callTracer","tracerConfig":"{\"withLog\":true}"}`,
+			wantTracerConfig: `{"withLog":true}`,
+		},
+		{
+			name:             "no tracer config",
+			json:             `{"tracer":"callTracer"}`,
+			wantTracerConfig: "",
+		},
+		{
+			name:             "null tracer config",
+			json:             `{"tracer":"callTracer","tracerConfig":null}`,
+			wantTracerConfig: "",
+		},
+	} {
+		s.Run(tc.name, func() {
+			var traceConfig evm.TraceConfig
+			s.Require().NoError(json.Unmarshal([]byte(tc.json), &traceConfig))
+			s.Equal("callTracer", traceConfig.Tracer)
+			s.Equal(tc.wantTracerConfig, traceConfig.TracerJsonConfig)
+		})
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/NibiruChain/collections"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
@@ -15,12 +17,15 @@ import (
 	"github.com/NibiruChain/nibiru/x/evm/keeper"
 )
 
-// InitGenesis initializes genesis state based on exported genesis
+// InitGenesis initializes genesis state based on exported genesis. If the
+// genesis state has an "accounts_manifest_hash", the accounts and storage of
+// the streamed export in "streamDir" are set as well.
 func InitGenesis(
 	ctx sdk.Context,
 	k *keeper.Keeper,
 	accountKeeper evm.AccountKeeper,
 	genState evm.GenesisState,
+	streamDir string,
 ) []abci.ValidatorUpdate {
 	k.SetParams(ctx, genState.Params)
 
@@ -33,46 +38,113 @@ func InitGenesis(
 
 	for _, account := range genState.Accounts {
 		address := gethcommon.HexToAddress(account.Address)
-		accAddress := sdk.AccAddress(address.Bytes())
-		// check that the EVM balance the matches the account balance
-		acc := accountKeeper.GetAccount(ctx, accAddress)
-		if acc == nil {
-			panic(fmt.Errorf("account not found for address %s", account.Address))
+		code := gethcommon.Hex2Bytes(account.Code)
+		if err := setGenesisCode(ctx, k, accountKeeper, address, code); err != nil {
+			panic(err)
 		}
 
-		ethAcct, ok := acc.(eth.EthAccountI)
-		if !ok {
-			panic(
-				fmt.Errorf("account %s must be an EthAccount interface, got %T",
-					account.Address, acc,
-				),
-			)
+		for _, storage := range account.Storage {
+			k.SetState(ctx, address, gethcommon.HexToHash(storage.Key), gethcommon.HexToHash(storage.Value).Bytes())
 		}
-		code := gethcommon.Hex2Bytes(account.Code)
-		codeHash := crypto.Keccak256Hash(code)
+	}
 
-		// we ignore the empty Code hash checking, see ethermint PR#1234
-		if len(account.Code) != 0 && !bytes.Equal(ethAcct.GetCodeHash().Bytes(), codeHash.Bytes()) {
-			s := "the evm state code doesn't match with the codehash\n"
-			panic(fmt.Sprintf("%s account: %s , evm state codehash: %v, ethAccount codehash: %v, evm state code: %s\n",
-				s, account.Address, codeHash, ethAcct.GetCodeHash(), account.Code))
+	if genState.AccountsManifestHash != "" {
+		if streamDir == "" {
+			panic(fmt.Errorf(
+				"the evm genesis has an accounts manifest hash but no genesis stream directory is set",
+			))
 		}
+		err := InitGenesisStream(ctx, k, accountKeeper, streamDir, genState.AccountsManifestHash)
+		if err != nil {
+			panic(fmt.Errorf("failed to import the evm genesis stream: %w", err))
+		}
+	}
 
-		k.SetCode(ctx, codeHash.Bytes(), code)
-
-		for _, storage := range account.Storage {
-			k.SetState(ctx, address, gethcommon.HexToHash(storage.Key), gethcommon.HexToHash(storage.Value).Bytes())
+	for _, funtoken := range genState.FuntokenMappings {
+		if err := funtoken.Validate(); err != nil {
+			panic(fmt.Errorf("invalid funtoken mapping %s: %w", funtoken.ID(), err))
 		}
+		k.FunTokens.Insert(ctx, funtoken.ID(), funtoken)
 	}
 
 	return []abci.ValidatorUpdate{}
 }
 
-// ExportGenesis exports genesis state of the EVM module
-func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak evm.AccountKeeper) *evm.GenesisState {
-	// TODO: impl ExportGenesis
-	return &evm.GenesisState{
-		Accounts: []evm.GenesisAccount{},
-		Params:   evm.Params{},
+// setGenesisCode checks that the account of "address" is an [eth.EthAccountI]
+// with the code hash of "code" and stores the bytecode.
+func setGenesisCode(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	accountKeeper evm.AccountKeeper,
+	address gethcommon.Address,
+	code []byte,
+) error {
+	acc := accountKeeper.GetAccount(ctx, sdk.AccAddress(address.Bytes()))
+	if acc == nil {
+		return fmt.Errorf("account not found for address %s", address.Hex())
+	}
+
+	ethAcct, ok := acc.(eth.EthAccountI)
+	if !ok {
+		return fmt.Errorf("account %s must be an EthAccount interface, got %T",
+			address.Hex(), acc,
+		)
+	}
+	codeHash := crypto.Keccak256Hash(code)
+
+	// we ignore the empty Code hash checking, see ethermint PR#1234
+	if len(code) != 0 && !bytes.Equal(ethAcct.GetCodeHash().Bytes(), codeHash.Bytes()) {
+		s := "the evm state code doesn't match with the codehash\n"
+		return fmt.Errorf("%s account: %s , evm state codehash: %v, ethAccount codehash: %v, evm state code: %x",
+			s, address.Hex(), codeHash, ethAcct.GetCodeHash(), code)
+	}
+
+	k.SetCode(ctx, codeHash.Bytes(), code)
+	return nil
+}
+
+// ExportGenesis exports genesis state of the EVM module. If "streamDir" is
+// set, the accounts and storage are written to chunk files of "chunkSize"
+// records in "streamDir"
+// instead of the "accounts" of the genesis state, so that the export does not
+// hold the whole EVM state in memory.
+func ExportGenesis(
+	ctx sdk.Context, k *keeper.Keeper, ak evm.AccountKeeper, streamDir string, chunkSize int,
+) *evm.GenesisState {
+	genState := &evm.GenesisState{
+		Accounts:         []evm.GenesisAccount{},
+		Params:           k.GetParams(ctx),
+		FuntokenMappings: k.FunTokens.Iterate(ctx, collections.Range[[]byte]{}).Values(),
 	}
+
+	if streamDir != "" {
+		manifestHash, err := ExportGenesisStream(ctx, k, ak, streamDir, chunkSize)
+		if err != nil {
+			panic(fmt.Errorf("failed to export the evm genesis stream: %w", err))
+		}
+		genState.AccountsManifestHash = manifestHash
+		return genState
+	}
+
+	ak.IterateAccounts(ctx, func(acc authtypes.AccountI) (stop bool) {
+		ethAcct, ok := acc.(eth.EthAccountI)
+		if !ok || ethAcct.GetCodeHash() == gethcommon.BytesToHash(evm.EmptyCodeHash) {
+			return false
+		}
+		address := ethAcct.EthAddress()
+
+		var storage evm.Storage
+		k.ForEachStorage(ctx, address, func(key, value gethcommon.Hash) bool {
+			storage = append(storage, evm.NewStateFromEthHashes(key, value))
+			return true
+		})
+
+		genState.Accounts = append(genState.Accounts, evm.GenesisAccount{
+			Address: address.Hex(),
+			Code:    gethcommon.Bytes2Hex(k.GetCode(ctx, ethAcct.GetCodeHash())),
+			Storage: storage,
+		})
+		return false
+	})
+	return genState
 }
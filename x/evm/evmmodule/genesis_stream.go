@@ -0,0 +1,310 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evmmodule
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/keeper"
+)
+
+const (
+	// GenesisManifestFile is the name of the manifest of a streamed export of
+	// the EVM accounts and storage.
+	GenesisManifestFile = "manifest.json"
+	// DefaultGenesisChunkSize is the default number of records per chunk file.
+	DefaultGenesisChunkSize = 100_000
+)
+
+// GenesisRecord is a line of a JSON-lines chunk file of a streamed genesis.
+// A record with a nil "Key" holds the bytecode of an account. The storage
+// records of an account directly follow its bytecode record.
+type GenesisRecord struct {
+	// Address is the hex encoded Ethereum address of the account.
+	Address string `json:"address"`
+	// Code is the bytecode of the account.
+	Code []byte `json:"code,omitempty"`
+	// Key is the storage slot.
+	Key []byte `json:"key,omitempty"`
+	// Value is the value of the storage slot.
+	Value []byte `json:"value,omitempty"`
+}
+
+// IsStorage returns true if the record is a storage slot.
+func (r GenesisRecord) IsStorage() bool {
+	return r.Key != nil
+}
+
+// GenesisChunk describes a chunk file of a streamed genesis.
+type GenesisChunk struct {
+	File    string `json:"file"`
+	Records uint64 `json:"records"`
+	// Sha256 is the hex encoded SHA-256 hash of the file.
+	Sha256 string `json:"sha256"`
+}
+
+// GenesisManifest lists the chunk files of a streamed genesis. Its hash is
+// stored in the "accounts_manifest_hash" of the genesis state.
+type GenesisManifest struct {
+	Chunks       []GenesisChunk `json:"chunks"`
+	NumAccounts  uint64         `json:"num_accounts"`
+	NumStorage   uint64         `json:"num_storage"`
+	ChunkRecords uint64         `json:"chunk_records"`
+}
+
+// ExportGenesisStream writes the EVM accounts with their bytecode and storage
+// to JSON-lines chunk files in "dir", with at most "chunkSize" records per
+// file. The accounts are read with store iterators, so the memory use does not
+// grow with the size of the state. It returns the hex encoded SHA-256 hash of
+// the manifest.
+func ExportGenesisStream(
+	ctx sdk.Context, k *keeper.Keeper, ak evm.AccountKeeper, dir string, chunkSize int,
+) (manifestHash string, err error) {
+	if chunkSize <= 0 {
+		return "", fmt.Errorf("chunk size must be positive, got %d", chunkSize)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	w := &chunkWriter{dir: dir, chunkSize: uint64(chunkSize)}
+	defer w.abort()
+
+	manifest := GenesisManifest{ChunkRecords: uint64(chunkSize)}
+	ak.IterateAccounts(ctx, func(acc authtypes.AccountI) (stop bool) {
+		ethAcct, ok := acc.(eth.EthAccountI)
+		if !ok || ethAcct.GetCodeHash() == gethcommon.BytesToHash(evm.EmptyCodeHash) {
+			return false
+		}
+		address := ethAcct.EthAddress()
+		err = w.write(GenesisRecord{
+			Address: address.Hex(),
+			Code:    k.GetCode(ctx, ethAcct.GetCodeHash()),
+		})
+		if err != nil {
+			return true
+		}
+		manifest.NumAccounts++
+
+		k.ForEachStorage(ctx, address, func(key, value gethcommon.Hash) bool {
+			err = w.write(GenesisRecord{
+				Address: address.Hex(),
+				Key:     key.Bytes(),
+				Value:   value.Bytes(),
+			})
+			if err != nil {
+				return false
+			}
+			manifest.NumStorage++
+			return true
+		})
+		return err != nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := w.close(); err != nil {
+		return "", err
+	}
+	manifest.Chunks = w.chunks
+
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(dir, GenesisManifestFile), bz, 0o644); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bz)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// InitGenesisStream sets the EVM accounts and storage of a streamed genesis in
+// "dir". The manifest must match "manifestHash" and every chunk file must match
+// the hash of the manifest. Each chunk is applied to a cached context that is
+// only written once the hash of the chunk is verified.
+func InitGenesisStream(
+	ctx sdk.Context, k *keeper.Keeper, ak evm.AccountKeeper, dir string, manifestHash string,
+) error {
+	bz, err := os.ReadFile(filepath.Join(dir, GenesisManifestFile))
+	if err != nil {
+		return err
+	}
+	if sum := sha256.Sum256(bz); hex.EncodeToString(sum[:]) != manifestHash {
+		return fmt.Errorf(
+			"genesis manifest hash mismatch: expected %s, got %x", manifestHash, sum,
+		)
+	}
+	var manifest GenesisManifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return fmt.Errorf("failed to decode the genesis manifest: %w", err)
+	}
+
+	var (
+		numAccounts, numStorage uint64
+		current                 gethcommon.Address
+		hasCurrent              bool
+	)
+	for _, chunk := range manifest.Chunks {
+		if filepath.Base(chunk.File) != chunk.File {
+			return fmt.Errorf("invalid genesis chunk file name %q", chunk.File)
+		}
+		f, err := os.Open(filepath.Join(dir, chunk.File))
+		if err != nil {
+			return err
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		hasher := sha256.New()
+		dec := json.NewDecoder(io.TeeReader(f, hasher))
+		var records uint64
+		for {
+			var record GenesisRecord
+			err = dec.Decode(&record)
+			if errors.Is(err, io.EOF) {
+				err = nil
+				break
+			}
+			if err != nil {
+				err = fmt.Errorf("failed to decode record %d: %w", records, err)
+				break
+			}
+			records++
+
+			if err = eth.ValidateAddress(record.Address); err != nil {
+				break
+			}
+			address := gethcommon.HexToAddress(record.Address)
+			if !record.IsStorage() {
+				if err = setGenesisCode(cacheCtx, k, ak, address, record.Code); err != nil {
+					break
+				}
+				current, hasCurrent = address, true
+				numAccounts++
+				continue
+			}
+
+			if !hasCurrent || address != current {
+				err = fmt.Errorf("storage of %s does not follow its account", record.Address)
+				break
+			}
+			if len(record.Key) != gethcommon.HashLength || len(record.Value) != gethcommon.HashLength {
+				err = fmt.Errorf("invalid storage slot length for %s", record.Address)
+				break
+			}
+			k.SetState(cacheCtx, address, gethcommon.BytesToHash(record.Key), record.Value)
+			numStorage++
+		}
+		// Drain the file so the hash covers any trailing bytes.
+		if err == nil {
+			_, err = io.Copy(hasher, f)
+		}
+		_ = f.Close()
+		if err != nil {
+			return fmt.Errorf("genesis chunk %s: %w", chunk.File, err)
+		}
+
+		if sum := hex.EncodeToString(hasher.Sum(nil)); sum != chunk.Sha256 {
+			return fmt.Errorf(
+				"genesis chunk %s hash mismatch: expected %s, got %s", chunk.File, chunk.Sha256, sum,
+			)
+		}
+		if records != chunk.Records {
+			return fmt.Errorf(
+				"genesis chunk %s has %d records, expected %d", chunk.File, records, chunk.Records,
+			)
+		}
+		writeCache()
+	}
+
+	if numAccounts != manifest.NumAccounts || numStorage != manifest.NumStorage {
+		return fmt.Errorf(
+			"genesis stream has %d accounts and %d storage slots, expected %d and %d",
+			numAccounts, numStorage, manifest.NumAccounts, manifest.NumStorage,
+		)
+	}
+	return nil
+}
+
+// chunkWriter writes records to JSON-lines files, starting a new file every
+// "chunkSize" records.
+type chunkWriter struct {
+	dir       string
+	chunkSize uint64
+	chunks    []GenesisChunk
+
+	file    *os.File
+	buf     *bufio.Writer
+	hasher  hash.Hash
+	records uint64
+}
+
+func (w *chunkWriter) write(record GenesisRecord) error {
+	if w.file == nil {
+		name := fmt.Sprintf("accounts-%06d.jsonl", len(w.chunks))
+		f, err := os.Create(filepath.Join(w.dir, name))
+		if err != nil {
+			return err
+		}
+		w.file = f
+		w.hasher = sha256.New()
+		w.buf = bufio.NewWriter(io.MultiWriter(f, w.hasher))
+		w.records = 0
+		w.chunks = append(w.chunks, GenesisChunk{File: name})
+	}
+
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := w.buf.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+	w.records++
+	if w.records == w.chunkSize {
+		return w.close()
+	}
+	return nil
+}
+
+// close flushes and closes the current chunk file, if any.
+func (w *chunkWriter) close() error {
+	if w.file == nil {
+		return nil
+	}
+	err := w.buf.Flush()
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+	w.file = nil
+	if err != nil {
+		return err
+	}
+
+	chunk := &w.chunks[len(w.chunks)-1]
+	chunk.Records = w.records
+	chunk.Sha256 = hex.EncodeToString(w.hasher.Sum(nil))
+	return nil
+}
+
+// abort closes the current chunk file without recording it.
+func (w *chunkWriter) abort() {
+	if w.file != nil {
+		_ = w.file.Close()
+		w.file = nil
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evmmodule_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmmodule"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+)
+
+type GenesisSuite struct {
+	suite.Suite
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(GenesisSuite))
+}
+
+// deployWithStorage deploys an ERC20 contract and mints tokens so that the
+// contract has storage.
+func (s *GenesisSuite) deployWithStorage(deps *evmtest.TestDeps) gethcommon.Address {
+	deployResp, err := evmtest.DeployContract(
+		deps, embeds.SmartContract_ERC20Minter, s.T(), "erc20name", "TOKEN", uint8(18),
+	)
+	s.Require().NoError(err)
+	contract := deployResp.ContractAddr
+	_, err = deps.K.ERC20().Mint(contract, deps.Sender.EthAddr, deps.Sender.EthAddr, big.NewInt(1_000), deps.Ctx)
+	s.Require().NoError(err)
+	return contract
+}
+
+func storageOf(deps *evmtest.TestDeps, addr gethcommon.Address) map[gethcommon.Hash]gethcommon.Hash {
+	storage := make(map[gethcommon.Hash]gethcommon.Hash)
+	deps.K.ForEachStorage(deps.Ctx, addr, func(key, value gethcommon.Hash) bool {
+		storage[key] = value
+		return true
+	})
+	return storage
+}
+
+// wipeContract deletes the bytecode and storage of a contract, keeping its
+// account.
+func wipeContract(deps *evmtest.TestDeps, addr gethcommon.Address) {
+	for key := range storageOf(deps, addr) {
+		deps.K.SetState(deps.Ctx, addr, key, nil)
+	}
+	codeHash := deps.K.GetAccount(deps.Ctx, addr).CodeHash
+	deps.K.SetCode(deps.Ctx, codeHash, nil)
+}
+
+func (s *GenesisSuite) TestExportGenesis() {
+	deps := evmtest.NewTestDeps()
+	contract := s.deployWithStorage(&deps)
+	wantStorage := storageOf(&deps, contract)
+	s.Require().NotEmpty(wantStorage)
+
+	genState := evmmodule.ExportGenesis(deps.Ctx, &deps.K, deps.Chain.AccountKeeper, "", 0)
+	s.Require().NoError(genState.Validate())
+	s.Empty(genState.AccountsManifestHash)
+	s.Equal(deps.K.GetParams(deps.Ctx), genState.Params)
+
+	var exported *evm.GenesisAccount
+	for i := range genState.Accounts {
+		if genState.Accounts[i].Address == contract.Hex() {
+			exported = &genState.Accounts[i]
+		}
+	}
+	s.Require().NotNil(exported)
+	s.Len(exported.Storage, len(wantStorage))
+
+	wipeContract(&deps, contract)
+	evmmodule.InitGenesis(deps.Ctx, &deps.K, deps.Chain.AccountKeeper, *genState, "")
+	s.Equal(wantStorage, storageOf(&deps, contract))
+}
+
+func (s *GenesisSuite) TestGenesisStream() {
+	deps := evmtest.NewTestDeps()
+	contract := s.deployWithStorage(&deps)
+	wantStorage := storageOf(&deps, contract)
+	wantCode := deps.K.GetCode(deps.Ctx, gethcommon.BytesToHash(deps.K.GetAccount(deps.Ctx, contract).CodeHash))
+
+	dir := s.T().TempDir()
+	manifestHash, err := evmmodule.ExportGenesisStream(deps.Ctx, &deps.K, deps.Chain.AccountKeeper, dir, 2)
+	s.Require().NoError(err)
+	chunks, err := filepath.Glob(filepath.Join(dir, "accounts-*.jsonl"))
+	s.Require().NoError(err)
+	s.Greater(len(chunks), 1, "expected the records to span several chunks")
+
+	s.Run("happy: import restores code and storage", func() {
+		wipeContract(&deps, contract)
+		s.Empty(storageOf(&deps, contract))
+
+		err := evmmodule.InitGenesisStream(deps.Ctx, &deps.K, deps.Chain.AccountKeeper, dir, manifestHash)
+		s.Require().NoError(err)
+		s.Equal(wantStorage, storageOf(&deps, contract))
+		codeHash := gethcommon.BytesToHash(deps.K.GetAccount(deps.Ctx, contract).CodeHash)
+		s.Equal(wantCode, deps.K.GetCode(deps.Ctx, codeHash))
+	})
+
+	s.Run("sad: manifest hash mismatch", func() {
+		err := evmmodule.InitGenesisStream(
+			deps.Ctx, &deps.K, deps.Chain.AccountKeeper, dir, gethcommon.Hash{}.Hex()[2:],
+		)
+		s.ErrorContains(err, "genesis manifest hash mismatch")
+	})
+
+	s.Run("sad: tampered chunk", func() {
+		bz, err := os.ReadFile(chunks[len(chunks)-1])
+		s.Require().NoError(err)
+		s.Require().NoError(os.WriteFile(chunks[len(chunks)-1], append(bz, '\n'), 0o644))
+
+		err = evmmodule.InitGenesisStream(deps.Ctx, &deps.K, deps.Chain.AccountKeeper, dir, manifestHash)
+		s.ErrorContains(err, "hash mismatch")
+	})
+}
+
+func (s *GenesisSuite) TestGenesisPausedFunToken() {
+	for _, tc := range []struct {
+		name   string
+		stream bool
+	}{
+		{name: "plain genesis", stream: false},
+		{name: "streamed genesis", stream: true},
+	} {
+		s.Run(tc.name, func() {
+			deps := evmtest.NewTestDeps()
+			funtoken := evmtest.CreateFunTokenForBankCoin(&deps, "unibi", &s.Suite)
+			funtoken.Paused = true
+			deps.K.FunTokens.Insert(deps.Ctx, funtoken.ID(), funtoken)
+
+			streamDir := ""
+			if tc.stream {
+				streamDir = s.T().TempDir()
+			}
+			genState := evmmodule.ExportGenesis(deps.Ctx, &deps.K, deps.Chain.AccountKeeper, streamDir, 2)
+			s.Require().NoError(genState.Validate())
+			s.Require().Equal([]evm.FunToken{funtoken}, genState.FuntokenMappings)
+
+			s.Require().NoError(deps.K.FunTokens.Delete(deps.Ctx, funtoken.ID()))
+			evmmodule.InitGenesis(deps.Ctx, &deps.K, deps.Chain.AccountKeeper, *genState, streamDir)
+
+			got, err := deps.K.FunTokens.Get(deps.Ctx, funtoken.ID())
+			s.Require().NoError(err)
+			s.Equal(funtoken, got)
+			s.True(got.Paused)
+		})
+	}
+}
@@ -23,10 +23,11 @@ import (
 	"github.com/NibiruChain/nibiru/x/evm"
 	"github.com/NibiruChain/nibiru/x/evm/cli"
 	"github.com/NibiruChain/nibiru/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/x/evm/simulation"
 )
 
 // consensusVersion: EVM module consensus version for upgrades.
-const consensusVersion = 1
+const consensusVersion = 2
 
 var (
 	_ module.AppModule           = AppModule{}
@@ -101,34 +102,66 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 // AppModule implements an application module for the evm module.
 type AppModule struct {
 	AppModuleBasic
+	cdc    codec.Codec
 	keeper *keeper.Keeper
 	ak     evm.AccountKeeper
+	bk     evm.BankKeeper
+
+	// genesisStreamDir is the directory of the chunk files of the EVM accounts
+	// and storage in genesis import and export. If empty, the accounts are part
+	// of the genesis state.
+	genesisStreamDir string
+	// genesisChunkSize is the number of records per chunk file in genesis
+	// export.
+	genesisChunkSize int
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(k *keeper.Keeper, ak evm.AccountKeeper) AppModule {
+func NewAppModule(
+	cdc codec.Codec, k *keeper.Keeper, ak evm.AccountKeeper, bk evm.BankKeeper,
+) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
+		cdc:            cdc,
 		keeper:         k,
 		ak:             ak,
+		bk:             bk,
+
+		genesisChunkSize: DefaultGenesisChunkSize,
 	}
 }
 
+// WithGenesisStream returns a copy of the module that streams the EVM accounts
+// and storage of the genesis to and from chunk files in "dir". Exports write
+// "chunkSize" records per file, or [DefaultGenesisChunkSize] if it is zero.
+func (am AppModule) WithGenesisStream(dir string, chunkSize int) AppModule {
+	am.genesisStreamDir = dir
+	if chunkSize != 0 {
+		am.genesisChunkSize = chunkSize
+	}
+	return am
+}
+
 // Name returns the evm module's name.
 func (AppModule) Name() string {
 	return evm.ModuleName
 }
 
-// RegisterInvariants interface for registering invariants. Performs a no-op
-// as the evm module doesn't expose invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+// RegisterInvariants registers the evm module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices registers a GRPC query service to respond to the
-// module-specific GRPC queries.
+// module-specific GRPC queries, and the in-place store migrations.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	evm.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	evm.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(evm.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Errorf("failed to register the evm migration 1 to 2: %w", err))
+	}
 }
 
 // BeginBlock returns the begin block for the evm module.
@@ -147,26 +180,31 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState evm.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
-	InitGenesis(ctx, am.keeper, am.ak, genesisState)
+	InitGenesis(ctx, am.keeper, am.ak, genesisState, am.genesisStreamDir)
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the evm
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	gs := ExportGenesis(ctx, am.keeper, am.ak)
+	gs := ExportGenesis(ctx, am.keeper, am.ak, am.genesisStreamDir, am.genesisChunkSize)
 	return cdc.MustMarshalJSON(gs)
 }
 
 // RegisterStoreDecoder registers a decoder for evm module's types
-func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[evm.StoreKey] = simulation.NewDecodeStore(am.cdc)
 }
 
 // GenerateGenesisState creates a randomized GenState of the evm module.
-func (AppModule) GenerateGenesisState(_ *module.SimulationState) {
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
 }
 
 // WeightedOperations returns the all the evm module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc,
+		am.ak, am.bk, am.keeper,
+	)
 }
@@ -4,11 +4,13 @@ import (
 	"math/big"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/x/evm"
 )
 
@@ -32,6 +34,13 @@ func AssertERC20BalanceEqual(
 	assert.Equal(t, balance.String(), gotBalance.String())
 }
 
+// FundFeeForCreateFunToken: Funds "addr" with the "create_funtoken_fee" of
+// the module params so that it can pay for a "MsgCreateFunToken".
+func FundFeeForCreateFunToken(deps *TestDeps, addr sdk.AccAddress) error {
+	fee := deps.K.GetParams(deps.Ctx).CreateFuntokenFee
+	return testapp.FundAccount(deps.Chain.BankKeeper, deps.Ctx, addr, fee)
+}
+
 // CreateFunTokenForBankCoin: Uses the "TestDeps.Sender" account to create a
 // "FunToken" mapping for a new coin
 func CreateFunTokenForBankCoin(
@@ -56,6 +65,7 @@ func CreateFunTokenForBankCoin(
 	deps.Chain.BankKeeper.SetDenomMetaData(deps.Ctx, bankMetadata)
 
 	s.T().Log("happy: CreateFunToken for the bank coin")
+	s.Require().NoError(FundFeeForCreateFunToken(deps, deps.Sender.NibiruAddr))
 	createFuntokenResp, err := deps.K.CreateFunToken(
 		deps.GoCtx(),
 		&evm.MsgCreateFunToken{
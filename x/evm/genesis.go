@@ -2,6 +2,8 @@
 package evm
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/NibiruChain/nibiru/eth"
@@ -38,5 +40,11 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	if gs.AccountsManifestHash != "" {
+		if hash, err := hex.DecodeString(gs.AccountsManifestHash); err != nil || len(hash) != sha256.Size {
+			return fmt.Errorf("invalid accounts manifest hash %s", gs.AccountsManifestHash)
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -31,6 +31,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// Fungible token mappings corresponding to ERC-20 smart contract tokens.
 	FuntokenMappings []FunToken `protobuf:"bytes,3,rep,name=funtoken_mappings,json=funtokenMappings,proto3" json:"funtoken_mappings"`
+	// accounts_manifest_hash is the hex encoded SHA-256 hash of the manifest of
+	// a streamed export of the EVM accounts and storage. It is empty when all
+	// of the accounts are in "accounts".
+	AccountsManifestHash string `protobuf:"bytes,4,opt,name=accounts_manifest_hash,json=accountsManifestHash,proto3" json:"accounts_manifest_hash,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,13 @@ func (m *GenesisState) GetFuntokenMappings() []FunToken {
 	return nil
 }
 
+func (m *GenesisState) GetAccountsManifestHash() string {
+	if m != nil {
+		return m.AccountsManifestHash
+	}
+	return ""
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -161,28 +172,29 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/genesis.proto", fileDescriptor_d41c81841e3983b5) }
 
 var fileDescriptor_d41c81841e3983b5 = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x5a, 0xb5, 0xd4, 0x45, 0x40, 0xad, 0x0e, 0x51, 0x87, 0xb4, 0x2a, 0x4b, 0xa7,
-	0x98, 0x96, 0xb5, 0x0c, 0x14, 0x89, 0x4e, 0x20, 0x94, 0x32, 0xb1, 0x20, 0x37, 0x35, 0x49, 0x84,
-	0x62, 0x47, 0xb1, 0x13, 0x31, 0x70, 0x08, 0xce, 0xc1, 0x45, 0xe8, 0xd8, 0x91, 0x09, 0x50, 0x7b,
-	0x11, 0x64, 0xc7, 0x81, 0xb0, 0xfd, 0x7e, 0xfe, 0xbf, 0xf7, 0x3f, 0xfb, 0x41, 0x9b, 0xca, 0x10,
-	0xd3, 0x3c, 0xc6, 0xf9, 0x18, 0x07, 0x94, 0x51, 0x11, 0x09, 0x37, 0x49, 0xb9, 0xe4, 0x08, 0x52,
-	0x19, 0xba, 0x34, 0x8f, 0xdd, 0x7c, 0xdc, 0xeb, 0x56, 0x5c, 0xaa, 0xa4, 0x1d, 0xbd, 0x6e, 0xc0,
-	0x03, 0xae, 0x25, 0x56, 0xaa, 0xa8, 0x0e, 0xdf, 0x01, 0x3c, 0x98, 0x17, 0x9d, 0x16, 0x92, 0x48,
-	0x8a, 0xa6, 0x70, 0x9f, 0xf8, 0x3e, 0xcf, 0x98, 0x14, 0x36, 0x18, 0xd4, 0x46, 0xed, 0x49, 0xcf,
-	0xfd, 0xeb, 0xed, 0x1a, 0xef, 0x45, 0x61, 0x99, 0xd5, 0xd7, 0x9f, 0x7d, 0xcb, 0xfb, 0x25, 0xd0,
-	0x29, 0x6c, 0x24, 0x24, 0x25, 0xb1, 0xb0, 0xf7, 0x06, 0x60, 0xd4, 0x9e, 0xa0, 0x2a, 0x7b, 0xab,
-	0x6f, 0x0c, 0x63, 0x7c, 0x68, 0x0e, 0x3b, 0x8f, 0x19, 0x93, 0xfc, 0x89, 0xb2, 0x87, 0x98, 0x24,
-	0x49, 0xc4, 0x02, 0x61, 0xd7, 0x74, 0x70, 0xb7, 0x0a, 0x5f, 0x65, 0xec, 0x4e, 0x99, 0x0c, 0x7e,
-	0x5c, 0x42, 0xd7, 0x86, 0x19, 0xbe, 0xc0, 0xc3, 0xff, 0xc3, 0x21, 0x1b, 0x36, 0xc9, 0x6a, 0x95,
-	0x52, 0xa1, 0x5e, 0x02, 0x46, 0x2d, 0xaf, 0x3c, 0x22, 0x04, 0xeb, 0x3e, 0x5f, 0x51, 0x3d, 0x64,
-	0xcb, 0xd3, 0x1a, 0x4d, 0x61, 0x53, 0x48, 0x9e, 0x92, 0x80, 0x9a, 0xf8, 0x4e, 0x35, 0x5e, 0x7f,
-	0xce, 0xec, 0x48, 0x65, 0xbf, 0x7d, 0xf5, 0x9b, 0x8b, 0xc2, 0xe9, 0x95, 0xc8, 0xec, 0x7c, 0xbd,
-	0x75, 0xc0, 0x66, 0xeb, 0x80, 0xef, 0xad, 0x03, 0x5e, 0x77, 0x8e, 0xb5, 0xd9, 0x39, 0xd6, 0xc7,
-	0xce, 0xb1, 0xee, 0x4f, 0x82, 0x48, 0x86, 0xd9, 0xd2, 0xf5, 0x79, 0x8c, 0x6f, 0xa2, 0x65, 0x94,
-	0x66, 0x97, 0x21, 0x89, 0x18, 0x66, 0x5a, 0xe3, 0x67, 0xb5, 0xa2, 0x65, 0x43, 0x6f, 0xe3, 0xec,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x43, 0x29, 0x65, 0x1b, 0xe1, 0x01, 0x00, 0x00,
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x55, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x14, 0x45, 0x1b, 0x5a, 0xb5, 0xd4, 0x20, 0xa0, 0x56, 0x84, 0xa2, 0x0e, 0x6d, 0x55, 0x16, 0xa6,
+	0x98, 0x02, 0x23, 0x0c, 0x14, 0x89, 0xb2, 0x14, 0xa1, 0x94, 0x89, 0xa5, 0x72, 0x53, 0x37, 0xb1,
+	0x50, 0xec, 0x28, 0x76, 0x2a, 0x06, 0x3e, 0x82, 0xef, 0xe0, 0x4b, 0x3a, 0x76, 0x64, 0x02, 0x04,
+	0xdf, 0x81, 0x84, 0xe3, 0x38, 0x10, 0x86, 0x27, 0xbd, 0xf8, 0xde, 0xf3, 0x72, 0x9f, 0x0d, 0x1c,
+	0x22, 0x43, 0x44, 0x96, 0x11, 0x5a, 0x0e, 0x50, 0x40, 0x18, 0x11, 0x54, 0xb8, 0x71, 0xc2, 0x25,
+	0x87, 0x40, 0x29, 0xae, 0x52, 0xdc, 0xe5, 0xa0, 0x6d, 0x97, 0x5c, 0xd9, 0x91, 0x76, 0xb4, 0xed,
+	0x80, 0x07, 0x5c, 0xb7, 0x28, 0xeb, 0xf2, 0xd3, 0xfe, 0xb7, 0x05, 0xb6, 0x47, 0xf9, 0xa4, 0x89,
+	0xc4, 0x92, 0xc0, 0x33, 0xb0, 0x89, 0x7d, 0x9f, 0xa7, 0x4c, 0x0a, 0xc7, 0xea, 0x55, 0x0f, 0xb7,
+	0x8e, 0xdb, 0xee, 0xdf, 0x6c, 0xd7, 0x78, 0x2f, 0x72, 0xcb, 0xb0, 0xb6, 0x7a, 0xeb, 0x56, 0xbc,
+	0x5f, 0x02, 0x1e, 0x81, 0x7a, 0x8c, 0x13, 0x1c, 0x09, 0x67, 0xa3, 0x67, 0x29, 0x16, 0x96, 0xd9,
+	0x5b, 0xad, 0x18, 0xc6, 0xf8, 0xe0, 0x08, 0xb4, 0x16, 0x0a, 0xe5, 0x0f, 0x84, 0x4d, 0x23, 0x1c,
+	0xc7, 0x94, 0x05, 0xc2, 0xa9, 0xea, 0x1f, 0xdb, 0x65, 0xf8, 0x2a, 0x65, 0x77, 0x99, 0xc9, 0xe0,
+	0x7b, 0x05, 0x34, 0x36, 0x0c, 0x3c, 0x05, 0xfb, 0x45, 0x0c, 0x35, 0x88, 0xd1, 0x05, 0x11, 0x72,
+	0x1a, 0x62, 0x11, 0x3a, 0x35, 0x15, 0xa5, 0xe9, 0xd9, 0x85, 0x3a, 0x36, 0xe2, 0xb5, 0xd2, 0xfa,
+	0x4f, 0x60, 0xe7, 0xff, 0x4a, 0xd0, 0x01, 0x0d, 0x3c, 0x9f, 0x27, 0x44, 0x64, 0xfb, 0x67, 0x60,
+	0xf1, 0x09, 0x21, 0xa8, 0xf9, 0x7c, 0x4e, 0xf4, 0x6a, 0x4d, 0x4f, 0xf7, 0xea, 0xba, 0x1a, 0x42,
+	0xf2, 0x04, 0x07, 0xc4, 0x84, 0x6e, 0x95, 0x43, 0xeb, 0x2b, 0x1d, 0xee, 0x66, 0x89, 0x5f, 0xde,
+	0xbb, 0x8d, 0x49, 0xee, 0xf4, 0x0a, 0x64, 0x78, 0xbe, 0xfa, 0xec, 0x58, 0x6b, 0x55, 0x1f, 0xaa,
+	0x9e, 0xbf, 0x3a, 0x95, 0xb5, 0xaa, 0x57, 0x55, 0xf7, 0x07, 0x01, 0x95, 0x61, 0x3a, 0x73, 0x7d,
+	0x1e, 0xa1, 0x1b, 0x3a, 0xa3, 0x49, 0x7a, 0x19, 0x62, 0xca, 0x10, 0xd3, 0x3d, 0x7a, 0xcc, 0x1e,
+	0x76, 0x56, 0xd7, 0x6f, 0x78, 0xf2, 0x03, 0xba, 0x95, 0xbc, 0x5b, 0x17, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -205,6 +217,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountsManifestHash) > 0 {
+		i -= len(m.AccountsManifestHash)
+		copy(dAtA[i:], m.AccountsManifestHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AccountsManifestHash)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.FuntokenMappings) > 0 {
 		for iNdEx := len(m.FuntokenMappings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -328,6 +347,10 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.AccountsManifestHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -490,6 +513,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountsManifestHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountsManifestHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package evm_test
 
 import (
+	"strings"
 	"testing"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -215,6 +216,22 @@ func (s *GenesisSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid accounts manifest hash",
+			genState: &evm.GenesisState{
+				Params:               evm.DefaultParams(),
+				AccountsManifestHash: strings.Repeat("ab", 32),
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid accounts manifest hash",
+			genState: &evm.GenesisState{
+				Params:               evm.DefaultParams(),
+				AccountsManifestHash: "0xabcd",
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,62 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// checkCreatePolicy returns an error if the "create_policy" param forbids the
+// given contract deployment. Deployments made by the EVM module itself, such
+// as the ERC20 contracts of FunToken mappings, are always allowed.
+func checkCreatePolicy(
+	params evm.Params, origin, creator gethcommon.Address, nested bool,
+) error {
+	if origin == evm.ModuleAddressEVM() {
+		return nil
+	}
+	return params.CreatePolicy.CheckCreate(origin, creator, nested)
+}
+
+var _ vm.Interpreter = (*createPolicyInterpreter)(nil)
+
+// createPolicyInterpreter wraps the interpreter of the EVM to enforce the
+// "create_policy" param on the contracts deployed with CREATE and CREATE2. A
+// forbidden deployment fails like any other failed CREATE: only its own call
+// frame is reverted, its gas is consumed, and the creator gets the zero address.
+type createPolicyInterpreter struct {
+	vm.Interpreter
+	stateDB vm.StateDB
+	params  evm.Params
+	origin  gethcommon.Address
+	depth   int
+}
+
+// Run checks the call frames that run init code against the create policy
+// before passing them to the wrapped interpreter.
+func (in *createPolicyInterpreter) Run(
+	contract *vm.Contract, input []byte, static bool,
+) ([]byte, error) {
+	if in.isDeployment(contract, static) {
+		nested := in.depth > 0
+		if err := checkCreatePolicy(in.params, in.origin, contract.CallerAddress, nested); err != nil {
+			return nil, err
+		}
+	}
+	in.depth++
+	defer func() { in.depth-- }()
+	return in.Interpreter.Run(contract, input, static)
+}
+
+// isDeployment returns true if the call frame runs the init code of a new
+// contract. The EVM runs init code on the account that is being created, which
+// has no code until the init code returns. Calls to an account only run its
+// code if it has some.
+func (in *createPolicyInterpreter) isDeployment(contract *vm.Contract, static bool) bool {
+	self := contract.Address()
+	return !static &&
+		contract.CodeAddr != nil && *contract.CodeAddr == self &&
+		in.stateDB.GetCodeHash(self) == gethcommon.BytesToHash(evm.EmptyCodeHash)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+)
+
+// factoryBytecode is the init code of a minimal factory contract. Each call to
+// the factory deploys an empty contract with CREATE.
+//
+//	init:    PUSH1 0x08 PUSH1 0x0c PUSH1 0x00 CODECOPY PUSH1 0x08 PUSH1 0x00 RETURN
+//	runtime: PUSH1 0x00 PUSH1 0x00 PUSH1 0x00 CREATE STOP
+var factoryBytecode = gethcommon.FromHex("0x6008600c60003960086000f3600060006000f000")
+
+func (s *Suite) TestCreatePolicy() {
+	setPolicy := func(deps *evmtest.TestDeps, policy evm.CreatePolicy) {
+		params := deps.K.GetParams(deps.Ctx)
+		params.CreatePolicy = policy
+		s.Require().NoError(params.Validate())
+		deps.K.SetParams(deps.Ctx, params)
+	}
+	deploy := func(deps *evmtest.TestDeps, from gethcommon.Address) (gethcommon.Address, error) {
+		nonce := deps.K.GetAccNonce(deps.Ctx, from)
+		_, err := deps.K.CallContractWithInput(deps.Ctx, from, nil, true, factoryBytecode)
+		return crypto.CreateAddress(from, nonce), err
+	}
+	callFactory := func(
+		deps *evmtest.TestDeps, from, factory gethcommon.Address,
+	) error {
+		_, err := deps.K.CallContractWithInput(deps.Ctx, from, &factory, true, nil)
+		return err
+	}
+
+	s.Run("permissionless: anyone deploys directly and through factories", func() {
+		deps := evmtest.NewTestDeps()
+		factory, err := deploy(&deps, deps.Sender.EthAddr)
+		s.Require().NoError(err)
+		factoryNonce := deps.K.GetAccNonce(deps.Ctx, factory)
+		s.Require().NoError(callFactory(&deps, evmtest.NewEthAccInfo().EthAddr, factory))
+		s.Require().Equal(factoryNonce+1, deps.K.GetAccNonce(deps.Ctx, factory))
+	})
+
+	s.Run("deployer allowlist", func() {
+		deps := evmtest.NewTestDeps()
+		outsider := evmtest.NewEthAccInfo().EthAddr
+		factory, err := deploy(&deps, outsider)
+		s.Require().NoError(err)
+
+		setPolicy(&deps, evm.CreatePolicy{
+			Type:      evm.CreatePolicyType_CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST,
+			Allowlist: []string{deps.Sender.NibiruAddr.String()},
+		})
+
+		_, err = deploy(&deps, outsider)
+		s.Require().ErrorContains(err, "not on the allowlist")
+		_, err = deploy(&deps, deps.Sender.EthAddr)
+		s.Require().NoError(err)
+
+		s.T().Log("nested deployments are checked against the signer of the tx")
+		factoryNonce := deps.K.GetAccNonce(deps.Ctx, factory)
+		s.Require().NoError(callFactory(&deps, outsider, factory), "only the CREATE frame fails")
+		s.Require().Nil(deps.K.GetAccount(deps.Ctx, crypto.CreateAddress(factory, factoryNonce)))
+		s.Require().NoError(callFactory(&deps, deps.Sender.EthAddr, factory))
+		s.Require().NotNil(deps.K.GetAccount(deps.Ctx, crypto.CreateAddress(factory, factoryNonce+1)))
+	})
+
+	s.Run("factory allowlist", func() {
+		deps := evmtest.NewTestDeps()
+		factory, err := deploy(&deps, deps.Sender.EthAddr)
+		s.Require().NoError(err)
+		otherFactory, err := deploy(&deps, deps.Sender.EthAddr)
+		s.Require().NoError(err)
+
+		setPolicy(&deps, evm.CreatePolicy{
+			Type:      evm.CreatePolicyType_CREATE_POLICY_TYPE_FACTORY_ALLOWLIST,
+			Allowlist: []string{factory.Hex(), deps.Sender.EthAddr.Hex()},
+		})
+
+		s.T().Log("contract creation txs are rejected, even from listed addresses")
+		_, err = deploy(&deps, deps.Sender.EthAddr)
+		s.Require().ErrorContains(err, "only be deployed by the factories")
+
+		outsider := evmtest.NewEthAccInfo().EthAddr
+		factoryNonce := deps.K.GetAccNonce(deps.Ctx, factory)
+		s.Require().NoError(callFactory(&deps, outsider, factory))
+		s.Require().NotNil(deps.K.GetAccount(deps.Ctx, crypto.CreateAddress(factory, factoryNonce)))
+		otherNonce := deps.K.GetAccNonce(deps.Ctx, otherFactory)
+		s.Require().NoError(callFactory(&deps, outsider, otherFactory))
+		s.Require().Nil(deps.K.GetAccount(deps.Ctx, crypto.CreateAddress(otherFactory, otherNonce)))
+
+		s.T().Log("the EVM module still deploys the ERC20s of FunTokens")
+		evmtest.CreateFunTokenForBankCoin(&deps, "unibi", &s.Suite)
+	})
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"cosmossdk.io/errors"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
@@ -129,19 +131,7 @@ func (k *Keeper) CreateFunTokenFromERC20(
 	}
 
 	// 4 | Set bank coin denom metadata in state
-	bankMetadata := bank.Metadata{
-		Description: fmt.Sprintf("Bank coin representation of ERC20 token \"%s\"", erc20.String()),
-		DenomUnits: []*bank.DenomUnit{
-			{
-				Denom:    bankDenom,
-				Exponent: 0,
-			},
-		},
-		Base:    bankDenom,
-		Display: bankDenom,
-		Name:    bankDenom,
-		Symbol:  info.Symbol,
-	}
+	bankMetadata := BankMetadataForERC20(erc20, info)
 
 	err = bankMetadata.Validate()
 	if err != nil {
@@ -163,6 +153,46 @@ func (k *Keeper) CreateFunTokenFromERC20(
 	)
 }
 
+// BankMetadataForERC20 returns the bank metadata of the coin that represents
+// an ERC20 in a FunToken mapping created from the ERC20. The base denom is
+// "erc20/{contract address}". If the ERC20 has decimals, the metadata also
+// gets a display unit with that exponent, named after the ERC20 symbol when
+// the symbol is a valid denom.
+func BankMetadataForERC20(erc20 eth.HexAddr, info ERC20Metadata) bank.Metadata {
+	bankDenom := fmt.Sprintf("erc20/%s", erc20.String())
+	name := info.Name
+	if strings.TrimSpace(name) == "" {
+		name = bankDenom
+	}
+	bankMetadata := bank.Metadata{
+		Description: fmt.Sprintf("Bank coin representation of ERC20 token \"%s\"", erc20.String()),
+		DenomUnits: []*bank.DenomUnit{
+			{
+				Denom:    bankDenom,
+				Exponent: 0,
+			},
+		},
+		Base:    bankDenom,
+		Display: bankDenom,
+		Name:    name,
+		Symbol:  info.Symbol,
+	}
+	if info.Decimals == 0 {
+		return bankMetadata
+	}
+
+	displayDenom := info.Symbol
+	if sdk.ValidateDenom(displayDenom) != nil || displayDenom == bankDenom {
+		displayDenom = bankDenom + "/display"
+	}
+	bankMetadata.DenomUnits = append(bankMetadata.DenomUnits, &bank.DenomUnit{
+		Denom:    displayDenom,
+		Exponent: uint32(info.Decimals),
+	})
+	bankMetadata.Display = displayDenom
+	return bankMetadata
+}
+
 // CallContract invokes a smart contract on the method specified by [methodName]
 // using the given [args].
 //
@@ -257,12 +287,23 @@ func (k Keeper) CallContractWithInput(
 		return
 	}
 	txConfig := statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash()))
+	// Precompile calls pay the EVM gas used instead of the store gas.
+	chargeEvmGas := commit && IsPrecompileCall(ctx)
+	evmCtx := ctx
+	if chargeEvmGas {
+		evmCtx = ctx.
+			WithKVGasConfig(storetypes.GasConfig{}).
+			WithTransientKVGasConfig(storetypes.GasConfig{})
+	}
 	evmResp, err = k.ApplyEvmMsg(
-		ctx, evmMsg, evm.NewNoOpTracer(), commit, cfg, txConfig,
+		evmCtx, evmMsg, evm.NewNoOpTracer(), commit, cfg, txConfig,
 	)
 	if err != nil {
 		return
 	}
+	if chargeEvmGas {
+		ctx.GasMeter().ConsumeGas(evmResp.GasUsed, "CallContractWithInput")
+	}
 
 	if evmResp.Failed() {
 		err = fmt.Errorf("%w: EVM error: %s", err, evmResp.VmError)
@@ -272,6 +313,19 @@ func (k Keeper) CallContractWithInput(
 	return evmResp, err
 }
 
+// precompileCallKey is the context key set by [WithPrecompileCall].
+type precompileCallKey struct{}
+
+// WithPrecompileCall marks "ctx" as the context of a precompile call.
+func WithPrecompileCall(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(precompileCallKey{}, true)
+}
+
+// IsPrecompileCall returns true if "ctx" is the context of a precompile call.
+func IsPrecompileCall(ctx sdk.Context) bool {
+	return ctx.Value(precompileCallKey{}) != nil
+}
+
 func computeCommitGasLimit(
 	commit bool,
 	gasLimit uint64,
@@ -284,8 +338,9 @@ func computeCommitGasLimit(
 		return gasLimit, nil
 	}
 
-	// Create a cached context for gas estimation
-	cachedCtx, _ := ctx.CacheContext()
+	// Create a cached context for gas estimation. The estimation itself is
+	// not charged to the caller.
+	cachedCtx, _ := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
 
 	jsonArgs, err := json.Marshal(evm.JsonTxArgs{
 		From: fromAcc,
@@ -448,6 +503,9 @@ Transfer implements "ERC20.transfer"
 /// Emits a {Transfer} event.
 function transfer(address to, uint256 amount) external returns (bool);
 ```
+
+Transfer errors if the ERC20 returns false. Tokens that return nothing, like
+USDT, are accepted.
 */
 func (e erc20Calls) Transfer(
 	contract, from, to gethcommon.Address, amount *big.Int,
@@ -458,7 +516,19 @@ func (e erc20Calls) Transfer(
 		return
 	}
 	commit := true
-	return e.CallContractWithInput(ctx, from, &contract, commit, input)
+	evmResp, err = e.CallContractWithInput(ctx, from, &contract, commit, input)
+	if err != nil || len(evmResp.Ret) == 0 {
+		return evmResp, err
+	}
+
+	success := new(ERC20Bool)
+	if err = e.ABI.UnpackIntoInterface(success, "transfer", evmResp.Ret); err != nil {
+		return evmResp, errors.Wrap(err, "failed to unpack ERC20.transfer return value")
+	}
+	if !success.Value {
+		return evmResp, fmt.Errorf("ERC20.transfer returned false")
+	}
+	return evmResp, nil
 }
 
 // BalanceOf retrieves the balance of an ERC20 token for a specific account.
@@ -470,6 +540,15 @@ func (e erc20Calls) BalanceOf(
 	return e.LoadERC20BigInt(ctx, e.ABI, contract, "balanceOf", account)
 }
 
+// TotalSupply retrieves the total supply of an ERC20 token.
+// Implements "ERC20.totalSupply".
+func (e erc20Calls) TotalSupply(
+	contract gethcommon.Address,
+	ctx sdk.Context,
+) (out *big.Int, err error) {
+	return e.LoadERC20BigInt(ctx, e.ABI, contract, "totalSupply")
+}
+
 /*
 Burn implements "ERC20Burnable.burn"
 
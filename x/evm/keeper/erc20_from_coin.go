@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,6 +30,9 @@ func (k *Keeper) CreateFunTokenFromCoin(
 	if !isAlreadyCoin {
 		return funtoken, fmt.Errorf("Bank coin denom should have bank metadata for denom \"%s\"", bankDenom)
 	}
+	if err = ValidateFunTokenBankMetadata(bankCoin); err != nil {
+		return
+	}
 
 	// 3 | deploy ERC20 for metadata
 	erc20Addr, err := k.DeployERC20ForBankCoin(ctx, bankCoin)
@@ -57,6 +61,34 @@ func (k *Keeper) CreateFunTokenFromCoin(
 	)
 }
 
+// ValidateFunTokenBankMetadata: Checks that the bank metadata of a coin fully
+// describes the ERC20 that "DeployERC20ForBankCoin" deploys for it. The
+// metadata must be valid, and its display denom must be the largest denom
+// unit, since the exponent of that unit is the number of decimals of the
+// ERC20.
+func ValidateFunTokenBankMetadata(bankCoin bank.Metadata) error {
+	if err := bankCoin.Validate(); err != nil {
+		return errors.Wrapf(err, "invalid bank metadata for denom \"%s\"", bankCoin.Base)
+	}
+
+	decimals := bankCoin.DenomUnits[len(bankCoin.DenomUnits)-1].Exponent
+	if decimals > math.MaxUint8 {
+		return fmt.Errorf(
+			"bank metadata for denom \"%s\" has %d decimals, but an ERC20 has at most %d",
+			bankCoin.Base, decimals, math.MaxUint8,
+		)
+	}
+	for _, unit := range bankCoin.DenomUnits {
+		if unit.Denom == bankCoin.Display && unit.Exponent != decimals {
+			return fmt.Errorf(
+				"display denom \"%s\" of bank coin \"%s\" has exponent %d, but the ERC20 would have %d decimals",
+				bankCoin.Display, bankCoin.Base, unit.Exponent, decimals,
+			)
+		}
+	}
+	return nil
+}
+
 func (k *Keeper) DeployERC20ForBankCoin(
 	ctx sdk.Context, bankCoin bank.Metadata,
 ) (erc20Addr gethcommon.Address, err error) {
@@ -9,9 +9,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/x/evm"
 	"github.com/NibiruChain/nibiru/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/x/evm/evmtest"
@@ -44,6 +47,12 @@ func (s *Suite) TestCreateFunTokenFromERC20() {
 		info, err := deps.K.FindERC20Metadata(deps.Ctx, deployResp.ContractAddr)
 		s.NoError(err, info)
 		s.Equal(metadata, info)
+
+		s.T().Log("ERC20 queries are not charged the eth_call gas cap")
+		ctx := deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err = deps.K.FindERC20Metadata(ctx, deployResp.ContractAddr)
+		s.NoError(err)
+		s.Less(ctx.GasMeter().GasConsumed(), uint64(1_000_000))
 	}
 
 	s.T().Log("Case 2: Deploy and invoke ERC20 for info")
@@ -74,6 +83,7 @@ func (s *Suite) TestCreateFunTokenFromERC20() {
 	_, err = deps.K.Code(deps.Ctx, queryCodeReq)
 	s.NoError(err)
 
+	s.Require().NoError(evmtest.FundFeeForCreateFunToken(&deps, deps.Sender.NibiruAddr))
 	createFuntokenResp, err := deps.K.CreateFunToken(
 		deps.GoCtx(),
 		&evm.MsgCreateFunToken{
@@ -166,6 +176,7 @@ func (s *Suite) TestCreateFunTokenFromCoin() {
 	setBankDenomMetadata(deps.Ctx, deps.Chain.BankKeeper, bankDenom)
 
 	s.T().Log("happy: CreateFunToken for the bank coin")
+	s.Require().NoError(evmtest.FundFeeForCreateFunToken(&deps, deps.Sender.NibiruAddr))
 	createFuntokenResp, err := deps.K.CreateFunToken(
 		deps.GoCtx(),
 		&evm.MsgCreateFunToken{
@@ -266,6 +277,7 @@ func (s *Suite) TestSendFunTokenToEvm() {
 			s.Require().NoError(err)
 
 			// Create fun token from coin
+			s.Require().NoError(evmtest.FundFeeForCreateFunToken(&deps, deps.Sender.NibiruAddr))
 			createFunTokenResp, err := deps.K.CreateFunToken(
 				ctx,
 				&evm.MsgCreateFunToken{
@@ -328,6 +340,161 @@ func (s *Suite) TestSendFunTokenToEvm() {
 	}
 }
 
+// TestConvertEvmToCoin converts ERC20 tokens back into bank coins for both
+// kinds of "FunToken" mappings and checks that:
+//   - coin-originated: the ERC20 is burned and the escrowed coins are released
+//   - ERC20-originated: the ERC20 is escrowed and new bank coins are minted
+func (s *Suite) TestConvertEvmToCoin() {
+	s.Run("funtoken made from coin", func() {
+		deps := evmtest.NewTestDeps()
+		bankDenom := "unibi"
+		funtoken := evmtest.CreateFunTokenForBankCoin(&deps, bankDenom, &s.Suite)
+		erc20 := funtoken.Erc20Addr.ToAddr()
+		sender := deps.Sender
+		recipient := testutil.AccAddress()
+
+		coins := sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 100))
+		s.Require().NoError(testapp.FundAccount(deps.Chain.BankKeeper, deps.Ctx, sender.NibiruAddr, coins))
+		_, err := deps.K.SendFunTokenToEvm(deps.GoCtx(), &evm.MsgSendFunTokenToEvm{
+			Sender:    sender.NibiruAddr.String(),
+			BankCoin:  coins[0],
+			ToEthAddr: eth.NewHexAddr(sender.EthAddr),
+		})
+		s.Require().NoError(err)
+
+		s.T().Log("sad: amount exceeds ERC20 balance")
+		_, err = deps.K.ConvertEvmToCoin(deps.GoCtx(), &evm.MsgConvertEvmToCoin{
+			Sender:     sender.NibiruAddr.String(),
+			Erc20Addr:  funtoken.Erc20Addr,
+			Amount:     math.NewInt(101),
+			ToBankAddr: recipient.String(),
+		})
+		s.ErrorContains(err, "transfer amount exceeds balance")
+
+		s.T().Log("happy: convert part of the ERC20 balance")
+		resp, err := deps.K.ConvertEvmToCoin(deps.GoCtx(), &evm.MsgConvertEvmToCoin{
+			Sender:     sender.NibiruAddr.String(),
+			Erc20Addr:  funtoken.Erc20Addr,
+			Amount:     math.NewInt(40),
+			ToBankAddr: recipient.String(),
+		})
+		s.Require().NoError(err)
+		s.Equal(sdk.NewInt64Coin(bankDenom, 40), resp.BankCoin)
+
+		evmtest.AssertERC20BalanceEqual(s.T(), &deps, erc20, sender.EthAddr, big.NewInt(60))
+		evmtest.AssertERC20BalanceEqual(s.T(), &deps, erc20, evm.ModuleAddressEVM(), big.NewInt(0))
+		s.Equal("40", deps.Chain.BankKeeper.GetBalance(deps.Ctx, recipient, bankDenom).Amount.String())
+		evmModuleAddr := deps.Chain.AccountKeeper.GetModuleAddress(evm.ModuleName)
+		s.Equal("60", deps.Chain.BankKeeper.GetBalance(deps.Ctx, evmModuleAddr, bankDenom).Amount.String())
+		totalSupply, err := deps.K.LoadERC20BigInt(
+			deps.Ctx, embeds.Contract_ERC20Minter.ABI, erc20, "totalSupply",
+		)
+		s.Require().NoError(err)
+		s.Equal("60", totalSupply.String())
+	})
+
+	s.Run("funtoken made from ERC20", func() {
+		deps := evmtest.NewTestDeps()
+		sender := deps.Sender
+		recipient := testutil.AccAddress()
+
+		deployResp, err := evmtest.DeployContract(
+			&deps, embeds.SmartContract_ERC20Minter, s.T(), "erc20name", "TOKEN", uint8(18),
+		)
+		s.Require().NoError(err)
+		erc20 := deployResp.ContractAddr
+		_, err = deps.K.ERC20().Mint(erc20, sender.EthAddr, sender.EthAddr, big.NewInt(1_000), deps.Ctx)
+		s.Require().NoError(err)
+
+		erc20Addr := eth.NewHexAddr(erc20)
+		s.Require().NoError(evmtest.FundFeeForCreateFunToken(&deps, sender.NibiruAddr))
+		createResp, err := deps.K.CreateFunToken(deps.GoCtx(), &evm.MsgCreateFunToken{
+			FromErc20: &erc20Addr,
+			Sender:    sender.NibiruAddr.String(),
+		})
+		s.Require().NoError(err)
+		bankDenom := createResp.FuntokenMapping.BankDenom
+
+		resp, err := deps.K.ConvertEvmToCoin(deps.GoCtx(), &evm.MsgConvertEvmToCoin{
+			Sender:     sender.NibiruAddr.String(),
+			Erc20Addr:  erc20Addr,
+			Amount:     math.NewInt(250),
+			ToBankAddr: recipient.String(),
+		})
+		s.Require().NoError(err)
+		s.Equal(sdk.NewInt64Coin(bankDenom, 250), resp.BankCoin)
+
+		evmtest.AssertERC20BalanceEqual(s.T(), &deps, erc20, sender.EthAddr, big.NewInt(750))
+		evmtest.AssertERC20BalanceEqual(s.T(), &deps, erc20, evm.ModuleAddressEVM(), big.NewInt(250))
+		s.Equal("250", deps.Chain.BankKeeper.GetBalance(deps.Ctx, recipient, bankDenom).Amount.String())
+		s.Equal("250", deps.Chain.BankKeeper.GetSupply(deps.Ctx, bankDenom).Amount.String())
+	})
+
+	s.Run("sad: no funtoken mapping", func() {
+		deps := evmtest.NewTestDeps()
+		_, err := deps.K.ConvertEvmToCoin(deps.GoCtx(), &evm.MsgConvertEvmToCoin{
+			Sender:     deps.Sender.NibiruAddr.String(),
+			Erc20Addr:  eth.MustNewHexAddrFromStr("0x1234500000000000000000000000000000000000"),
+			Amount:     math.NewInt(1),
+			ToBankAddr: testutil.AccAddress().String(),
+		})
+		s.ErrorContains(err, "does not exist")
+	})
+}
+
+// feeTokenCode returns the runtime bytecode of a minimal token with a fee on
+// transfer. "transfer(to, amount)" adds "amount - 1" to a single balance and
+// returns [ret], and any other call, such as "balanceOf", returns the balance.
+func feeTokenCode(ret byte) []byte {
+	return gethcommon.FromHex(fmt.Sprintf(
+		"60003560e01c63a9059cbb14601a5760005460005260206000f3"+
+			"5b60016024350360005401600055"+"60%02x60005260206000f3",
+		ret,
+	))
+}
+
+// TestConvertEvmToCoin_TransferResult checks that ERC20-originated conversions
+// credit only the tokens the EVM module account actually received.
+func (s *Suite) TestConvertEvmToCoin_TransferResult() {
+	setup := func(ret byte) (evmtest.TestDeps, evm.FunToken) {
+		deps := evmtest.NewTestDeps()
+		erc20 := gethcommon.BytesToAddress([]byte("fee-on-transfer"))
+		db := deps.StateDB()
+		db.SetCode(erc20, feeTokenCode(ret))
+		s.Require().NoError(db.Commit())
+		return deps, evm.NewFunToken(erc20, "erc20/fee", false)
+	}
+
+	s.Run("happy: fee on transfer", func() {
+		deps, funtoken := setup(1)
+		recipient := testutil.AccAddress()
+		bankCoin, err := deps.K.ConvertErc20ToCoin(
+			deps.Ctx, funtoken, deps.Sender.EthAddr, recipient, big.NewInt(100),
+		)
+		s.Require().NoError(err)
+		s.Equal(sdk.NewInt64Coin("erc20/fee", 99), bankCoin)
+		s.Equal("99", deps.Chain.BankKeeper.GetBalance(deps.Ctx, recipient, "erc20/fee").Amount.String())
+		s.Equal("99", deps.Chain.BankKeeper.GetSupply(deps.Ctx, "erc20/fee").Amount.String())
+	})
+
+	s.Run("sad: nothing received", func() {
+		deps, funtoken := setup(1)
+		_, err := deps.K.ConvertErc20ToCoin(
+			deps.Ctx, funtoken, deps.Sender.EthAddr, testutil.AccAddress(), big.NewInt(1),
+		)
+		s.ErrorContains(err, "received no ERC20 tokens")
+	})
+
+	s.Run("sad: transfer returns false", func() {
+		deps, funtoken := setup(0)
+		_, err := deps.K.ConvertErc20ToCoin(
+			deps.Ctx, funtoken, deps.Sender.EthAddr, testutil.AccAddress(), big.NewInt(100),
+		)
+		s.ErrorContains(err, "ERC20.transfer returned false")
+		s.True(deps.Chain.BankKeeper.GetSupply(deps.Ctx, "erc20/fee").IsZero())
+	})
+}
+
 // setBankDenomMetadata utility method to set bank denom metadata required for working with coin
 func setBankDenomMetadata(ctx sdk.Context, bankKeeper bankkeeper.Keeper, bankDenom string) {
 	bankMetadata := bank.Metadata{
@@ -409,3 +576,167 @@ func (s *Suite) TestERC20Calls() {
 		evmtest.AssertERC20BalanceEqual(s.T(), &deps, contract, theEvm, big.NewInt(54_000))
 	}
 }
+
+// TestCallContractGas checks that only precompile calls are charged the EVM
+// gas used of a contract call in place of the store gas.
+func (s *Suite) TestCallContractGas() {
+	deps := evmtest.NewTestDeps()
+	funtoken := evmtest.CreateFunTokenForBankCoin(&deps, "ibc/btc", &s.Suite)
+	contract := funtoken.Erc20Addr.ToAddr()
+	theEvm := evm.ModuleAddressEVM()
+
+	s.Run("module call pays the store gas", func() {
+		ctx, _ := deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+		resp, err := deps.K.ERC20().Mint(contract, theEvm, theEvm, big.NewInt(1), ctx)
+		s.Require().NoError(err)
+		s.Less(ctx.GasMeter().GasConsumed(), resp.GasUsed)
+	})
+
+	s.Run("precompile call pays the EVM gas used", func() {
+		ctx, _ := deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+		ctx = keeper.WithPrecompileCall(ctx)
+		resp, err := deps.K.ERC20().Mint(contract, theEvm, theEvm, big.NewInt(1), ctx)
+		s.Require().NoError(err)
+		s.GreaterOrEqual(ctx.GasMeter().GasConsumed(), resp.GasUsed)
+	})
+}
+
+func (s *Suite) TestCreateFunTokenFee() {
+	createFunToken := func(deps *evmtest.TestDeps, bankDenom string) error {
+		setBankDenomMetadata(deps.Ctx, deps.Chain.BankKeeper, bankDenom)
+		_, err := deps.K.CreateFunToken(deps.GoCtx(), &evm.MsgCreateFunToken{
+			FromBankDenom: bankDenom,
+			Sender:        deps.Sender.NibiruAddr.String(),
+		})
+		return err
+	}
+
+	s.Run("sad: sender cannot pay the fee", func() {
+		deps := evmtest.NewTestDeps()
+		err := createFunToken(&deps, "sometoken")
+		s.ErrorContains(err, "unable to pay the create_funtoken_fee")
+	})
+
+	s.Run("happy: fee funds the community pool", func() {
+		deps := evmtest.NewTestDeps()
+		fee := deps.K.GetParams(deps.Ctx).CreateFuntokenFee
+		s.Require().False(fee.IsZero())
+		s.Require().NoError(evmtest.FundFeeForCreateFunToken(&deps, deps.Sender.NibiruAddr))
+		poolBefore := deps.Chain.DistrKeeper.GetFeePoolCommunityCoins(deps.Ctx)
+
+		s.Require().NoError(createFunToken(&deps, "sometoken"))
+		poolAfter := deps.Chain.DistrKeeper.GetFeePoolCommunityCoins(deps.Ctx)
+		s.Equal(sdk.NewDecCoinsFromCoins(fee...), poolAfter.Sub(poolBefore))
+		s.True(deps.Chain.BankKeeper.GetAllBalances(deps.Ctx, deps.Sender.NibiruAddr).IsZero())
+	})
+
+	s.Run("happy: fee gets burned", func() {
+		deps := evmtest.NewTestDeps()
+		params := deps.K.GetParams(deps.Ctx)
+		params.BurnCreateFuntokenFee = true
+		deps.K.SetParams(deps.Ctx, params)
+		fee := params.CreateFuntokenFee
+		s.Require().NoError(evmtest.FundFeeForCreateFunToken(&deps, deps.Sender.NibiruAddr))
+		supplyBefore := deps.Chain.BankKeeper.GetSupply(deps.Ctx, fee[0].Denom)
+		poolBefore := deps.Chain.DistrKeeper.GetFeePoolCommunityCoins(deps.Ctx)
+
+		s.Require().NoError(createFunToken(&deps, "sometoken"))
+		supplyAfter := deps.Chain.BankKeeper.GetSupply(deps.Ctx, fee[0].Denom)
+		s.Equal(fee[0], supplyBefore.Sub(supplyAfter))
+		s.Equal(poolBefore, deps.Chain.DistrKeeper.GetFeePoolCommunityCoins(deps.Ctx))
+	})
+
+	s.Run("happy: no fee", func() {
+		deps := evmtest.NewTestDeps()
+		params := deps.K.GetParams(deps.Ctx)
+		params.CreateFuntokenFee = sdk.NewCoins()
+		deps.K.SetParams(deps.Ctx, params)
+		s.Require().NoError(createFunToken(&deps, "sometoken"))
+	})
+}
+
+func (s *Suite) TestValidateFunTokenBankMetadata() {
+	bankDenom := "ibc/btc"
+	validMetadata := func() bank.Metadata {
+		return bank.Metadata{
+			DenomUnits: []*bank.DenomUnit{
+				{Denom: bankDenom, Exponent: 0},
+				{Denom: "sat", Exponent: 2},
+				{Denom: "btc", Exponent: 8},
+			},
+			Base:    bankDenom,
+			Display: "btc",
+			Name:    "Bitcoin",
+			Symbol:  "BTC",
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		metadata func() bank.Metadata
+		wantErr  string
+	}{
+		{
+			name:     "happy: display denom has the ERC20 decimals",
+			metadata: validMetadata,
+		},
+		{
+			name: "sad: display denom is not the largest unit",
+			metadata: func() bank.Metadata {
+				md := validMetadata()
+				md.Display = "sat"
+				return md
+			},
+			wantErr: "has exponent 2, but the ERC20 would have 8 decimals",
+		},
+		{
+			name: "sad: missing display denom",
+			metadata: func() bank.Metadata {
+				md := validMetadata()
+				md.Display = ""
+				return md
+			},
+			wantErr: "invalid bank metadata",
+		},
+		{
+			name: "sad: missing symbol",
+			metadata: func() bank.Metadata {
+				md := validMetadata()
+				md.Symbol = ""
+				return md
+			},
+			wantErr: "invalid bank metadata",
+		},
+		{
+			name: "sad: more decimals than an ERC20 supports",
+			metadata: func() bank.Metadata {
+				md := validMetadata()
+				md.DenomUnits[2].Exponent = 256
+				return md
+			},
+			wantErr: "an ERC20 has at most 255",
+		},
+	} {
+		s.Run(tc.name, func() {
+			err := keeper.ValidateFunTokenBankMetadata(tc.metadata())
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	s.Run("sad: CreateFunToken rejects invalid metadata", func() {
+		deps := evmtest.NewTestDeps()
+		md := validMetadata()
+		md.Display = "sat"
+		deps.Chain.BankKeeper.SetDenomMetaData(deps.Ctx, md)
+		s.Require().NoError(evmtest.FundFeeForCreateFunToken(&deps, deps.Sender.NibiruAddr))
+		_, err := deps.K.CreateFunToken(deps.GoCtx(), &evm.MsgCreateFunToken{
+			FromBankDenom: bankDenom,
+			Sender:        deps.Sender.NibiruAddr.String(),
+		})
+		s.ErrorContains(err, "but the ERC20 would have 8 decimals")
+	})
+}
@@ -40,7 +40,14 @@ type EvmState struct {
 		[]byte,
 	]
 
-	// BlockGasUsed: Gas used by Ethereum txs in the block (transient).
+	// BaseFee: EIP-1559 base fee of the current block, set in "BeginBlock".
+	BaseFee collections.Item[*big.Int]
+	// LastBlockGasUsed: Gas used by the last block, set in "EndBlock". The base
+	// fee of the next block is derived from it.
+	LastBlockGasUsed collections.Item[uint64]
+
+	// BlockGasUsed: Gas used by the Ethereum txs of the current cosmos tx
+	// (transient). The ante handler resets it before each tx.
 	BlockGasUsed collections.ItemTransient[uint64]
 	// BlockLogSize: EVM tx log size for the block (transient).
 	BlockLogSize collections.ItemTransient[uint64]
@@ -72,6 +79,14 @@ func NewEvmState(
 			collections.PairKeyEncoder(eth.KeyEncoderEthAddr, eth.KeyEncoderEthHash),
 			eth.ValueEncoderBytes,
 		),
+		BaseFee: collections.NewItem(
+			storeKey, evm.KeyPrefixBaseFee,
+			eth.ValueEncoderBigInt,
+		),
+		LastBlockGasUsed: collections.NewItem(
+			storeKey, evm.KeyPrefixLastBlockGasUsed,
+			collections.Uint64ValueEncoder,
+		),
 		BlockGasUsed: collections.NewItemTransient(
 			storeKeyTransient,
 			evm.NamespaceBlockGasUsed,
@@ -20,12 +20,15 @@ import (
 	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/x/evm"
 	"github.com/NibiruChain/nibiru/x/evm/statedb"
+	// Registers the native tracers selectable with "TraceConfig.Tracer".
+	_ "github.com/NibiruChain/nibiru/x/evm/tracers"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	gethparams "github.com/ethereum/go-ethereum/params"
@@ -283,11 +286,19 @@ func (k Keeper) EthCall(
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
 	}
+	stateOverride, blockOverrides, err := parseCallOverrides(req.StateOverrides, req.BlockOverrides)
+	if err != nil {
+		return nil, err
+	}
 	chainID := k.EthChainID(ctx)
 	cfg, err := k.GetEVMConfig(ctx, ParseProposerAddr(ctx, req.ProposerAddress), chainID)
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
 	}
+	if ctx, err = k.ApplyStateOverride(ctx, stateOverride); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	ctx = ApplyBlockOverrides(ctx, cfg, blockOverrides)
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetAccNonce(ctx, args.GetFrom())
@@ -350,6 +361,18 @@ func (k Keeper) EstimateGasForEvmCallType(
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
 	}
+	stateOverride, blockOverrides, err := parseCallOverrides(req.StateOverrides, req.BlockOverrides)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := k.GetEVMConfig(ctx, ParseProposerAddr(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, "failed to load evm config")
+	}
+	if ctx, err = k.ApplyStateOverride(ctx, stateOverride); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	ctx = ApplyBlockOverrides(ctx, cfg, blockOverrides)
 
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
@@ -378,10 +401,6 @@ func (k Keeper) EstimateGasForEvmCallType(
 	}
 
 	gasCap = hi
-	cfg, err := k.GetEVMConfig(ctx, ParseProposerAddr(ctx, req.ProposerAddress), chainID)
-	if err != nil {
-		return nil, grpcstatus.Error(grpccodes.Internal, "failed to load evm config")
-	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetAccNonce(ctx, args.GetFrom())
@@ -414,10 +433,10 @@ func (k Keeper) EstimateGasForEvmCallType(
 			msg.IsFake(),
 		)
 
-		tmpCtx := ctx
+		// Each attempt runs on a fresh cache so that state written directly by
+		// precompiles does not leak between iterations of the binary search.
+		tmpCtx, _ := ctx.CacheContext()
 		if fromType == evm.CallTypeRPC {
-			tmpCtx, _ = ctx.CacheContext()
-
 			acct := k.GetAccount(tmpCtx, msg.From())
 
 			from := msg.From()
@@ -476,6 +495,78 @@ func (k Keeper) EstimateGasForEvmCallType(
 	return &evm.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList: Implements the gRPC query for
+// "/eth.evm.v1.Query/CreateAccessList". Like geth, it runs the call with an
+// access list tracer and reruns it with the traced access list until the list
+// no longer changes. The sender, the recipient, and the precompiles are left
+// out of the list, since they are warm anyway.
+func (k Keeper) CreateAccessList(
+	goCtx context.Context, req *evm.EthCallRequest,
+) (*evm.QueryCreateAccessListResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var args evm.JsonTxArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	stateOverride, blockOverrides, err := parseCallOverrides(req.StateOverrides, req.BlockOverrides)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := k.GetEVMConfig(ctx, ParseProposerAddr(ctx, req.ProposerAddress), k.EthChainID(ctx))
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	if ctx, err = k.ApplyStateOverride(ctx, stateOverride); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	ctx = ApplyBlockOverrides(ctx, cfg, blockOverrides)
+
+	from := args.GetFrom()
+	nonce := k.GetAccNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+	to := crypto.CreateAddress(from, nonce)
+	if args.To != nil {
+		to = *args.To
+	}
+	precompiles := k.PrecompileAddrsSorted()
+	txConfig := statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash()))
+
+	var accessList gethcore.AccessList
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+	for {
+		accessList = prevTracer.AccessList()
+		args.AccessList = &accessList
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+		}
+
+		// pass false to not commit StateDB
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		res, err := k.ApplyEvmMsg(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, grpcstatus.Errorf(
+				grpccodes.Internal, "failed to apply transaction: %s", err)
+		}
+		if tracer.Equal(prevTracer) {
+			return &evm.QueryCreateAccessListResponse{
+				AccessList: evm.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -548,12 +639,7 @@ func (k Keeper) TraceTx(
 		txConfig.TxIndex++
 	}
 
-	var tracerConfig json.RawMessage
-	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
-		// ignore error. default to no traceConfig
-		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
-	}
-
+	tracerConfig := tracerJSONConfig(req.TraceConfig)
 	result, _, err := k.TraceEthTxMsg(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
 	if err != nil {
 		// error will be returned with detail status from traceTx
@@ -570,6 +656,74 @@ func (k Keeper) TraceTx(
 	}, nil
 }
 
+// TraceCall: Implements the gRPC query for "/eth.evm.v1.Query/TraceCall".
+// TraceCall traces a call built from JSON-RPC tx args, the way "EthCall" runs
+// it, on the state at the end of the requested block. The state and block
+// overrides of the request are applied first, and they are never persisted.
+func (k Keeper) TraceCall(
+	goCtx context.Context, req *evm.QueryTraceCallRequest,
+) (*evm.QueryTraceCallResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var args evm.JsonTxArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	stateOverride, blockOverrides, err := parseCallOverrides(req.StateOverrides, req.BlockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cfg, err := k.blockReplayCtx(
+		goCtx, req.BlockNumber, req.BlockTime, req.BlockHash, req.BlockMaxGas,
+		req.ProposerAddress,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if ctx, err = k.ApplyStateOverride(ctx, stateOverride); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	ctx = ApplyBlockOverrides(ctx, cfg, blockOverrides)
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetAccNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash()))
+	result, _, err := k.traceEthMsg(
+		ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerJSONConfig(req.TraceConfig),
+	)
+	if err != nil {
+		// error will be returned with detail status from traceEthMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	return &evm.QueryTraceCallResponse{Data: resultData}, nil
+}
+
+// tracerJSONConfig returns the JSON config of the tracer of a trace request,
+// or nil if it has none or it is not valid JSON.
+func tracerJSONConfig(traceConfig *evm.TraceConfig) json.RawMessage {
+	var tracerConfig json.RawMessage
+	if traceConfig != nil && traceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(traceConfig.TracerJsonConfig), &tracerConfig)
+	}
+	return tracerConfig
+}
+
 // Re-export of the default tracer timeout from go-ethereum.
 // See "geth/eth/tracers/api.go".
 const DefaultGethTraceTimeout = 5 * time.Second
@@ -586,34 +740,12 @@ func (k Keeper) TraceBlock(
 		return nil, err
 	}
 
-	// get the context of block beginning
-	contextHeight := req.BlockNumber
-	if contextHeight < 1 {
-		// 0 is a special value in `ContextWithHeight`
-		contextHeight = 1
-	}
-
-	ctx := sdk.UnwrapSDKContext(goCtx)
-	ctx = ctx.WithBlockHeight(contextHeight)
-	ctx = ctx.WithBlockTime(req.BlockTime)
-	ctx = ctx.WithHeaderHash(gethcommon.Hex2Bytes(req.BlockHash))
-
-	// to get the base fee we only need the block max gas in the consensus params
-	ctx = ctx.WithConsensusParams(&cmtproto.ConsensusParams{
-		Block: &cmtproto.BlockParams{MaxGas: req.BlockMaxGas},
-	})
-
-	chainID := k.EthChainID(ctx)
-
-	cfg, err := k.GetEVMConfig(ctx, ParseProposerAddr(ctx, req.ProposerAddress), chainID)
+	ctx, cfg, err := k.blockReplayCtx(
+		goCtx, req.BlockNumber, req.BlockTime, req.BlockHash, req.BlockMaxGas,
+		req.ProposerAddress,
+	)
 	if err != nil {
-		return nil, grpcstatus.Error(grpccodes.Internal, "failed to load evm config")
-	}
-
-	// compute and use base fee of height that is being traced
-	baseFee := k.GetBaseFee(ctx)
-	if baseFee != nil {
-		cfg.BaseFee = baseFee
+		return nil, err
 	}
 
 	signer := gethcore.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
@@ -621,13 +753,14 @@ func (k Keeper) TraceBlock(
 	results := make([]*evm.TxTraceResult, 0, txsLength)
 
 	txConfig := statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash().Bytes()))
+	tracerConfig := tracerJSONConfig(req.TraceConfig)
 
 	for i, tx := range req.Txs {
 		result := evm.TxTraceResult{}
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.TraceEthTxMsg(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.TraceEthTxMsg(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, tracerConfig)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -647,6 +780,124 @@ func (k Keeper) TraceBlock(
 	}, nil
 }
 
+// IntermediateRoots replays the EVM txs of a block and returns a state
+// commitment after each of them. Each root is the Keccak-256 hash of the
+// previous root and the hash of the EVM account and storage changes of the tx
+// (see "StateDB.StateChangesHash"), so the root of a tx commits to every EVM
+// state change of the block up to and including that tx. A tx that fails is
+// recorded with its error and changes no state, like in "TraceBlock".
+func (k Keeper) IntermediateRoots(
+	goCtx context.Context, req *evm.QueryIntermediateRootsRequest,
+) (*evm.QueryIntermediateRootsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, cfg, err := k.blockReplayCtx(
+		goCtx, req.BlockNumber, req.BlockTime, req.BlockHash, req.BlockMaxGas,
+		req.ProposerAddress,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := gethcore.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	txConfig := statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash().Bytes()))
+
+	var root gethcommon.Hash
+	roots := make([]string, 0, len(req.Txs))
+	txErrors := make([]string, 0, len(req.Txs))
+	for i, tx := range req.Txs {
+		ethTx := tx.AsTransaction()
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i)
+		stateChangesHash, logs, err := k.intermediateRootTx(ctx, cfg, txConfig, signer, ethTx)
+		if err != nil {
+			roots = append(roots, root.Hex())
+			txErrors = append(txErrors, err.Error())
+			continue
+		}
+		txConfig.LogIndex += uint(logs)
+
+		root = crypto.Keccak256Hash(root.Bytes(), stateChangesHash.Bytes())
+		roots = append(roots, root.Hex())
+		txErrors = append(txErrors, "")
+	}
+
+	return &evm.QueryIntermediateRootsResponse{Roots: roots, Errors: txErrors}, nil
+}
+
+// intermediateRootTx applies a tx of a replayed block and returns the hash of
+// its EVM state changes and its number of logs. The state is only written to
+// "ctx" if the tx applies without error.
+func (k Keeper) intermediateRootTx(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	signer gethcore.Signer,
+	ethTx *gethcore.Transaction,
+) (stateChangesHash gethcommon.Hash, logs int, err error) {
+	msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return stateChangesHash, 0, err
+	}
+
+	// Same gas setup as "TraceEthTxMsg" so that the replay matches the
+	// original execution.
+	txCtx, writeCache := ctx.CacheContext()
+	txCtx = txCtx.WithGasMeter(eth.NewInfiniteGasMeterWithLimit(msg.Gas())).
+		WithKVGasConfig(storetypes.GasConfig{}).
+		WithTransientKVGasConfig(storetypes.GasConfig{})
+	res, stateDB, err := k.applyEvmMsg(txCtx, msg, nil, true, cfg, txConfig)
+	if err != nil {
+		return stateChangesHash, 0, err
+	}
+	writeCache()
+	return stateDB.StateChangesHash(), len(res.Logs), nil
+}
+
+// blockReplayCtx returns the context and EVM config used to replay the txs of
+// a block from the beginning of that block.
+func (k Keeper) blockReplayCtx(
+	goCtx context.Context,
+	blockNumber int64,
+	blockTime time.Time,
+	blockHash string,
+	blockMaxGas int64,
+	proposerAddr sdk.ConsAddress,
+) (ctx sdk.Context, cfg *statedb.EVMConfig, err error) {
+	// get the context of block beginning
+	contextHeight := blockNumber
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx = sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(blockTime)
+	ctx = ctx.WithHeaderHash(gethcommon.Hex2Bytes(blockHash))
+
+	// to get the base fee we only need the block max gas in the consensus params
+	ctx = ctx.WithConsensusParams(&cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxGas: blockMaxGas},
+	})
+
+	chainID := k.EthChainID(ctx)
+
+	cfg, err = k.GetEVMConfig(ctx, ParseProposerAddr(ctx, proposerAddr), chainID)
+	if err != nil {
+		return ctx, nil, grpcstatus.Error(grpccodes.Internal, "failed to load evm config")
+	}
+
+	// compute and use base fee of height that is being traced
+	baseFee := k.GetBaseFee(ctx)
+	if baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+	return ctx, cfg, nil
+}
+
 // TraceEthTxMsg do trace on one transaction, it returns a tuple: (traceResult,
 // nextLogIndex, error).
 func (k *Keeper) TraceEthTxMsg(
@@ -658,6 +909,24 @@ func (k *Keeper) TraceEthTxMsg(
 	traceConfig *evm.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	return k.traceEthMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceEthMsg traces the execution of an EVM message, signed or not, and
+// returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceEthMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+	traceConfig *evm.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -666,10 +935,6 @@ func (k *Keeper) TraceEthTxMsg(
 		err       error
 		timeout   = DefaultGethTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, grpcstatus.Error(grpccodes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &evm.TraceConfig{}
@@ -767,3 +1032,17 @@ func (k Keeper) TokenMapping(
 
 	return nil, grpcstatus.Errorf(grpccodes.NotFound, "token mapping not found for %s", req.Token)
 }
+
+// CreateFunTokenFee: Implements the gRPC query for
+// "/eth.evm.v1.Query/CreateFunTokenFee". It returns the fee paid by the
+// sender of a "MsgCreateFunToken" and whether the fee is burned.
+func (k Keeper) CreateFunTokenFee(
+	goCtx context.Context, _ *evm.QueryCreateFunTokenFeeRequest,
+) (*evm.QueryCreateFunTokenFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	return &evm.QueryCreateFunTokenFeeResponse{
+		Fee:    params.CreateFuntokenFee,
+		Burned: params.BurnCreateFuntokenFee,
+	}, nil
+}
@@ -12,6 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	gethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/NibiruChain/nibiru/eth"
@@ -476,6 +478,19 @@ func (s *Suite) TestQueryParams() {
 	s.Require().True(want.Equal(got), "want %s, got %s", want, got)
 }
 
+func (s *Suite) TestQueryCreateFunTokenFee() {
+	deps := evmtest.NewTestDeps()
+	params := deps.K.GetParams(deps.Ctx)
+	params.CreateFuntokenFee = sdk.NewCoins(sdk.NewInt64Coin(evm.DefaultEVMDenom, 420))
+	params.BurnCreateFuntokenFee = true
+	deps.K.SetParams(deps.Ctx, params)
+
+	resp, err := deps.K.CreateFunTokenFee(deps.GoCtx(), &evm.QueryCreateFunTokenFeeRequest{})
+	s.Require().NoError(err)
+	s.Equal(params.CreateFuntokenFee, resp.Fee)
+	s.True(resp.Burned)
+}
+
 func (s *Suite) TestQueryEthCall() {
 	type In = *evm.EthCallRequest
 	type Out = *evm.MsgEthereumTxResponse
@@ -532,6 +547,112 @@ func (s *Suite) TestQueryEthCall() {
 	}
 }
 
+func (s *Suite) TestQueryEthCallOverrides() {
+	contract := evmtest.NewEthAccInfo().EthAddr
+	// Returns the word at storage slot 0.
+	sloadCode := "0x60005460005260206000f3"
+	// Returns the block number.
+	numberCode := "0x4360005260206000f3"
+
+	ethCall := func(
+		deps *evmtest.TestDeps, stateOverrides, blockOverrides string,
+	) (*evm.MsgEthereumTxResponse, error) {
+		args, err := json.Marshal(&evm.JsonTxArgs{
+			From: &deps.Sender.EthAddr,
+			To:   &contract,
+		})
+		s.Require().NoError(err)
+		return deps.K.EthCall(deps.GoCtx(), &evm.EthCallRequest{
+			Args:           args,
+			GasCap:         100_000,
+			StateOverrides: []byte(stateOverrides),
+			BlockOverrides: []byte(blockOverrides),
+		})
+	}
+
+	s.Run("sad: invalid state overrides", func() {
+		deps := evmtest.NewTestDeps()
+		_, err := ethCall(&deps, "invalid", "")
+		s.ErrorContains(err, "state overrides")
+	})
+
+	s.Run("sad: invalid block overrides", func() {
+		deps := evmtest.NewTestDeps()
+		_, err := ethCall(&deps, "", "invalid")
+		s.ErrorContains(err, "block overrides")
+	})
+
+	s.Run("happy: code and stateDiff overrides", func() {
+		deps := evmtest.NewTestDeps()
+		resp, err := ethCall(&deps, fmt.Sprintf(
+			`{"%s":{"code":"%s","stateDiff":{"%s":"%s"}}}`,
+			contract.Hex(), sloadCode,
+			gethcommon.Hash{}.Hex(), gethcommon.BigToHash(big.NewInt(42)).Hex(),
+		), "")
+		s.Require().NoError(err)
+		s.Empty(resp.VmError)
+		s.Equal(gethcommon.BigToHash(big.NewInt(42)).Bytes(), resp.Ret)
+
+		// The overrides are not persisted.
+		s.Empty(deps.StateDB().GetCode(contract))
+		s.Equal(gethcommon.Hash{}, deps.StateDB().GetState(contract, gethcommon.Hash{}))
+	})
+
+	s.Run("happy: block number override", func() {
+		deps := evmtest.NewTestDeps()
+		resp, err := ethCall(&deps, fmt.Sprintf(
+			`{"%s":{"code":"%s"}}`, contract.Hex(), numberCode,
+		), `{"number":"0x64"}`)
+		s.Require().NoError(err)
+		s.Empty(resp.VmError)
+		s.Equal(gethcommon.BigToHash(big.NewInt(100)).Bytes(), resp.Ret)
+	})
+}
+
+func (s *Suite) TestCreateAccessList() {
+	contract := evmtest.NewEthAccInfo().EthAddr
+	other := evmtest.NewEthAccInfo().EthAddr
+	ecrecover := gethcommon.BytesToAddress([]byte{0x1})
+	// Reads the balance of "other" and of the ecrecover precompile, and the
+	// storage slot 0 of the contract.
+	code := "0x" +
+		"73" + gethcommon.Bytes2Hex(other.Bytes()) + "3150" +
+		"73" + gethcommon.Bytes2Hex(ecrecover.Bytes()) + "3150" +
+		"6000545000"
+
+	s.Run("sad: nil query", func() {
+		deps := evmtest.NewTestDeps()
+		_, err := deps.K.CreateAccessList(deps.GoCtx(), nil)
+		s.ErrorContains(err, "InvalidArgument")
+	})
+
+	s.Run("happy: lists the touched accounts and slots", func() {
+		deps := evmtest.NewTestDeps()
+		args, err := json.Marshal(&evm.JsonTxArgs{
+			From: &deps.Sender.EthAddr,
+			To:   &contract,
+		})
+		s.Require().NoError(err)
+		resp, err := deps.K.CreateAccessList(deps.GoCtx(), &evm.EthCallRequest{
+			Args:   args,
+			GasCap: 100_000,
+			StateOverrides: []byte(fmt.Sprintf(
+				`{"%s":{"code":"%s"}}`, contract.Hex(), code,
+			)),
+		})
+		s.Require().NoError(err)
+		s.Empty(resp.VmError)
+
+		// The sender and the precompile are left out. The recipient is only
+		// listed for its storage slot.
+		s.ElementsMatch(gethcore.AccessList{
+			{Address: other, StorageKeys: []gethcommon.Hash{}},
+			{Address: contract, StorageKeys: []gethcommon.Hash{{}}},
+		}, *resp.AccessList.ToEthAccessList())
+		s.Greater(resp.GasUsed, gethparams.TxGas+gethparams.TxAccessListAddressGas)
+	})
+}
+
 func (s *Suite) TestQueryBalance() {
 	type In = *evm.QueryBalanceRequest
 	type Out = *evm.QueryBalanceResponse
@@ -735,6 +856,32 @@ func (s *Suite) TestEstimateGasForEvmCallType() {
 			},
 			wantErr: "insufficient balance for transfer",
 		},
+		{
+			name: "happy: state override funds the sender",
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				recipient := evmtest.NewEthAccInfo().EthAddr
+				amountToSend := hexutil.Big(*big.NewInt(10))
+
+				jsonTxArgs, err := json.Marshal(&evm.JsonTxArgs{
+					From:  &deps.Sender.EthAddr,
+					To:    &recipient,
+					Value: &amountToSend,
+				})
+				s.Require().NoError(err)
+				req = &evm.EthCallRequest{
+					Args:   jsonTxArgs,
+					GasCap: gethparams.TxGas,
+					StateOverrides: []byte(fmt.Sprintf(
+						`{"%s":{"balance":"0x3e8"}}`, deps.Sender.EthAddr.Hex(),
+					)),
+				}
+				wantResp = &evm.EstimateGasResponse{
+					Gas: gethparams.TxGas,
+				}
+				return req, wantResp
+			},
+			wantErr: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -833,6 +980,173 @@ func (s *Suite) TestTraceTx() {
 	}
 }
 
+func (s *Suite) TestTraceTxNativeTracers() {
+	transferSig := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	transferSelector := hexutil.Encode(crypto.Keccak256([]byte("transfer(address,uint256)"))[:4])
+
+	traceERC20Transfer := func(
+		deps *evmtest.TestDeps, tracer, tracerJSONConfig string,
+	) (txMsg *evm.MsgEthereumTx, result map[string]any) {
+		txMsg, predecessors := evmtest.DeployAndExecuteERC20Transfer(deps, s.T())
+		resp, err := deps.K.TraceTx(deps.GoCtx(), &evm.QueryTraceTxRequest{
+			Msg:          txMsg,
+			Predecessors: predecessors,
+			TraceConfig: &evm.TraceConfig{
+				Tracer:           tracer,
+				TracerJsonConfig: tracerJSONConfig,
+			},
+		})
+		s.Require().NoError(err)
+		s.Require().NoError(json.Unmarshal(resp.Data, &result))
+		return txMsg, result
+	}
+
+	s.Run("callTracer with logs", func() {
+		deps := evmtest.NewTestDeps()
+		txMsg, result := traceERC20Transfer(&deps, "callTracer", `{"withLog":true}`)
+		s.Equal("CALL", result["type"])
+		s.Equal(strings.ToLower(txMsg.AsTransaction().To().Hex()), result["to"])
+		s.Equal(strings.ToLower(deps.Sender.EthAddr.Hex()), result["from"])
+
+		logs, ok := result["logs"].([]any)
+		s.Require().True(ok, "result: %v", result)
+		s.Require().Len(logs, 1)
+		log := logs[0].(map[string]any)
+		s.Equal(transferSig.Hex(), log["topics"].([]any)[0])
+	})
+
+	s.Run("callTracer without logs", func() {
+		deps := evmtest.NewTestDeps()
+		_, result := traceERC20Transfer(&deps, "callTracer", "")
+		s.NotContains(result, "logs")
+	})
+
+	s.Run("prestateTracer", func() {
+		deps := evmtest.NewTestDeps()
+		txMsg, result := traceERC20Transfer(&deps, "prestateTracer", "")
+		contract := strings.ToLower(txMsg.AsTransaction().To().Hex())
+		s.Contains(result, contract)
+		s.Contains(result[contract], "code")
+		s.NotEmpty(result[contract].(map[string]any)["storage"])
+		s.Contains(result, strings.ToLower(deps.Sender.EthAddr.Hex()))
+	})
+
+	s.Run("prestateTracer in diff mode", func() {
+		deps := evmtest.NewTestDeps()
+		txMsg, result := traceERC20Transfer(&deps, "prestateTracer", `{"diffMode":true}`)
+		contract := strings.ToLower(txMsg.AsTransaction().To().Hex())
+		s.Require().Contains(result, "pre")
+		s.Require().Contains(result, "post")
+		pre := result["pre"].(map[string]any)
+		post := result["post"].(map[string]any)
+
+		// The balances of the sender and the recipient change in storage.
+		s.Require().Contains(post, contract)
+		postStorage := post[contract].(map[string]any)["storage"].(map[string]any)
+		s.Len(postStorage, 2)
+		s.NotContains(post[contract], "code", "unchanged code is omitted")
+
+		// The ante handler increments the nonce of the sender.
+		sender := strings.ToLower(deps.Sender.EthAddr.Hex())
+		s.Require().Contains(post, sender)
+		preNonce, _ := pre[sender].(map[string]any)["nonce"].(float64)
+		s.EqualValues(preNonce+1, post[sender].(map[string]any)["nonce"])
+	})
+
+	s.Run("4byteTracer", func() {
+		deps := evmtest.NewTestDeps()
+		_, result := traceERC20Transfer(&deps, "4byteTracer", "")
+		s.Equal(map[string]any{transferSelector + "-64": float64(1)}, result)
+	})
+
+	s.Run("sad: unknown tracer", func() {
+		deps := evmtest.NewTestDeps()
+		txMsg, predecessors := evmtest.DeployAndExecuteERC20Transfer(&deps, s.T())
+		_, err := deps.K.TraceTx(deps.GoCtx(), &evm.QueryTraceTxRequest{
+			Msg:          txMsg,
+			Predecessors: predecessors,
+			TraceConfig:  &evm.TraceConfig{Tracer: "fooTracer"},
+		})
+		s.ErrorContains(err, "tracer not found")
+	})
+}
+
+func (s *Suite) TestTraceCall() {
+	sender := evmtest.NewEthAccInfo().EthAddr
+	recipient := evmtest.NewEthAccInfo().EthAddr
+	args, err := json.Marshal(&evm.JsonTxArgs{
+		From:  &sender,
+		To:    &recipient,
+		Value: (*hexutil.Big)(big.NewInt(1000)),
+	})
+	s.Require().NoError(err)
+
+	traceCall := func(
+		deps *evmtest.TestDeps, tracer, stateOverrides, blockOverrides string,
+	) (result map[string]any, err error) {
+		resp, err := deps.K.TraceCall(deps.GoCtx(), &evm.QueryTraceCallRequest{
+			Args:           args,
+			TraceConfig:    &evm.TraceConfig{Tracer: tracer},
+			StateOverrides: []byte(stateOverrides),
+			BlockOverrides: []byte(blockOverrides),
+			BlockNumber:    deps.Ctx.BlockHeight(),
+			BlockTime:      deps.Ctx.BlockTime(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		s.Require().NoError(json.Unmarshal(resp.Data, &result))
+		return result, nil
+	}
+
+	s.Run("sad: nil query", func() {
+		deps := evmtest.NewTestDeps()
+		_, err := deps.K.TraceCall(deps.GoCtx(), nil)
+		s.ErrorContains(err, "InvalidArgument")
+	})
+
+	s.Run("sad: invalid state overrides", func() {
+		deps := evmtest.NewTestDeps()
+		_, err := traceCall(&deps, "callTracer", "invalid", "")
+		s.ErrorContains(err, "state overrides")
+	})
+
+	s.Run("sad: state and stateDiff of the same account", func() {
+		deps := evmtest.NewTestDeps()
+		_, err := traceCall(&deps, "callTracer", fmt.Sprintf(
+			`{"%s":{"state":{},"stateDiff":{}}}`, sender.Hex(),
+		), "")
+		s.ErrorContains(err, "has both 'state' and 'stateDiff'")
+	})
+
+	s.Run("happy: state overrides apply to the call only", func() {
+		deps := evmtest.NewTestDeps()
+		result, err := traceCall(&deps, "prestateTracer", fmt.Sprintf(
+			`{"%s":{"balance":"0xde0b6b3a7640000","nonce":"0x5"}}`, sender.Hex(),
+		), "")
+		s.Require().NoError(err)
+
+		senderPre := result[strings.ToLower(sender.Hex())].(map[string]any)
+		s.Equal("0xde0b6b3a7640000", senderPre["balance"])
+		s.EqualValues(5, senderPre["nonce"])
+
+		s.Zero(deps.StateDB().GetBalance(sender).Sign())
+		s.Zero(deps.StateDB().GetNonce(sender))
+	})
+
+	s.Run("happy: block overrides", func() {
+		deps := evmtest.NewTestDeps()
+		result, err := traceCall(&deps, "callTracer", fmt.Sprintf(
+			`{"%s":{"balance":"0xde0b6b3a7640000"}}`, sender.Hex(),
+		), `{"number":"0x64","time":"0x1"}`)
+		s.Require().NoError(err)
+		s.Equal("CALL", result["type"])
+		s.Equal(strings.ToLower(recipient.Hex()), result["to"])
+		s.Equal("0x3e8", result["value"])
+		s.NotContains(result, "error")
+	})
+}
+
 func (s *Suite) TestTraceBlock() {
 	type In = *evm.QueryTraceBlockRequest
 	type Out = string
@@ -912,6 +1226,76 @@ func (s *Suite) TestTraceBlock() {
 	}
 }
 
+func (s *Suite) TestIntermediateRoots() {
+	s.Run("sad: nil query", func() {
+		deps := evmtest.NewTestDeps()
+		_, err := deps.K.IntermediateRoots(deps.GoCtx(), nil)
+		s.Require().ErrorContains(err, "InvalidArgument")
+	})
+
+	s.Run("happy: one root per tx", func() {
+		deps := evmtest.NewTestDeps()
+		transferTx := evmtest.ExecuteNibiTransfer(&deps, s.T())
+		erc20Tx, _ := evmtest.DeployAndExecuteERC20Transfer(&deps, s.T())
+		req := &evm.QueryIntermediateRootsRequest{
+			Txs: []*evm.MsgEthereumTx{transferTx, erc20Tx},
+		}
+
+		// Each replay runs on its own branch of the state, so the roots are
+		// the same every time.
+		ctx, _ := deps.Ctx.CacheContext()
+		resp, err := deps.K.IntermediateRoots(sdk.WrapSDKContext(ctx), req)
+		s.Require().NoError(err)
+		s.Require().Len(resp.Roots, 2)
+		s.NotEqual(resp.Roots[0], resp.Roots[1])
+		s.NotEqual(gethcommon.Hash{}.Hex(), resp.Roots[0])
+
+		ctx, _ = deps.Ctx.CacheContext()
+		respAgain, err := deps.K.IntermediateRoots(sdk.WrapSDKContext(ctx), req)
+		s.Require().NoError(err)
+		s.Equal(resp.Roots, respAgain.Roots)
+	})
+
+	s.Run("happy: a failed tx is recorded and the replay continues", func() {
+		deps := evmtest.NewTestDeps()
+		transferTx := evmtest.ExecuteNibiTransfer(&deps, s.T())
+		recipient := evmtest.NewEthAccInfo().EthAddr
+		nonce := deps.StateDB().GetNonce(deps.Sender.EthAddr)
+		gasTooLow := hexutil.Uint64(1)
+		failedTxArgs := evm.JsonTxArgs{
+			From:  &deps.Sender.EthAddr,
+			To:    &recipient,
+			Nonce: (*hexutil.Uint64)(&nonce),
+			Gas:   &gasTooLow,
+		}
+		failedTx := failedTxArgs.ToTransaction()
+		s.Require().NoError(failedTx.Sign(
+			deps.Sender.GethSigner(deps.K.EthChainID(deps.Ctx)), deps.Sender.KeyringSigner,
+		))
+		erc20Tx, _ := evmtest.DeployAndExecuteERC20Transfer(&deps, s.T())
+
+		ctx, _ := deps.Ctx.CacheContext()
+		resp, err := deps.K.IntermediateRoots(sdk.WrapSDKContext(ctx), &evm.QueryIntermediateRootsRequest{
+			Txs: []*evm.MsgEthereumTx{transferTx, failedTx, erc20Tx},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Roots, 3)
+		s.Require().Len(resp.Errors, 3)
+		s.Empty(resp.Errors[0])
+		s.Contains(resp.Errors[1], "intrinsic gas too low")
+		s.Empty(resp.Errors[2])
+		s.Equal(resp.Roots[0], resp.Roots[1], "a failed tx changes no state")
+		s.NotEqual(resp.Roots[1], resp.Roots[2])
+	})
+
+	s.Run("happy: no txs", func() {
+		deps := evmtest.NewTestDeps()
+		resp, err := deps.K.IntermediateRoots(deps.GoCtx(), &evm.QueryIntermediateRootsRequest{})
+		s.Require().NoError(err)
+		s.Empty(resp.Roots)
+	})
+}
+
 func (s *Suite) TestQueryTokenMapping() {
 	type In = *evm.QueryTokenMappingRequest
 	type Out = *evm.QueryTokenMappingResponse
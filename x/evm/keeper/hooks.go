@@ -2,21 +2,54 @@
 package keeper
 
 import (
+	"math/big"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the EIP-1559 base fee of the block, derived from the base
+// fee and gas used of the last block.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	// TODO: feat(evm): impl BeginBlock
-	// Is it necessary to set a local variable, or can we use ctx everywhere?
+	baseFee := k.CalcBaseFee(ctx)
+	k.EvmState.BaseFee.Set(ctx, baseFee)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		evm.EventTypeFeeMarket,
+		sdk.NewAttribute(evm.AttributeKeyBaseFee, baseFee.String()),
+	))
 }
 
-// EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// EndBlock records the gas used by the block, for the base fee of the next
+// block, and emits the bloom filter of the block logs so that the block
+// headers served over JSON-RPC carry it. The EVM end block logic doesn't
+// update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	// TODO: Do we care about bloom here?
+	var gasUsed uint64
+	if blockGasMeter := ctx.BlockGasMeter(); blockGasMeter != nil {
+		gasUsed = blockGasMeter.GasConsumedToLimit()
+	}
+	k.EvmState.LastBlockGasUsed.Set(ctx, gasUsed)
+
+	bloom := gethcore.BytesToBloom(k.EvmState.GetBlockBloomTransient(ctx).Bytes())
+	_ = ctx.EventManager().EmitTypedEvent(evm.NewEventBlockBloom(bloom))
 	return []abci.ValidatorUpdate{}
 }
+
+// CalcBaseFee returns the EIP-1559 base fee of the current block, given the
+// base fee and gas used of the last block. See [evm.Params.NextBaseFee].
+func (k Keeper) CalcBaseFee(ctx sdk.Context) *big.Int {
+	parentBaseFee := k.GetBaseFee(ctx)
+	parentGasUsed, err := k.EvmState.LastBlockGasUsed.Get(ctx)
+	if err != nil {
+		// first block of the fee market
+		parentGasUsed = 0
+	}
+	return k.GetParams(ctx).NextBaseFee(
+		parentBaseFee, parentGasUsed, eth.BlockGasLimit(ctx),
+	)
+}
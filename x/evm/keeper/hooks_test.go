@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+)
+
+func (s *Suite) TestFeeMarketBaseFee() {
+	deps := evmtest.NewTestDeps()
+	k := deps.Chain.EvmKeeper
+
+	params := k.GetParams(deps.Ctx)
+	params.MinBaseFee = sdkmath.NewInt(1_000)
+	k.SetParams(deps.Ctx, params)
+
+	s.T().Log("before the first block of the fee market, the base fee is the min base fee")
+	s.Require().Equal("1000", k.GetBaseFee(deps.Ctx).String())
+
+	// gas target of 1_000_000 with the default elasticity multiplier of 2
+	ctx := deps.Ctx.WithBlockGasMeter(sdk.NewGasMeter(2_000_000))
+
+	s.T().Log("a full block raises the base fee of the next block by 1/8")
+	ctx.BlockGasMeter().ConsumeGas(2_000_000, "full block")
+	k.EndBlock(ctx, abci.RequestEndBlock{})
+	gasUsed, err := k.EvmState.LastBlockGasUsed.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2_000_000, gasUsed)
+
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(2_000_000)).
+		WithEventManager(sdk.NewEventManager())
+	k.BeginBlock(ctx, abci.RequestBeginBlock{})
+	s.Require().Equal("1125", k.GetBaseFee(ctx).String())
+
+	s.T().Log("BeginBlock emits the base fee of the block")
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != evm.EventTypeFeeMarket {
+			continue
+		}
+		found = true
+		attr, ok := event.GetAttribute(evm.AttributeKeyBaseFee)
+		s.Require().True(ok)
+		s.Require().Equal("1125", attr.Value)
+	}
+	s.Require().True(found, "missing fee_market event")
+
+	s.T().Log("an empty block lowers the base fee, down to the min base fee")
+	k.EndBlock(ctx, abci.RequestEndBlock{})
+	k.BeginBlock(ctx, abci.RequestBeginBlock{})
+	s.Require().Equal(big.NewInt(1_000).String(), k.GetBaseFee(ctx).String())
+
+	s.T().Log("the base fee query returns the base fee of the block")
+	res, err := k.BaseFee(sdk.WrapSDKContext(ctx), &evm.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(1_000), *res.BaseFee)
+}
+
+func (s *Suite) TestEndBlockBloom() {
+	deps := evmtest.NewTestDeps()
+	k := deps.Chain.EvmKeeper
+
+	blockBloom := func(ctx sdk.Context) gethcore.Bloom {
+		eventType := proto.MessageName(new(evm.EventBlockBloom))
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != eventType {
+				continue
+			}
+			bloom, err := evm.EventBlockBloomFromABCIEvent(abci.Event(event))
+			s.Require().NoError(err)
+			return bloom
+		}
+		s.FailNow("missing block bloom event")
+		return gethcore.Bloom{}
+	}
+
+	s.T().Log("a block without logs has an empty bloom")
+	ctx := deps.Ctx.WithEventManager(sdk.NewEventManager())
+	k.EndBlock(ctx, abci.RequestEndBlock{})
+	s.Require().Equal(gethcore.Bloom{}, blockBloom(ctx))
+
+	s.T().Log("the bloom of the block covers the logs of all its txs")
+	logs := []*gethcore.Log{
+		{Address: evmtest.NewEthAccInfo().EthAddr},
+		{Address: evmtest.NewEthAccInfo().EthAddr},
+	}
+	ctx = deps.Ctx.WithEventManager(sdk.NewEventManager())
+	for _, log := range logs {
+		bloom := k.EvmState.CalcBloomFromLogs(ctx, []*gethcore.Log{log})
+		k.EvmState.BlockBloom.Set(ctx, bloom.Bytes())
+	}
+	k.EndBlock(ctx, abci.RequestEndBlock{})
+	s.Require().Equal(
+		gethcore.BytesToBloom(gethcore.LogsBloom(logs)), blockBloom(ctx),
+	)
+}
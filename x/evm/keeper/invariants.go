@@ -0,0 +1,166 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// RegisterInvariants registers the x/evm module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(evm.ModuleName, "funtoken-from-coin-escrow", FunTokenFromCoinEscrowInvariant(k))
+	ir.RegisterRoute(evm.ModuleName, "funtoken-from-erc20-escrow", FunTokenFromErc20EscrowInvariant(k))
+	ir.RegisterRoute(evm.ModuleName, "account-code-hash", AccountCodeHashInvariant(k))
+}
+
+// AllInvariants runs all invariants of the x/evm module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			FunTokenFromCoinEscrowInvariant(k),
+			FunTokenFromErc20EscrowInvariant(k),
+			AccountCodeHashInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// FunTokenFromCoinEscrowInvariant checks that, for each FunToken mapping
+// created from a bank coin, the bank coins escrowed in the EVM module account
+// by [Keeper.SendFunTokenToEvm] back the total supply of the ERC20 deployed by
+// the module. Holders can burn their ERC20 tokens, so the escrow may exceed
+// the supply, but never the other way around.
+func FunTokenFromCoinEscrowInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		evmModuleAddr := k.accountKeeper.GetModuleAddress(evm.ModuleName)
+		for _, funtoken := range k.FunTokens.Iterate(ctx, collections.Range[[]byte]{}).Values() {
+			if !funtoken.IsMadeFromCoin {
+				continue
+			}
+			erc20 := funtoken.Erc20Addr.ToAddr()
+			supply, err := k.erc20TotalSupply(ctx, erc20)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tfailed to query the total supply of ERC20 %s: %s\n", erc20.Hex(), err)
+				continue
+			}
+			escrow := k.bankKeeper.GetBalance(ctx, evmModuleAddr, funtoken.BankDenom).Amount.BigInt()
+			if escrow.Cmp(supply) < 0 {
+				broken = true
+				msg += fmt.Sprintf(
+					"\tERC20 %s has a total supply of %s, but only %s%s are escrowed in the %s module account\n",
+					erc20.Hex(), supply, escrow, funtoken.BankDenom, evm.ModuleName,
+				)
+			}
+		}
+		return sdk.FormatInvariant(
+			evm.ModuleName, "funtoken-from-coin-escrow",
+			"module-owned ERC20 supply backed by escrowed bank coins\n"+msg,
+		), broken
+	}
+}
+
+// FunTokenFromErc20EscrowInvariant checks that, for each FunToken mapping
+// created from an ERC20, the ERC20 tokens held by the EVM module account back
+// the bank supply minted by [Keeper.ConvertErc20ToCoin]. Anyone can transfer
+// ERC20 tokens to the module account, so the escrow may exceed the supply,
+// but never the other way around.
+func FunTokenFromErc20EscrowInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		evmModuleAddr := evm.ModuleAddressEVM()
+		for _, funtoken := range k.FunTokens.Iterate(ctx, collections.Range[[]byte]{}).Values() {
+			if funtoken.IsMadeFromCoin {
+				continue
+			}
+			erc20 := funtoken.Erc20Addr.ToAddr()
+			escrow, err := k.erc20BalanceOf(ctx, erc20, evmModuleAddr)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tfailed to query the ERC20 %s balance of the %s module account: %s\n", erc20.Hex(), evm.ModuleName, err)
+				continue
+			}
+			supply := k.bankKeeper.GetSupply(ctx, funtoken.BankDenom).Amount.BigInt()
+			if escrow.Cmp(supply) < 0 {
+				broken = true
+				msg += fmt.Sprintf(
+					"\tbank coin %s has a supply of %s, but only %s tokens of ERC20 %s are escrowed in the %s module account\n",
+					funtoken.BankDenom, supply, escrow, erc20.Hex(), evm.ModuleName,
+				)
+			}
+		}
+		return sdk.FormatInvariant(
+			evm.ModuleName, "funtoken-from-erc20-escrow",
+			"bank coin supply backed by escrowed ERC20 tokens\n"+msg,
+		), broken
+	}
+}
+
+// AccountCodeHashInvariant checks that the code hash of each Ethereum account
+// with a non-empty code hash resolves to contract bytecode in the EVM state.
+func AccountCodeHashInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		emptyCodeHash := gethcommon.BytesToHash(evm.EmptyCodeHash)
+		k.accountKeeper.IterateAccounts(ctx, func(acc authtypes.AccountI) (stop bool) {
+			ethAcc, ok := acc.(eth.EthAccountI)
+			if !ok {
+				return false
+			}
+			codeHash := ethAcc.GetCodeHash()
+			if codeHash == emptyCodeHash || codeHash == (gethcommon.Hash{}) {
+				return false
+			}
+			if _, err := k.EvmState.ContractBytecode.Get(ctx, codeHash.Bytes()); err != nil {
+				broken = true
+				msg += fmt.Sprintf(
+					"\taccount %s has code hash %s, but no bytecode is stored for it\n",
+					ethAcc.EthAddress().Hex(), codeHash.Hex(),
+				)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(
+			evm.ModuleName, "account-code-hash",
+			"code hashes of Ethereum accounts resolve to contract bytecode\n"+msg,
+		), broken
+	}
+}
+
+// erc20TotalSupply queries "ERC20.totalSupply" in a cached context so that the
+// invariant leaves no trace in the state or the gas meter.
+func (k *Keeper) erc20TotalSupply(ctx sdk.Context, erc20 gethcommon.Address) (*big.Int, error) {
+	cacheCtx, _ := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+	return k.ERC20().TotalSupply(erc20, cacheCtx)
+}
+
+// erc20BalanceOf queries "ERC20.balanceOf" in a cached context so that the
+// invariant leaves no trace in the state or the gas meter.
+func (k *Keeper) erc20BalanceOf(
+	ctx sdk.Context, erc20, account gethcommon.Address,
+) (*big.Int, error) {
+	cacheCtx, _ := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+	return k.ERC20().BalanceOf(erc20, account, cacheCtx)
+}
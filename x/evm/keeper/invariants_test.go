@@ -0,0 +1,111 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/keeper"
+)
+
+func (s *Suite) TestInvariants() {
+	s.Run("funtoken made from coin", func() {
+		deps := evmtest.NewTestDeps()
+		bankDenom := "unibi"
+		funtoken := evmtest.CreateFunTokenForBankCoin(&deps, bankDenom, &s.Suite)
+		erc20 := funtoken.Erc20Addr.ToAddr()
+		sender := deps.Sender
+
+		coins := sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 100))
+		s.Require().NoError(testapp.FundAccount(deps.Chain.BankKeeper, deps.Ctx, sender.NibiruAddr, coins))
+		_, err := deps.K.SendFunTokenToEvm(deps.GoCtx(), &evm.MsgSendFunTokenToEvm{
+			Sender:    sender.NibiruAddr.String(),
+			BankCoin:  coins[0],
+			ToEthAddr: eth.NewHexAddr(sender.EthAddr),
+		})
+		s.Require().NoError(err)
+
+		s.T().Log("happy: burning ERC20 tokens keeps the escrow sufficient")
+		_, err = deps.K.ERC20().Burn(erc20, sender.EthAddr, big.NewInt(10), deps.Ctx)
+		s.Require().NoError(err)
+		_, broken := keeper.FunTokenFromCoinEscrowInvariant(&deps.K)(deps.Ctx)
+		s.False(broken)
+
+		s.T().Log("sad: ERC20 minted without escrowed coins")
+		_, err = deps.K.ERC20().Mint(erc20, evm.ModuleAddressEVM(), sender.EthAddr, big.NewInt(11), deps.Ctx)
+		s.Require().NoError(err)
+		msg, broken := keeper.FunTokenFromCoinEscrowInvariant(&deps.K)(deps.Ctx)
+		s.True(broken)
+		s.Contains(msg, erc20.Hex())
+	})
+
+	s.Run("funtoken made from ERC20", func() {
+		deps := evmtest.NewTestDeps()
+		sender := deps.Sender
+
+		deployResp, err := evmtest.DeployContract(
+			&deps, embeds.SmartContract_ERC20Minter, s.T(), "erc20name", "TOKEN", uint8(18),
+		)
+		s.Require().NoError(err)
+		erc20 := deployResp.ContractAddr
+		_, err = deps.K.ERC20().Mint(erc20, sender.EthAddr, sender.EthAddr, big.NewInt(1_000), deps.Ctx)
+		s.Require().NoError(err)
+
+		erc20Addr := eth.NewHexAddr(erc20)
+		s.Require().NoError(evmtest.FundFeeForCreateFunToken(&deps, sender.NibiruAddr))
+		createResp, err := deps.K.CreateFunToken(deps.GoCtx(), &evm.MsgCreateFunToken{
+			FromErc20: &erc20Addr,
+			Sender:    sender.NibiruAddr.String(),
+		})
+		s.Require().NoError(err)
+		bankDenom := createResp.FuntokenMapping.BankDenom
+
+		_, err = deps.K.ConvertEvmToCoin(deps.GoCtx(), &evm.MsgConvertEvmToCoin{
+			Sender:     sender.NibiruAddr.String(),
+			Erc20Addr:  erc20Addr,
+			Amount:     math.NewInt(250),
+			ToBankAddr: testutil.AccAddress().String(),
+		})
+		s.Require().NoError(err)
+
+		s.T().Log("happy: bank supply backed by the escrowed ERC20")
+		_, broken := keeper.FunTokenFromErc20EscrowInvariant(&deps.K)(deps.Ctx)
+		s.False(broken)
+
+		s.T().Log("sad: bank coins minted without escrowed ERC20")
+		s.Require().NoError(testapp.FundAccount(
+			deps.Chain.BankKeeper, deps.Ctx, sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 1)),
+		))
+		msg, broken := keeper.FunTokenFromErc20EscrowInvariant(&deps.K)(deps.Ctx)
+		s.True(broken)
+		s.Contains(msg, bankDenom)
+	})
+
+	s.Run("account code hash", func() {
+		deps := evmtest.NewTestDeps()
+		deployResp, err := evmtest.DeployContract(
+			&deps, embeds.SmartContract_ERC20Minter, s.T(), "erc20name", "TOKEN", uint8(18),
+		)
+		s.Require().NoError(err)
+
+		s.T().Log("happy: deployed contract has bytecode")
+		_, broken := keeper.AllInvariants(&deps.K)(deps.Ctx)
+		s.False(broken)
+
+		s.T().Log("sad: bytecode missing for the code hash")
+		codeHash := deps.K.GetAccount(deps.Ctx, deployResp.ContractAddr).CodeHash
+		s.Require().NoError(deps.K.EvmState.ContractBytecode.Delete(deps.Ctx, codeHash))
+		msg, broken := keeper.AccountCodeHashInvariant(&deps.K)(deps.Ctx)
+		s.True(broken)
+		s.Contains(msg, deployResp.ContractAddr.Hex())
+	})
+}
@@ -43,6 +43,12 @@ type Keeper struct {
 	bankKeeper    evm.BankKeeper
 	accountKeeper evm.AccountKeeper
 	stakingKeeper evm.StakingKeeper
+	// distrKeeper and inflationKeeper: Destinations of the
+	// "create_funtoken_fee" param.
+	distrKeeper     evm.DistrKeeper
+	inflationKeeper evm.InflationKeeper
+	// sudoKeeper: Gates the sudo messages for FunToken mappings.
+	sudoKeeper evm.SudoKeeper
 
 	// Integer for the Ethereum EIP155 Chain ID
 	// eip155ChainIDInt *big.Int
@@ -63,22 +69,28 @@ func NewKeeper(
 	accKeeper evm.AccountKeeper,
 	bankKeeper evm.BankKeeper,
 	stakingKeeper evm.StakingKeeper,
+	distrKeeper evm.DistrKeeper,
+	inflationKeeper evm.InflationKeeper,
+	sudoKeeper evm.SudoKeeper,
 	tracer string,
 ) Keeper {
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
 		panic(err)
 	}
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		transientKey:  transientKey,
-		authority:     authority,
-		EvmState:      NewEvmState(cdc, storeKey, transientKey),
-		FunTokens:     NewFunTokenState(cdc, storeKey),
-		accountKeeper: accKeeper,
-		bankKeeper:    bankKeeper,
-		stakingKeeper: stakingKeeper,
-		tracer:        tracer,
+		cdc:             cdc,
+		storeKey:        storeKey,
+		transientKey:    transientKey,
+		authority:       authority,
+		EvmState:        NewEvmState(cdc, storeKey, transientKey),
+		FunTokens:       NewFunTokenState(cdc, storeKey),
+		accountKeeper:   accKeeper,
+		bankKeeper:      bankKeeper,
+		stakingKeeper:   stakingKeeper,
+		distrKeeper:     distrKeeper,
+		inflationKeeper: inflationKeeper,
+		sudoKeeper:      sudoKeeper,
+		tracer:          tracer,
 	}
 }
 
@@ -110,7 +122,7 @@ func (k Keeper) AddToBlockGasUsed(
 	if result < gasUsed {
 		return 0, sdkerrors.Wrap(evm.ErrGasOverflow, "transient gas used")
 	}
-	k.EvmState.BlockGasUsed.Set(ctx, gasUsed)
+	k.EvmState.BlockGasUsed.Set(ctx, result)
 	return result, nil
 }
 
@@ -119,9 +131,17 @@ func (k Keeper) GetMinGasMultiplier(ctx sdk.Context) math.LegacyDec {
 	return math.LegacyNewDecWithPrec(50, 2) // 50%
 }
 
+// GetBaseFee returns the EIP-1559 base fee of the current block. Before the
+// first block with a fee market, it is the min base fee.
 func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
-	// TODO: plug in fee market keeper
-	return big.NewInt(0)
+	if baseFee, err := k.EvmState.BaseFee.Get(ctx); err == nil {
+		return baseFee
+	}
+	minBaseFee := k.GetParams(ctx).MinBaseFee
+	if minBaseFee.IsNil() {
+		return big.NewInt(0)
+	}
+	return minBaseFee.BigInt()
 }
 
 func (k Keeper) GetBaseFeeNoCfg(
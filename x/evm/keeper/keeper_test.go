@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
 )
 
 type Suite struct {
@@ -15,3 +18,19 @@ func TestKeeperSuite(t *testing.T) {
 	s := new(Suite)
 	suite.Run(t, s)
 }
+
+func (s *Suite) TestAddToBlockGasUsed() {
+	deps := evmtest.NewTestDeps()
+
+	total, err := deps.K.AddToBlockGasUsed(deps.Ctx, 21_000)
+	s.Require().NoError(err)
+	s.Equal(uint64(21_000), total)
+
+	total, err = deps.K.AddToBlockGasUsed(deps.Ctx, 50_000)
+	s.Require().NoError(err)
+	s.Equal(uint64(71_000), total, "gas used by the previous eth msgs is kept")
+	s.Equal(uint64(71_000), deps.K.EvmState.BlockGasUsed.GetOr(deps.Ctx, 0))
+
+	_, err = deps.K.AddToBlockGasUsed(deps.Ctx, math.MaxUint64)
+	s.ErrorContains(err, "transient gas used")
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// Migrator handles the in-place store migrations of the EVM module.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a Migrator for the EVM module.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 activates the Shanghai EIPs supported by the EVM, such as PUSH0,
+// on chains whose parameters predate them, and sets the defaults of the
+// parameters added since. The new rules apply from the height of the upgrade
+// that runs the migration.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	for _, eip := range evm.ShanghaiEIPs {
+		if !slices.Contains(params.ExtraEIPs, eip) {
+			params.ExtraEIPs = append(params.ExtraEIPs, eip)
+		}
+	}
+	// Params stored before the precompile gas schedule have an empty one,
+	// which makes every precompile call free.
+	if params.PrecompileGas.BaseGas == 0 && params.PrecompileGas.GasPerInputByte == 0 &&
+		len(params.PrecompileGas.MethodGas) == 0 {
+		params.PrecompileGas = evm.DefaultPrecompileGasSchedule()
+	}
+	// Params stored before the FunToken creation fee have none, which makes
+	// registering a FunToken free.
+	if params.CreateFuntokenFee.Empty() {
+		params.CreateFuntokenFee = evm.DefaultCreateFunTokenFee()
+	}
+	// Params stored before the fee market have no base fee parameters, which
+	// fail validation.
+	if params.BaseFeeChangeDenominator == 0 {
+		params.BaseFeeChangeDenominator = evm.DefaultBaseFeeChangeDenominator
+	}
+	if params.ElasticityMultiplier == 0 {
+		params.ElasticityMultiplier = evm.DefaultElasticityMultiplier
+	}
+	if params.MinBaseFee.IsNil() {
+		params.MinBaseFee = evm.DefaultMinBaseFee()
+	}
+	// Params stored before the create policy decode to the zero policy. Set
+	// the default explicitly rather than rely on the zero value of the enum.
+	if params.CreatePolicy.Type == 0 && len(params.CreatePolicy.Allowlist) == 0 {
+		params.CreatePolicy = evm.DefaultCreatePolicy()
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
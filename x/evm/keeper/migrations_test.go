@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/keeper"
+)
+
+func (s *Suite) TestMigrate1to2() {
+	deps := evmtest.NewTestDeps()
+	k := deps.Chain.EvmKeeper
+	contract := evmtest.NewEthAccInfo().EthAddr
+	// Returns 42, using PUSH0 for the memory offsets.
+	push0Code := "0x602a5f5260205ff3"
+
+	ethCall := func() *evm.MsgEthereumTxResponse {
+		args, err := json.Marshal(&evm.JsonTxArgs{
+			From: &deps.Sender.EthAddr,
+			To:   &contract,
+		})
+		s.Require().NoError(err)
+		resp, err := k.EthCall(deps.GoCtx(), &evm.EthCallRequest{
+			Args:   args,
+			GasCap: 100_000,
+			StateOverrides: []byte(fmt.Sprintf(
+				`{"%s":{"code":"%s"}}`, contract.Hex(), push0Code,
+			)),
+		})
+		s.Require().NoError(err)
+		return resp
+	}
+
+	s.T().Log("PUSH0 is an invalid opcode before the migration")
+	// Params in the format stored before v1.5.0, without the fields added
+	// since. They fail validation until the migration sets the defaults.
+	params := k.GetParams(deps.Ctx)
+	params = evm.Params{
+		EvmDenom:            params.EvmDenom,
+		EnableCreate:        params.EnableCreate,
+		EnableCall:          params.EnableCall,
+		AllowUnprotectedTxs: params.AllowUnprotectedTxs,
+		ActivePrecompiles:   params.ActivePrecompiles,
+		EVMChannels:         params.EVMChannels,
+	}
+	s.Require().Error(params.Validate())
+	k.SetParams(deps.Ctx, params)
+	s.Contains(ethCall().VmError, "invalid opcode")
+
+	s.T().Log("the migration activates the Shanghai EIPs")
+	m := keeper.NewMigrator(&k)
+	s.Require().NoError(m.Migrate1to2(deps.Ctx))
+	s.Equal(evm.ShanghaiEIPs, k.GetParams(deps.Ctx).ExtraEIPs)
+
+	s.T().Log("the migration sets the defaults of the new params")
+	params = k.GetParams(deps.Ctx)
+	s.Equal(evm.DefaultPrecompileGasSchedule(), params.PrecompileGas)
+	s.Equal(evm.DefaultCreateFunTokenFee(), params.CreateFuntokenFee)
+	s.Equal(evm.DefaultBaseFeeChangeDenominator, params.BaseFeeChangeDenominator)
+	s.Equal(evm.DefaultElasticityMultiplier, params.ElasticityMultiplier)
+	s.Equal(evm.DefaultMinBaseFee(), params.MinBaseFee)
+	s.Equal(evm.DefaultCreatePolicy(), params.CreatePolicy)
+	s.NoError(params.Validate())
+
+	resp := ethCall()
+	s.Empty(resp.VmError)
+	s.Equal(gethcommon.BigToHash(big.NewInt(42)).Bytes(), resp.Ret)
+
+	s.T().Log("the migration is idempotent")
+	s.Require().NoError(m.Migrate1to2(deps.Ctx))
+	s.Equal(evm.ShanghaiEIPs, k.GetParams(deps.Ctx).ExtraEIPs)
+}
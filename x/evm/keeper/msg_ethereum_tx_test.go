@@ -4,10 +4,15 @@ import (
 	"math/big"
 	"strconv"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/core"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 	gethparams "github.com/ethereum/go-ethereum/params"
 
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
 	"github.com/NibiruChain/nibiru/x/evm/evmtest"
 )
 
@@ -38,8 +43,16 @@ func (s *Suite) TestMsgEthereumTx_CreateContract() {
 				s.Require().NoError(ethTxMsg.ValidateBasic())
 				s.Equal(ethTxMsg.GetGas(), gasLimit.Uint64())
 
+				s.T().Log("escrow the gas fees, as the ante handler does, for the refund")
+				s.Require().NoError(testapp.FundModuleAccount(
+					deps.Chain.BankKeeper, deps.Ctx, authtypes.FeeCollectorName,
+					sdk.NewCoins(sdk.NewCoin(evm.DefaultEVMDenom, sdkmath.NewIntFromBigInt(gasLimit))),
+				))
+
 				resp, err := deps.Chain.EvmKeeper.EthereumTx(deps.GoCtx(), ethTxMsg)
 				s.Require().NoError(err, "resp: %s\nblock header: %s", resp, deps.Ctx.BlockHeader().ProposerAddress)
+				s.Require().Empty(resp.VmError, "the deployment should not revert")
+				s.Less(resp.GasUsed, gasLimit.Uint64(), "leftover gas is refunded")
 			},
 		},
 		{
@@ -367,6 +367,19 @@ func (k *Keeper) ApplyEvmMsg(ctx sdk.Context,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 ) (*evm.MsgEthereumTxResponse, error) {
+	resp, _, err := k.applyEvmMsg(ctx, msg, tracer, commit, cfg, txConfig)
+	return resp, err
+}
+
+// applyEvmMsg implements "ApplyEvmMsg" and also returns the "StateDB" of the
+// message, which holds the journal of the state changes it made.
+func (k *Keeper) applyEvmMsg(ctx sdk.Context,
+	msg core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*evm.MsgEthereumTxResponse, *statedb.StateDB, error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
@@ -374,14 +387,30 @@ func (k *Keeper) ApplyEvmMsg(ctx sdk.Context,
 
 	// return error if contract creation or call are disabled through governance
 	if !cfg.Params.EnableCreate && msg.To() == nil {
-		return nil, errors.Wrap(evm.ErrCreateDisabled, "failed to create new contract")
+		return nil, nil, errors.Wrap(evm.ErrCreateDisabled, "failed to create new contract")
 	} else if !cfg.Params.EnableCall && msg.To() != nil {
-		return nil, errors.Wrap(evm.ErrCallDisabled, "failed to call contract")
+		return nil, nil, errors.Wrap(evm.ErrCallDisabled, "failed to call contract")
+	}
+	if msg.To() == nil {
+		if err := checkCreatePolicy(cfg.Params, msg.From(), msg.From(), false); err != nil {
+			return nil, nil, errors.Wrap(err, "failed to create new contract")
+		}
 	}
 
 	stateDB := statedb.New(ctx, k, txConfig)
 	evmObj := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
+	// Contracts deployed with CREATE and CREATE2 are checked against the
+	// create policy by a wrapper around the interpreter.
+	if cfg.Params.CreatePolicy.Type != evm.CreatePolicyType_CREATE_POLICY_TYPE_PERMISSIONLESS {
+		evmObj.WithInterpreter(&createPolicyInterpreter{
+			Interpreter: evmObj.Interpreter(),
+			stateDB:     stateDB,
+			params:      cfg.Params,
+			origin:      msg.From(),
+		})
+	}
+
 	numPrecompiles := len(k.precompiles)
 	precompileAddrs := make([]gethcommon.Address, numPrecompiles)
 
@@ -394,7 +423,7 @@ func (k *Keeper) ApplyEvmMsg(ctx sdk.Context,
 	if toAddr != nil &&
 		slices.Contains(evm.AvailableEVMExtensions, toAddr.String()) &&
 		!slices.Contains(precompileAddrs, *toAddr) {
-		return nil, errors.Wrap(evm.ErrInactivePrecompile, "failed to call precompile")
+		return nil, nil, errors.Wrap(evm.ErrInactivePrecompile, "failed to call precompile")
 	}
 
 	leftoverGas := msg.Gas()
@@ -414,13 +443,13 @@ func (k *Keeper) ApplyEvmMsg(ctx sdk.Context,
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, errors.Wrap(err, "intrinsic gas failed")
+		return nil, nil, errors.Wrap(err, "intrinsic gas failed")
 	}
 
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, errors.Wrap(core.ErrIntrinsicGas, "apply message")
+		return nil, nil, errors.Wrap(core.ErrIntrinsicGas, "apply message")
 	}
 	leftoverGas -= intrinsicGas
 
@@ -444,7 +473,7 @@ func (k *Keeper) ApplyEvmMsg(ctx sdk.Context,
 
 	// calculate gas refund
 	if msg.Gas() < leftoverGas {
-		return nil, errors.Wrap(evm.ErrGasOverflow, "apply message")
+		return nil, nil, errors.Wrap(evm.ErrGasOverflow, "apply message")
 	}
 	// refund gas
 	temporaryGasUsed := msg.Gas() - leftoverGas
@@ -463,7 +492,7 @@ func (k *Keeper) ApplyEvmMsg(ctx sdk.Context,
 	// The dirty states in `StateDB` is either committed or discarded after return
 	if commit {
 		if err := stateDB.Commit(); err != nil {
-			return nil, errors.Wrap(err, "failed to commit stateDB")
+			return nil, nil, errors.Wrap(err, "failed to commit stateDB")
 		}
 	}
 
@@ -472,11 +501,11 @@ func (k *Keeper) ApplyEvmMsg(ctx sdk.Context,
 	minimumGasUsed := gasLimit.Mul(minGasMultiplier)
 
 	if !minimumGasUsed.TruncateInt().IsUint64() {
-		return nil, errors.Wrapf(evm.ErrGasOverflow, "minimumGasUsed(%s) is not a uint64", minimumGasUsed.TruncateInt().String())
+		return nil, nil, errors.Wrapf(evm.ErrGasOverflow, "minimumGasUsed(%s) is not a uint64", minimumGasUsed.TruncateInt().String())
 	}
 
 	if msg.Gas() < leftoverGas {
-		return nil, errors.Wrapf(evm.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.Gas(), leftoverGas)
+		return nil, nil, errors.Wrapf(evm.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.Gas(), leftoverGas)
 	}
 
 	gasUsed := math.LegacyMaxDec(minimumGasUsed, math.LegacyNewDec(int64(temporaryGasUsed))).TruncateInt().Uint64()
@@ -489,7 +518,7 @@ func (k *Keeper) ApplyEvmMsg(ctx sdk.Context,
 		Ret:     ret,
 		Logs:    evm.NewLogsFromEth(stateDB.Logs()),
 		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+	}, stateDB, nil
 }
 
 // CreateFunToken is a gRPC transaction message for creating fungible token
@@ -498,6 +527,8 @@ func (k *Keeper) ApplyEvmMsg(ctx sdk.Context,
 // If the mapping is generated from an ERC20, this tx creates a bank coin to go
 // with it, and if the mapping's generated from a coin, the EVM module
 // deploys an ERC20 contract that for which it will be the owner.
+//
+// The sender pays the "create_funtoken_fee" param for the registration.
 func (k *Keeper) CreateFunToken(
 	goCtx context.Context, msg *evm.MsgCreateFunToken,
 ) (resp *evm.MsgCreateFunTokenResponse, err error) {
@@ -507,8 +538,6 @@ func (k *Keeper) CreateFunToken(
 		return
 	}
 
-	// TODO: UD-DEBUG: feat: Add fee upon registration.
-
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	emptyErc20 := msg.FromErc20 == nil || msg.FromErc20.Size() == 0
@@ -532,12 +561,40 @@ func (k *Keeper) CreateFunToken(
 	if err != nil {
 		return
 	}
+	if err = k.deductCreateFunTokenFee(ctx, msg); err != nil {
+		return
+	}
 
 	return &evm.MsgCreateFunTokenResponse{
 		FuntokenMapping: funtoken,
 	}, err
 }
 
+// deductCreateFunTokenFee: Charges the sender of a "MsgCreateFunToken" the
+// "create_funtoken_fee" param. The fee funds the community pool, or gets
+// burned if "burn_create_funtoken_fee" is set.
+func (k *Keeper) deductCreateFunTokenFee(
+	ctx sdk.Context, msg *evm.MsgCreateFunToken,
+) error {
+	params := k.GetParams(ctx)
+	fee := params.CreateFuntokenFee
+	if fee.IsZero() {
+		return nil
+	}
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender) // validated in ValidateBasic
+	var err error
+	if params.BurnCreateFuntokenFee {
+		err = k.inflationKeeper.Burn(ctx, fee, sender)
+	} else {
+		err = k.distrKeeper.FundCommunityPool(ctx, fee, sender)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "unable to pay the create_funtoken_fee of %s", fee)
+	}
+	return nil
+}
+
 // SendFunTokenToEvm Sends a coin with a valid "FunToken" mapping to the
 // given recipient address ("to_eth_addr") in the corresponding ERC20
 // representation.
@@ -555,6 +612,9 @@ func (k *Keeper) SendFunTokenToEvm(
 	if len(funTokens) == 0 {
 		return nil, fmt.Errorf("funtoken for bank denom \"%s\" does not exist", bankDenom)
 	}
+	if err := funTokens[0].AssertNotPaused(); err != nil {
+		return nil, err
+	}
 	erc20ContractAddr := funTokens[0].Erc20Addr.ToAddr()
 
 	// Step 1: Send coins to the evm module account
@@ -590,3 +650,178 @@ func (k *Keeper) SendFunTokenToEvm(
 
 	return &evm.MsgSendFunTokenToEvmResponse{}, nil
 }
+
+// ConvertEvmToCoin Converts ERC20 tokens held by the sender's Ethereum address
+// into the bank coin representation given by the ERC20's "FunToken" mapping
+// and sends the coins to the recipient address ("to_bank_addr").
+func (k *Keeper) ConvertEvmToCoin(
+	goCtx context.Context, msg *evm.MsgConvertEvmToCoin,
+) (resp *evm.MsgConvertEvmToCoinResponse, err error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	toBankAddr := sdk.MustAccAddressFromBech32(msg.ToBankAddr)
+	erc20 := msg.Erc20Addr.ToAddr()
+
+	funTokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20))
+	if len(funTokens) == 0 {
+		return nil, fmt.Errorf("funtoken for ERC20 \"%s\" does not exist", erc20.Hex())
+	}
+
+	bankCoin, err := k.ConvertErc20ToCoin(
+		ctx, funTokens[0], gethcommon.BytesToAddress(sender.Bytes()), toBankAddr, msg.Amount.BigInt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertEvmToCoin{
+		Sender:               msg.Sender,
+		Erc20ContractAddress: erc20.String(),
+		ToBankAddr:           msg.ToBankAddr,
+		BankCoin:             bankCoin,
+	})
+
+	return &evm.MsgConvertEvmToCoinResponse{BankCoin: bankCoin}, nil
+}
+
+// ConvertErc20ToCoin moves [amount] ERC20 tokens of the [funtoken] mapping
+// from the [from] account to the EVM module account and credits bank coins for
+// the tokens actually received to [to].
+//
+//   - If the mapping was created from a bank coin, the EVM module owns the
+//     ERC20, so the received tokens are burned and the bank coins escrowed by
+//     [Keeper.SendFunTokenToEvm] are released.
+//   - If the mapping was created from an ERC20, the tokens stay escrowed in
+//     the EVM module account and new bank coins are minted.
+func (k *Keeper) ConvertErc20ToCoin(
+	ctx sdk.Context,
+	funtoken evm.FunToken,
+	from gethcommon.Address,
+	to sdk.AccAddress,
+	amount *big.Int,
+) (bankCoin sdk.Coin, err error) {
+	if amount == nil || amount.Sign() != 1 {
+		return bankCoin, fmt.Errorf("transfer amount must be positive")
+	}
+	if err := funtoken.AssertNotPaused(); err != nil {
+		return bankCoin, err
+	}
+	erc20 := funtoken.Erc20Addr.ToAddr()
+	evmModuleAddr := evm.ModuleAddressEVM()
+
+	// Step 1: Sender transfers ERC20 to the EVM module account. Only the
+	// amount actually received is converted, since tokens with a fee on
+	// transfer deliver less than [amount].
+	balanceBefore, err := k.ERC20().BalanceOf(erc20, evmModuleAddr, ctx)
+	if err != nil {
+		return bankCoin, errors.Wrap(err, "failed to retrieve the ERC20 balance of the EVM module account")
+	}
+	_, err = k.ERC20().Transfer(erc20, from, evmModuleAddr, amount, ctx)
+	if err != nil {
+		return bankCoin, errors.Wrap(err, "failed to send ERC20 to the EVM module account")
+	}
+	balanceAfter, err := k.ERC20().BalanceOf(erc20, evmModuleAddr, ctx)
+	if err != nil {
+		return bankCoin, errors.Wrap(err, "failed to retrieve the ERC20 balance of the EVM module account")
+	}
+	received := new(big.Int).Sub(balanceAfter, balanceBefore)
+	if received.Sign() != 1 {
+		return bankCoin, fmt.Errorf("the EVM module account received no ERC20 tokens, balance change: %s", received)
+	}
+	amount = received
+
+	bankCoin = sdk.NewCoin(funtoken.BankDenom, math.NewIntFromBigInt(amount))
+	coins := sdk.NewCoins(bankCoin)
+
+	// Step 2: Burn the ERC20 (coin-originated) or mint the bank coin
+	// (ERC20-originated) so that the supply on both sides stays in sync.
+	if funtoken.IsMadeFromCoin {
+		_, err = k.ERC20().Burn(erc20, evmModuleAddr, amount, ctx)
+		if err != nil {
+			return bankCoin, errors.Wrap(err, "ERC20.Burn")
+		}
+	} else {
+		err = k.bankKeeper.MintCoins(ctx, evm.ModuleName, coins)
+		if err != nil {
+			return bankCoin, errors.Wrapf(err, "mint failed for module \"%s\"", evm.ModuleName)
+		}
+	}
+
+	// Step 3: Send the bank coins from the EVM module account to the recipient
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, evm.ModuleName, to, coins)
+	if err != nil {
+		return bankCoin, errors.Wrapf(err, "send failed for module \"%s\"", evm.ModuleName)
+	}
+	return bankCoin, nil
+}
+
+// SetFunTokenPaused: [SUDO] Only callable by the x/sudo root. Pauses or
+// resumes the conversions of a FunToken mapping.
+func (k *Keeper) SetFunTokenPaused(
+	goCtx context.Context, msg *evm.MsgSetFunTokenPaused,
+) (resp *evm.MsgSetFunTokenPausedResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	funtoken, err := k.Sudo().SetFunTokenPaused(ctx, msg.Erc20Addr, msg.Paused, sender)
+	if err != nil {
+		return nil, err
+	}
+	return &evm.MsgSetFunTokenPausedResponse{FuntokenMapping: funtoken}, nil
+}
+
+// UnregisterFunToken: [SUDO] Only callable by the x/sudo root. Deletes a
+// FunToken mapping once its supply is fully unwound.
+func (k *Keeper) UnregisterFunToken(
+	goCtx context.Context, msg *evm.MsgUnregisterFunToken,
+) (resp *evm.MsgUnregisterFunTokenResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Sudo().UnregisterFunToken(ctx, msg.Erc20Addr, sender); err != nil {
+		return nil, err
+	}
+	return &evm.MsgUnregisterFunTokenResponse{}, nil
+}
+
+// RefreshFunTokenMetadata: [SUDO] Only callable by the x/sudo root. Re-syncs
+// the bank metadata of a FunToken mapping with its ERC20.
+func (k *Keeper) RefreshFunTokenMetadata(
+	goCtx context.Context, msg *evm.MsgRefreshFunTokenMetadata,
+) (resp *evm.MsgRefreshFunTokenMetadataResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	bankMetadata, err := k.Sudo().RefreshFunTokenMetadata(ctx, msg.Erc20Addr, sender)
+	if err != nil {
+		return nil, err
+	}
+	return &evm.MsgRefreshFunTokenMetadataResponse{BankMetadata: bankMetadata}, nil
+}
+
+// EditCreateAllowlist: [SUDO] Only callable by the x/sudo root. Adds and
+// removes addresses from the allowlist of the "create_policy" param.
+func (k *Keeper) EditCreateAllowlist(
+	goCtx context.Context, msg *evm.MsgEditCreateAllowlist,
+) (resp *evm.MsgEditCreateAllowlistResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := k.Sudo().EditCreateAllowlist(ctx, msg.AddAddrs, msg.RemoveAddrs, sender)
+	if err != nil {
+		return nil, err
+	}
+	return &evm.MsgEditCreateAllowlistResponse{CreatePolicy: policy}, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/statedb"
+)
+
+// parseCallOverrides decodes the JSON state and block overrides of a call
+// request. Empty JSON means no overrides.
+func parseCallOverrides(
+	stateOverridesJSON, blockOverridesJSON []byte,
+) (stateOverride evm.StateOverride, blockOverrides *evm.BlockOverrides, err error) {
+	if len(stateOverridesJSON) > 0 {
+		if err := json.Unmarshal(stateOverridesJSON, &stateOverride); err != nil {
+			return nil, nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "state overrides: %s", err)
+		}
+	}
+	if len(blockOverridesJSON) > 0 {
+		if err := json.Unmarshal(blockOverridesJSON, &blockOverrides); err != nil {
+			return nil, nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "block overrides: %s", err)
+		}
+	}
+	return stateOverride, blockOverrides, nil
+}
+
+// ApplyStateOverride returns a branch of ctx with the state overrides of a
+// call applied. The branch is never written to ctx, so the overrides only
+// live as long as the call that uses the returned context.
+func (k *Keeper) ApplyStateOverride(
+	ctx sdk.Context, stateOverride evm.StateOverride,
+) (sdk.Context, error) {
+	if len(stateOverride) == 0 {
+		return ctx, nil
+	}
+	if err := stateOverride.Validate(); err != nil {
+		return ctx, err
+	}
+
+	ctx, _ = ctx.CacheContext()
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash())))
+	for addr, account := range stateOverride {
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil {
+			balance := new(big.Int).Set(stateDB.GetBalance(addr))
+			stateDB.SubBalance(addr, balance)
+			stateDB.AddBalance(addr, (*account.Balance).ToInt())
+		}
+		if account.State != nil {
+			// Replace the entire storage: clear the slots that are not set.
+			if err := stateDB.ForEachStorage(addr, func(key, _ gethcommon.Hash) bool {
+				if _, ok := (*account.State)[key]; !ok {
+					stateDB.SetState(addr, key, gethcommon.Hash{})
+				}
+				return true
+			}); err != nil {
+				return ctx, err
+			}
+			for key, value := range *account.State {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+	if err := stateDB.Commit(); err != nil {
+		return ctx, err
+	}
+	return ctx, nil
+}
+
+// ApplyBlockOverrides returns ctx with the block overrides of a call applied
+// to its header and consensus params. The coinbase and base fee are
+// overridden in the EVM config.
+func ApplyBlockOverrides(
+	ctx sdk.Context, cfg *statedb.EVMConfig, blockOverrides *evm.BlockOverrides,
+) sdk.Context {
+	if blockOverrides == nil {
+		return ctx
+	}
+	if blockOverrides.Number != nil {
+		ctx = ctx.WithBlockHeight(blockOverrides.Number.ToInt().Int64())
+	}
+	if blockOverrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*blockOverrides.Time), 0).UTC())
+	}
+	if blockOverrides.GasLimit != nil {
+		maxGas := int64(-1) // no limit
+		if gasLimit := uint64(*blockOverrides.GasLimit); gasLimit <= math.MaxInt64 {
+			maxGas = int64(gasLimit)
+		}
+		cp := cmtproto.ConsensusParams{}
+		if ctx.ConsensusParams() != nil {
+			cp = *ctx.ConsensusParams()
+		}
+		block := cmtproto.BlockParams{}
+		if cp.Block != nil {
+			block = *cp.Block
+		}
+		block.MaxGas = maxGas
+		cp.Block = &block
+		ctx = ctx.WithConsensusParams(&cp)
+	}
+	if blockOverrides.Coinbase != nil {
+		cfg.CoinBase = *blockOverrides.Coinbase
+	}
+	if blockOverrides.BaseFee != nil {
+		cfg.BaseFee = blockOverrides.BaseFee.ToInt()
+	}
+	return ctx
+}
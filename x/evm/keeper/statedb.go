@@ -103,7 +103,7 @@ func (k *Keeper) SetAccBalance(
 
 // SetAccount: Updates nonce, balance, and codeHash.
 // Implements the `statedb.Keeper` interface.
-// Only called by `StateDB.Commit()`.
+// Called by `StateDB.Commit()` and before state-changing precompile calls.
 func (k *Keeper) SetAccount(
 	ctx sdk.Context, addr gethcommon.Address, account statedb.Account,
 ) error {
@@ -137,7 +137,7 @@ func (k *Keeper) SetAccount(
 
 // SetState:  Update contract storage, delete if value is empty.
 // Implements the `statedb.Keeper` interface.
-// Only called by `StateDB.Commit()`.
+// Called by `StateDB.Commit()` and before state-changing precompile calls.
 func (k *Keeper) SetState(
 	ctx sdk.Context, addr gethcommon.Address, stateKey gethcommon.Hash, stateValue []byte,
 ) {
@@ -146,7 +146,7 @@ func (k *Keeper) SetState(
 
 // SetCode: Setter for smart contract bytecode. Delete if code is empty.
 // Implements the `statedb.Keeper` interface.
-// Only called by `StateDB.Commit()`.
+// Called by `StateDB.Commit()` and before state-changing precompile calls.
 func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
 	k.EvmState.SetAccCode(ctx, codeHash, code)
 }
@@ -154,7 +154,7 @@ func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
 // DeleteAccount handles contract's suicide call, clearing the balance, contract
 // bytecode, contract state, and its native account.
 // Implements the `statedb.Keeper` interface.
-// Only called by `StateDB.Commit()`.
+// Called by `StateDB.Commit()` and before state-changing precompile calls.
 func (k *Keeper) DeleteAccount(ctx sdk.Context, addr gethcommon.Address) error {
 	nibiruAddr := sdk.AccAddress(addr.Bytes())
 	acct := k.accountKeeper.GetAccount(ctx, nibiruAddr)
@@ -0,0 +1,195 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// Sudo extends the Keeper with sudo functions. See [x/sudo].
+//
+// These sudo functions should:
+// 1. Not be called in other methods in the module.
+// 2. Only be callable by the x/sudo root.
+//
+// The intention behind "[Keeper.Sudo]" is to make it more obvious to the
+// developer that an unsafe function is being used when it's called.
+// [x/sudo]: https://pkg.go.dev/github.com/NibiruChain/nibiru@v1.1.0/x/sudo/keeper
+func (k Keeper) Sudo() sudoExtension { return sudoExtension{k} }
+
+type sudoExtension struct{ Keeper }
+
+// checkRoot errors if "sender" is not the x/sudo root.
+func (k sudoExtension) checkRoot(ctx sdk.Context, sender sdk.AccAddress) error {
+	root, err := k.sudoKeeper.GetRootAddr(ctx)
+	if err != nil {
+		return err
+	}
+	if !root.Equals(sender) {
+		return fmt.Errorf(
+			"insufficient permissions: sender %s is not the x/sudo root", sender,
+		)
+	}
+	return nil
+}
+
+// funTokenForERC20 returns the FunToken mapping of the given ERC20.
+func (k Keeper) funTokenForERC20(
+	ctx sdk.Context, erc20 eth.HexAddr,
+) (funtoken evm.FunToken, err error) {
+	funtokens := k.FunTokens.Collect(
+		ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20.ToAddr()),
+	)
+	if len(funtokens) == 0 {
+		return funtoken, fmt.Errorf("funtoken for ERC20 \"%s\" does not exist", erc20)
+	}
+	return funtokens[0], nil
+}
+
+// SetFunTokenPaused pauses or resumes the conversions of the FunToken mapping
+// of an ERC20. See [evm.FunToken.AssertNotPaused].
+func (k sudoExtension) SetFunTokenPaused(
+	ctx sdk.Context, erc20 eth.HexAddr, paused bool, sender sdk.AccAddress,
+) (funtoken evm.FunToken, err error) {
+	if err = k.checkRoot(ctx, sender); err != nil {
+		return
+	}
+	funtoken, err = k.funTokenForERC20(ctx, erc20)
+	if err != nil {
+		return
+	}
+
+	funtoken.Paused = paused
+	k.FunTokens.Insert(ctx, funtoken.ID(), funtoken)
+	return funtoken, nil
+}
+
+// UnregisterFunToken deletes the FunToken mapping of an ERC20. The supply must
+// be fully unwound first, meaning no tokens are left in the representation
+// that the mapping created:
+//   - For a mapping created from a bank coin, the ERC20 has no supply.
+//   - For a mapping created from an ERC20, the bank coin has no supply.
+//
+// The bank metadata of the coin is kept.
+func (k sudoExtension) UnregisterFunToken(
+	ctx sdk.Context, erc20 eth.HexAddr, sender sdk.AccAddress,
+) (err error) {
+	if err = k.checkRoot(ctx, sender); err != nil {
+		return
+	}
+	funtoken, err := k.funTokenForERC20(ctx, erc20)
+	if err != nil {
+		return
+	}
+
+	if funtoken.IsMadeFromCoin {
+		supply, err := k.ERC20().TotalSupply(erc20.ToAddr(), ctx)
+		if err != nil {
+			return err
+		}
+		if supply.Sign() != 0 {
+			return fmt.Errorf(
+				"cannot unregister FunToken: ERC20 \"%s\" has a supply of %s", erc20, supply,
+			)
+		}
+	} else {
+		supply := k.bankKeeper.GetSupply(ctx, funtoken.BankDenom)
+		if !supply.IsZero() {
+			return fmt.Errorf(
+				"cannot unregister FunToken: bank coin has a supply of %s", supply,
+			)
+		}
+	}
+
+	return k.FunTokens.Delete(ctx, funtoken.ID())
+}
+
+// RefreshFunTokenMetadata re-syncs the bank metadata of the FunToken mapping
+// of an ERC20 with the current name, symbol, and decimals of the ERC20. Only
+// mappings created from an ERC20 can be refreshed, since the bank metadata is
+// the source of truth for the ERC20 of a mapping created from a bank coin.
+func (k sudoExtension) RefreshFunTokenMetadata(
+	ctx sdk.Context, erc20 eth.HexAddr, sender sdk.AccAddress,
+) (bankMetadata bank.Metadata, err error) {
+	if err = k.checkRoot(ctx, sender); err != nil {
+		return
+	}
+	funtoken, err := k.funTokenForERC20(ctx, erc20)
+	if err != nil {
+		return
+	}
+	if funtoken.IsMadeFromCoin {
+		return bankMetadata, fmt.Errorf(
+			"cannot refresh metadata of FunToken made from bank coin \"%s\"", funtoken.BankDenom,
+		)
+	}
+
+	info, err := k.FindERC20Metadata(ctx, erc20.ToAddr())
+	if err != nil {
+		return
+	}
+	bankMetadata = BankMetadataForERC20(erc20, info)
+	if err = bankMetadata.Validate(); err != nil {
+		return
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, bankMetadata)
+	return bankMetadata, nil
+}
+
+// EditCreateAllowlist adds and removes addresses from the allowlist of the
+// "create_policy" param, without a governance proposal. Addresses match
+// regardless of their format, so an address added in hex format can be removed
+// in Bech32 format. The policy type is left unchanged.
+func (k sudoExtension) EditCreateAllowlist(
+	ctx sdk.Context, addAddrs, removeAddrs []string, sender sdk.AccAddress,
+) (policy evm.CreatePolicy, err error) {
+	if err = k.checkRoot(ctx, sender); err != nil {
+		return
+	}
+	params := k.GetParams(ctx)
+	policy = params.CreatePolicy
+
+	for _, addrStr := range removeAddrs {
+		addr, err := evm.ParseCreatePolicyAddr(addrStr)
+		if err != nil {
+			return policy, err
+		}
+		if !policy.IsAllowlisted(addr) {
+			return policy, fmt.Errorf(
+				"cannot remove %s: address is not on the create allowlist", addr.Hex(),
+			)
+		}
+		var allowlist []string
+		for _, allowedStr := range policy.Allowlist {
+			allowed, err := evm.ParseCreatePolicyAddr(allowedStr)
+			if err == nil && allowed == addr {
+				continue
+			}
+			allowlist = append(allowlist, allowedStr)
+		}
+		policy.Allowlist = allowlist
+	}
+
+	for _, addrStr := range addAddrs {
+		addr, err := evm.ParseCreatePolicyAddr(addrStr)
+		if err != nil {
+			return policy, err
+		}
+		if policy.IsAllowlisted(addr) {
+			continue
+		}
+		policy.Allowlist = append(policy.Allowlist, addr.Hex())
+	}
+
+	if err = policy.Validate(); err != nil {
+		return
+	}
+	params.CreatePolicy = policy
+	k.SetParams(ctx, params)
+	return policy, nil
+}
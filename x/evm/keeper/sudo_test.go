@@ -0,0 +1,289 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	sudotypes "github.com/NibiruChain/nibiru/x/sudo/types"
+)
+
+// setSudoRoot makes "root" the x/sudo root account.
+func setSudoRoot(deps *evmtest.TestDeps, root sdk.AccAddress) {
+	deps.Chain.SudoKeeper.Sudoers.Set(deps.Ctx, sudotypes.Sudoers{
+		Root:      root.String(),
+		Contracts: []string{},
+	})
+}
+
+func (s *Suite) TestSetFunTokenPaused() {
+	deps := evmtest.NewTestDeps()
+	root := deps.Sender
+	setSudoRoot(&deps, root.NibiruAddr)
+
+	bankDenom := "unibi"
+	funtoken := evmtest.CreateFunTokenForBankCoin(&deps, bankDenom, &s.Suite)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 100))
+	s.Require().NoError(testapp.FundAccount(deps.Chain.BankKeeper, deps.Ctx, root.NibiruAddr, coins))
+
+	sendToEvm := func() error {
+		_, err := deps.K.SendFunTokenToEvm(deps.GoCtx(), &evm.MsgSendFunTokenToEvm{
+			Sender:    root.NibiruAddr.String(),
+			BankCoin:  sdk.NewInt64Coin(bankDenom, 10),
+			ToEthAddr: eth.NewHexAddr(root.EthAddr),
+		})
+		return err
+	}
+	convertToCoin := func() error {
+		_, err := deps.K.ConvertEvmToCoin(deps.GoCtx(), &evm.MsgConvertEvmToCoin{
+			Sender:     root.NibiruAddr.String(),
+			Erc20Addr:  funtoken.Erc20Addr,
+			Amount:     math.NewInt(1),
+			ToBankAddr: root.NibiruAddr.String(),
+		})
+		return err
+	}
+	s.Require().NoError(sendToEvm())
+
+	s.T().Log("sad: sender is not the sudo root")
+	_, err := deps.K.SetFunTokenPaused(deps.GoCtx(), &evm.MsgSetFunTokenPaused{
+		Sender:    testutil.AccAddress().String(),
+		Erc20Addr: funtoken.Erc20Addr,
+		Paused:    true,
+	})
+	s.ErrorContains(err, "insufficient permissions")
+
+	s.T().Log("sad: no funtoken mapping")
+	_, err = deps.K.SetFunTokenPaused(deps.GoCtx(), &evm.MsgSetFunTokenPaused{
+		Sender:    root.NibiruAddr.String(),
+		Erc20Addr: eth.MustNewHexAddrFromStr("0x1234500000000000000000000000000000000000"),
+		Paused:    true,
+	})
+	s.ErrorContains(err, "does not exist")
+
+	s.T().Log("happy: pause blocks every conversion")
+	resp, err := deps.K.SetFunTokenPaused(deps.GoCtx(), &evm.MsgSetFunTokenPaused{
+		Sender:    root.NibiruAddr.String(),
+		Erc20Addr: funtoken.Erc20Addr,
+		Paused:    true,
+	})
+	s.Require().NoError(err)
+	s.True(resp.FuntokenMapping.Paused)
+	s.ErrorContains(sendToEvm(), "conversions are paused")
+	s.ErrorContains(convertToCoin(), "conversions are paused")
+
+	s.T().Log("happy: unpause restores conversions")
+	resp, err = deps.K.SetFunTokenPaused(deps.GoCtx(), &evm.MsgSetFunTokenPaused{
+		Sender:    root.NibiruAddr.String(),
+		Erc20Addr: funtoken.Erc20Addr,
+		Paused:    false,
+	})
+	s.Require().NoError(err)
+	s.False(resp.FuntokenMapping.Paused)
+	s.NoError(sendToEvm())
+	s.NoError(convertToCoin())
+}
+
+func (s *Suite) TestUnregisterFunToken() {
+	s.Run("funtoken made from coin", func() {
+		deps := evmtest.NewTestDeps()
+		root := deps.Sender
+		setSudoRoot(&deps, root.NibiruAddr)
+
+		bankDenom := "unibi"
+		funtoken := evmtest.CreateFunTokenForBankCoin(&deps, bankDenom, &s.Suite)
+		coins := sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 100))
+		s.Require().NoError(testapp.FundAccount(deps.Chain.BankKeeper, deps.Ctx, root.NibiruAddr, coins))
+		_, err := deps.K.SendFunTokenToEvm(deps.GoCtx(), &evm.MsgSendFunTokenToEvm{
+			Sender:    root.NibiruAddr.String(),
+			BankCoin:  coins[0],
+			ToEthAddr: eth.NewHexAddr(root.EthAddr),
+		})
+		s.Require().NoError(err)
+
+		msg := &evm.MsgUnregisterFunToken{
+			Sender:    root.NibiruAddr.String(),
+			Erc20Addr: funtoken.Erc20Addr,
+		}
+
+		s.T().Log("sad: sender is not the sudo root")
+		_, err = deps.K.UnregisterFunToken(deps.GoCtx(), &evm.MsgUnregisterFunToken{
+			Sender:    testutil.AccAddress().String(),
+			Erc20Addr: funtoken.Erc20Addr,
+		})
+		s.ErrorContains(err, "insufficient permissions")
+
+		s.T().Log("sad: ERC20 supply outstanding")
+		_, err = deps.K.UnregisterFunToken(deps.GoCtx(), msg)
+		s.ErrorContains(err, "has a supply of 100")
+
+		s.T().Log("happy: unregister after the ERC20 supply is unwound")
+		_, err = deps.K.ConvertEvmToCoin(deps.GoCtx(), &evm.MsgConvertEvmToCoin{
+			Sender:     root.NibiruAddr.String(),
+			Erc20Addr:  funtoken.Erc20Addr,
+			Amount:     math.NewInt(100),
+			ToBankAddr: root.NibiruAddr.String(),
+		})
+		s.Require().NoError(err)
+		_, err = deps.K.UnregisterFunToken(deps.GoCtx(), msg)
+		s.Require().NoError(err)
+		s.Empty(deps.K.FunTokens.Collect(
+			deps.Ctx, deps.K.FunTokens.Indexes.BankDenom.ExactMatch(deps.Ctx, bankDenom),
+		))
+
+		s.T().Log("sad: already unregistered")
+		_, err = deps.K.UnregisterFunToken(deps.GoCtx(), msg)
+		s.ErrorContains(err, "does not exist")
+	})
+
+	s.Run("funtoken made from ERC20", func() {
+		deps := evmtest.NewTestDeps()
+		root := deps.Sender
+		setSudoRoot(&deps, root.NibiruAddr)
+
+		deployResp, err := evmtest.DeployContract(
+			&deps, embeds.SmartContract_ERC20Minter, s.T(), "erc20name", "TOKEN", uint8(18),
+		)
+		s.Require().NoError(err)
+		erc20 := deployResp.ContractAddr
+		_, err = deps.K.ERC20().Mint(erc20, root.EthAddr, root.EthAddr, big.NewInt(1_000), deps.Ctx)
+		s.Require().NoError(err)
+
+		erc20Addr := eth.NewHexAddr(erc20)
+		s.Require().NoError(evmtest.FundFeeForCreateFunToken(&deps, root.NibiruAddr))
+		createResp, err := deps.K.CreateFunToken(deps.GoCtx(), &evm.MsgCreateFunToken{
+			FromErc20: &erc20Addr,
+			Sender:    root.NibiruAddr.String(),
+		})
+		s.Require().NoError(err)
+		bankDenom := createResp.FuntokenMapping.BankDenom
+
+		_, err = deps.K.ConvertEvmToCoin(deps.GoCtx(), &evm.MsgConvertEvmToCoin{
+			Sender:     root.NibiruAddr.String(),
+			Erc20Addr:  erc20Addr,
+			Amount:     math.NewInt(250),
+			ToBankAddr: root.NibiruAddr.String(),
+		})
+		s.Require().NoError(err)
+
+		msg := &evm.MsgUnregisterFunToken{
+			Sender:    root.NibiruAddr.String(),
+			Erc20Addr: erc20Addr,
+		}
+
+		s.T().Log("sad: bank coin supply outstanding")
+		_, err = deps.K.UnregisterFunToken(deps.GoCtx(), msg)
+		s.ErrorContains(err, "has a supply of 250"+bankDenom)
+
+		s.T().Log("happy: unregister after the bank coin supply is unwound")
+		coins := sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 250))
+		s.Require().NoError(deps.Chain.BankKeeper.SendCoinsFromAccountToModule(
+			deps.Ctx, root.NibiruAddr, evm.ModuleName, coins,
+		))
+		s.Require().NoError(deps.Chain.BankKeeper.BurnCoins(deps.Ctx, evm.ModuleName, coins))
+		_, err = deps.K.UnregisterFunToken(deps.GoCtx(), msg)
+		s.Require().NoError(err)
+		s.Empty(deps.K.FunTokens.Collect(
+			deps.Ctx, deps.K.FunTokens.Indexes.ERC20Addr.ExactMatch(deps.Ctx, erc20),
+		))
+	})
+}
+
+func (s *Suite) TestRefreshFunTokenMetadata() {
+	deps := evmtest.NewTestDeps()
+	root := deps.Sender
+	setSudoRoot(&deps, root.NibiruAddr)
+
+	deployResp, err := evmtest.DeployContract(
+		&deps, embeds.SmartContract_ERC20Minter, s.T(), "gwei", "GWEI", uint8(9),
+	)
+	s.Require().NoError(err)
+	erc20Addr := eth.NewHexAddr(deployResp.ContractAddr)
+	s.Require().NoError(evmtest.FundFeeForCreateFunToken(&deps, root.NibiruAddr))
+	createResp, err := deps.K.CreateFunToken(deps.GoCtx(), &evm.MsgCreateFunToken{
+		FromErc20: &erc20Addr,
+		Sender:    root.NibiruAddr.String(),
+	})
+	s.Require().NoError(err)
+	bankDenom := createResp.FuntokenMapping.BankDenom
+
+	s.T().Log("sad: sender is not the sudo root")
+	_, err = deps.K.RefreshFunTokenMetadata(deps.GoCtx(), &evm.MsgRefreshFunTokenMetadata{
+		Sender:    testutil.AccAddress().String(),
+		Erc20Addr: erc20Addr,
+	})
+	s.ErrorContains(err, "insufficient permissions")
+
+	s.T().Log("happy: bank metadata re-synced with the ERC20")
+	setBankDenomMetadata(deps.Ctx, deps.Chain.BankKeeper, bankDenom)
+	resp, err := deps.K.RefreshFunTokenMetadata(deps.GoCtx(), &evm.MsgRefreshFunTokenMetadata{
+		Sender:    root.NibiruAddr.String(),
+		Erc20Addr: erc20Addr,
+	})
+	s.Require().NoError(err)
+	s.Equal("gwei", resp.BankMetadata.Name)
+	s.Equal("GWEI", resp.BankMetadata.Symbol)
+	s.Equal("GWEI", resp.BankMetadata.Display)
+	s.Require().Len(resp.BankMetadata.DenomUnits, 2)
+	s.EqualValues(9, resp.BankMetadata.DenomUnits[1].Exponent)
+	bankMetadata, _ := deps.Chain.BankKeeper.GetDenomMetaData(deps.Ctx, bankDenom)
+	s.Equal(resp.BankMetadata, bankMetadata)
+
+	s.T().Log("sad: funtoken made from coin")
+	funtoken := evmtest.CreateFunTokenForBankCoin(&deps, "unibi", &s.Suite)
+	_, err = deps.K.RefreshFunTokenMetadata(deps.GoCtx(), &evm.MsgRefreshFunTokenMetadata{
+		Sender:    root.NibiruAddr.String(),
+		Erc20Addr: funtoken.Erc20Addr,
+	})
+	s.ErrorContains(err, "made from bank coin")
+}
+
+func (s *Suite) TestEditCreateAllowlist() {
+	deps := evmtest.NewTestDeps()
+	root := deps.Sender
+	setSudoRoot(&deps, root.NibiruAddr)
+	alice, bob := evmtest.NewEthAccInfo(), evmtest.NewEthAccInfo()
+
+	s.T().Log("sad: sender is not the sudo root")
+	_, err := deps.K.EditCreateAllowlist(deps.GoCtx(), &evm.MsgEditCreateAllowlist{
+		Sender:   testutil.AccAddress().String(),
+		AddAddrs: []string{alice.EthAddr.Hex()},
+	})
+	s.ErrorContains(err, "insufficient permissions")
+
+	s.T().Log("happy: add addresses in hex and Bech32 format")
+	resp, err := deps.K.EditCreateAllowlist(deps.GoCtx(), &evm.MsgEditCreateAllowlist{
+		Sender:   root.NibiruAddr.String(),
+		AddAddrs: []string{alice.EthAddr.Hex(), bob.NibiruAddr.String(), alice.NibiruAddr.String()},
+	})
+	s.Require().NoError(err)
+	s.Equal([]string{alice.EthAddr.Hex(), bob.EthAddr.Hex()}, resp.CreatePolicy.Allowlist)
+	s.Equal(resp.CreatePolicy, deps.K.GetParams(deps.Ctx).CreatePolicy)
+	s.Equal(
+		evm.CreatePolicyType_CREATE_POLICY_TYPE_PERMISSIONLESS, resp.CreatePolicy.Type,
+		"the policy type is left unchanged",
+	)
+
+	s.T().Log("sad: remove an address that is not on the allowlist")
+	_, err = deps.K.EditCreateAllowlist(deps.GoCtx(), &evm.MsgEditCreateAllowlist{
+		Sender:      root.NibiruAddr.String(),
+		RemoveAddrs: []string{root.EthAddr.Hex()},
+	})
+	s.ErrorContains(err, "not on the create allowlist")
+
+	s.T().Log("happy: remove an address given in another format")
+	resp, err = deps.K.EditCreateAllowlist(deps.GoCtx(), &evm.MsgEditCreateAllowlist{
+		Sender:      root.NibiruAddr.String(),
+		RemoveAddrs: []string{alice.NibiruAddr.String()},
+	})
+	s.Require().NoError(err)
+	s.Equal([]string{bob.EthAddr.Hex()}, resp.CreatePolicy.Allowlist)
+}
@@ -68,8 +68,15 @@ func (k Keeper) VMConfig(
 }
 
 // GetCoinbaseAddress returns the block proposer's validator operator address.
+// In InitChain, there is no block proposer and the coinbase is the zero
+// address.
 func (k Keeper) GetCoinbaseAddress(ctx sdk.Context, proposerAddress sdk.ConsAddress) (common.Address, error) {
-	validator, found := k.stakingKeeper.GetValidatorByConsAddr(ctx, ParseProposerAddr(ctx, proposerAddress))
+	proposerAddress = ParseProposerAddr(ctx, proposerAddress)
+	if len(proposerAddress) == 0 {
+		return common.Address{}, nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidatorByConsAddr(ctx, proposerAddress)
 	if !found {
 		return common.Address{}, errors.Wrapf(
 			stakingtypes.ErrNoValidatorFound,
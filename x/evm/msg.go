@@ -34,6 +34,12 @@ var (
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
 	_ sdk.Msg    = &MsgCreateFunToken{}
+	_ sdk.Msg    = &MsgSendFunTokenToEvm{}
+	_ sdk.Msg    = &MsgConvertEvmToCoin{}
+	_ sdk.Msg    = &MsgSetFunTokenPaused{}
+	_ sdk.Msg    = &MsgUnregisterFunToken{}
+	_ sdk.Msg    = &MsgRefreshFunTokenMetadata{}
+	_ sdk.Msg    = &MsgEditCreateAllowlist{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -534,3 +540,126 @@ func (m *MsgSendFunTokenToEvm) ValidateBasic() error {
 func (m MsgSendFunTokenToEvm) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgConvertEvmToCoin message.
+func (m MsgConvertEvmToCoin) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+func errMsgConvertEvmToCoinValidate(errMsg string) error {
+	return fmt.Errorf("MsgConvertEvmToCoin ValidateBasic error: %s", errMsg)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgConvertEvmToCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errMsgConvertEvmToCoinValidate("invalid sender addr")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ToBankAddr); err != nil {
+		return errMsgConvertEvmToCoinValidate("invalid \"to_bank_addr\"")
+	}
+	if err := m.Erc20Addr.Valid(); err != nil {
+		return errMsgConvertEvmToCoinValidate(err.Error())
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errMsgConvertEvmToCoinValidate("\"amount\" must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgConvertEvmToCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// validateSudoFunTokenMsg: ValidateBasic for the sudo messages that act on a
+// "FunToken" mapping given by its ERC20 address.
+func validateSudoFunTokenMsg(msgName, sender string, erc20 eth.HexAddr) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return fmt.Errorf("%s ValidateBasic error: invalid sender addr", msgName)
+	}
+	if err := erc20.Valid(); err != nil {
+		return fmt.Errorf("%s ValidateBasic error: %s", msgName, err)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgSetFunTokenPaused message.
+func (m MsgSetFunTokenPaused) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFunTokenPaused) ValidateBasic() error {
+	return validateSudoFunTokenMsg("MsgSetFunTokenPaused", m.Sender, m.Erc20Addr)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetFunTokenPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUnregisterFunToken message.
+func (m MsgUnregisterFunToken) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUnregisterFunToken) ValidateBasic() error {
+	return validateSudoFunTokenMsg("MsgUnregisterFunToken", m.Sender, m.Erc20Addr)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUnregisterFunToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRefreshFunTokenMetadata
+// message.
+func (m MsgRefreshFunTokenMetadata) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRefreshFunTokenMetadata) ValidateBasic() error {
+	return validateSudoFunTokenMsg("MsgRefreshFunTokenMetadata", m.Sender, m.Erc20Addr)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRefreshFunTokenMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgEditCreateAllowlist
+// message.
+func (m MsgEditCreateAllowlist) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgEditCreateAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("MsgEditCreateAllowlist ValidateBasic error: invalid sender addr")
+	}
+	if len(m.AddAddrs) == 0 && len(m.RemoveAddrs) == 0 {
+		return fmt.Errorf("MsgEditCreateAllowlist ValidateBasic error: no addresses to add or remove")
+	}
+	for _, addrs := range [][]string{m.AddAddrs, m.RemoveAddrs} {
+		for _, addr := range addrs {
+			if _, err := ParseCreatePolicyAddr(addr); err != nil {
+				return fmt.Errorf("MsgEditCreateAllowlist ValidateBasic error: %s", err)
+			}
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgEditCreateAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
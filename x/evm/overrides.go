@@ -0,0 +1,46 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[gethcommon.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64                      `json:"nonce"`
+	Code      *hexutil.Bytes                       `json:"code"`
+	Balance   **hexutil.Big                        `json:"balance"`
+	State     *map[gethcommon.Hash]gethcommon.Hash `json:"state"`
+	StateDiff *map[gethcommon.Hash]gethcommon.Hash `json:"stateDiff"`
+}
+
+// Validate returns an error if an account overrides both its "state" and its
+// "stateDiff".
+func (diff StateOverride) Validate() error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is a set of header fields to override for a message call.
+type BlockOverrides struct {
+	Number   *hexutil.Big        `json:"number"`
+	Time     *hexutil.Uint64     `json:"time"`
+	GasLimit *hexutil.Uint64     `json:"gasLimit"`
+	Coinbase *gethcommon.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big        `json:"baseFee"`
+}
@@ -3,15 +3,18 @@ package evm
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"golang.org/x/exp/slices"
 
@@ -32,10 +35,67 @@ var (
 	DefaultEnableCall = true
 	// AvailableEVMExtensions defines the default active precompiles
 	AvailableEVMExtensions = []string{}
-	DefaultExtraEIPs       = []int64{}
-	DefaultEVMChannels     = []string{}
+	// ShanghaiEIPs are the EIPs of the Shanghai hard fork that the EVM can
+	// activate on top of the Merge instruction set: EIP-3855 (PUSH0).
+	ShanghaiEIPs       = []int64{3855}
+	DefaultExtraEIPs   = ShanghaiEIPs
+	DefaultEVMChannels = []string{}
 )
 
+const (
+	// DefaultPrecompileBaseGas is the base cost of a precompile method
+	// without an explicit entry in the gas schedule.
+	DefaultPrecompileBaseGas uint64 = 2_000
+	// DefaultPrecompileGasPerInputByte is the cost charged for each byte of
+	// input to a precompile. It matches the cost of non-zero calldata bytes.
+	DefaultPrecompileGasPerInputByte uint64 = 16
+)
+
+const (
+	// DefaultBaseFeeChangeDenominator bounds the change of the base fee
+	// between blocks to 12.5%, as in EIP-1559.
+	DefaultBaseFeeChangeDenominator uint32 = 8
+	// DefaultElasticityMultiplier sets the gas target of a block to half of
+	// the block gas limit, as in EIP-1559.
+	DefaultElasticityMultiplier uint32 = 2
+)
+
+// DefaultMinBaseFee is the default floor of the base fee. It is zero, so the
+// base fee only rises above zero when blocks use more gas than their target.
+func DefaultMinBaseFee() sdkmath.Int { return sdkmath.ZeroInt() }
+
+// DefaultCreateFunTokenFee is the default fee to register a new FunToken
+// mapping: 10,000 NIBI. It prices out spam registrations of arbitrary bank
+// denoms, which each deploy an ERC20 contract.
+func DefaultCreateFunTokenFee() sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(DefaultEVMDenom, sdkmath.NewInt(10_000_000_000)))
+}
+
+// DefaultCreatePolicy is the default policy for contract creation: anyone can
+// deploy contracts.
+func DefaultCreatePolicy() CreatePolicy {
+	return CreatePolicy{
+		Type: CreatePolicyType_CREATE_POLICY_TYPE_PERMISSIONLESS,
+	}
+}
+
+// DefaultPrecompileGasSchedule returns the default gas schedule for the
+// custom Nibiru precompiles.
+func DefaultPrecompileGasSchedule() PrecompileGasSchedule {
+	return PrecompileGasSchedule{
+		BaseGas:         DefaultPrecompileBaseGas,
+		GasPerInputByte: DefaultPrecompileGasPerInputByte,
+		MethodGas: []PrecompileMethodGas{
+			{
+				// FunToken precompile: "bankSend"
+				Precompile: "0x0000000000000000000000000000000000000800",
+				Method:     "bankSend",
+				Gas:        10_000,
+			},
+		},
+	}
+}
+
 // NewParams creates a new Params instance
 func NewParams(
 	evmDenom string,
@@ -58,7 +118,8 @@ func NewParams(
 }
 
 // DefaultParams returns default evm parameters
-// ExtraEIPs is empty to prevent overriding the latest hard fork instruction set
+// ExtraEIPs only holds the Shanghai EIPs to avoid overriding the latest hard
+// fork instruction set
 // ActivePrecompiles is empty to prevent overriding the default precompiles
 // from the EVM configuration.
 func DefaultParams() Params {
@@ -70,6 +131,14 @@ func DefaultParams() Params {
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
 		ActivePrecompiles:   AvailableEVMExtensions,
 		EVMChannels:         DefaultEVMChannels,
+		PrecompileGas:       DefaultPrecompileGasSchedule(),
+		CreateFuntokenFee:   DefaultCreateFunTokenFee(),
+
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		ElasticityMultiplier:     DefaultElasticityMultiplier,
+		MinBaseFee:               DefaultMinBaseFee(),
+
+		CreatePolicy: DefaultCreatePolicy(),
 	}
 }
 
@@ -117,7 +186,87 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateChannels(p.EVMChannels)
+	if err := validateChannels(p.EVMChannels); err != nil {
+		return err
+	}
+
+	if err := p.CreateFuntokenFee.Validate(); err != nil {
+		return fmt.Errorf("invalid create_funtoken_fee: %w", err)
+	}
+
+	if err := p.validateFeeMarket(); err != nil {
+		return err
+	}
+
+	if err := p.CreatePolicy.Validate(); err != nil {
+		return err
+	}
+
+	return p.PrecompileGas.Validate()
+}
+
+// validateFeeMarket checks the parameters of the EIP-1559 base fee.
+func (p Params) validateFeeMarket() error {
+	if p.BaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("base_fee_change_denominator cannot be 0")
+	}
+	if p.ElasticityMultiplier == 0 {
+		return fmt.Errorf("elasticity_multiplier cannot be 0")
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min_base_fee cannot be nil or negative: %s", p.MinBaseFee)
+	}
+	return nil
+}
+
+// NextBaseFee returns the EIP-1559 base fee of a block, given the base fee and
+// gas used of its parent block and the block gas limit. The base fee moves
+// toward keeping blocks at their gas target, by at most 1/denominator per
+// block, and never goes below the min base fee. Without a block gas limit
+// ("gasLimit" of 0 or the max uint64), there is no gas target and the base
+// fee stays the same.
+func (p Params) NextBaseFee(
+	parentBaseFee *big.Int, parentGasUsed, gasLimit uint64,
+) *big.Int {
+	minBaseFee := big.NewInt(0)
+	if !p.MinBaseFee.IsNil() {
+		minBaseFee = p.MinBaseFee.BigInt()
+	}
+	maxBig := func(a, b *big.Int) *big.Int {
+		if a.Cmp(b) < 0 {
+			return b
+		}
+		return new(big.Int).Set(a)
+	}
+
+	// Params stored before the fee market have no denominator or multiplier.
+	if p.BaseFeeChangeDenominator == 0 || p.ElasticityMultiplier == 0 ||
+		gasLimit == 0 || gasLimit == gethmath.MaxUint64 {
+		return maxBig(parentBaseFee, minBaseFee)
+	}
+
+	gasTarget := gasLimit / uint64(p.ElasticityMultiplier)
+	if gasTarget == 0 || parentGasUsed == gasTarget {
+		return maxBig(parentBaseFee, minBaseFee)
+	}
+
+	target := new(big.Int).SetUint64(gasTarget)
+	denominator := new(big.Int).SetUint64(uint64(p.BaseFeeChangeDenominator))
+	if parentGasUsed > gasTarget {
+		// The base fee rises by at least 1 so that it can leave zero.
+		gasUsedDelta := new(big.Int).SetUint64(parentGasUsed - gasTarget)
+		delta := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
+		delta.Div(delta, target).Div(delta, denominator)
+		if delta.Sign() == 0 {
+			delta.SetInt64(1)
+		}
+		return maxBig(new(big.Int).Add(parentBaseFee, delta), minBaseFee)
+	}
+
+	gasUsedDelta := new(big.Int).SetUint64(gasTarget - parentGasUsed)
+	delta := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
+	delta.Div(delta, target).Div(delta, denominator)
+	return maxBig(new(big.Int).Sub(parentBaseFee, delta), minBaseFee)
 }
 
 // EIPs returns the ExtraEIPS as a int slice
@@ -223,3 +372,126 @@ func ValidatePrecompiles(i interface{}) error {
 
 	return nil
 }
+
+// Validate checks that every method entry of the gas schedule names a valid
+// precompile address and method, and that no entry is duplicated.
+func (s PrecompileGasSchedule) Validate() error {
+	seen := make(map[string]struct{})
+	for _, mg := range s.MethodGas {
+		if err := eth.ValidateAddress(mg.Precompile); err != nil {
+			return fmt.Errorf("invalid precompile gas schedule: invalid precompile %s", mg.Precompile)
+		}
+		if strings.TrimSpace(mg.Method) == "" {
+			return fmt.Errorf("invalid precompile gas schedule: empty method for precompile %s", mg.Precompile)
+		}
+		key := common.HexToAddress(mg.Precompile).Hex() + "/" + mg.Method
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("invalid precompile gas schedule: duplicate entry for %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// MethodBaseGas returns the base gas of the given precompile method, falling
+// back to BaseGas when the method has no entry in the schedule.
+func (s PrecompileGasSchedule) MethodBaseGas(precompile common.Address, method string) uint64 {
+	for _, mg := range s.MethodGas {
+		if mg.Method == method && common.HexToAddress(mg.Precompile) == precompile {
+			return mg.Gas
+		}
+	}
+	return s.BaseGas
+}
+
+// RequiredGas returns the static cost of calling a precompile method: its
+// base gas plus the per-byte cost of the call input. The result saturates at
+// the maximum uint64 instead of overflowing.
+func (s PrecompileGasSchedule) RequiredGas(
+	precompile common.Address, method string, input []byte,
+) uint64 {
+	inputGas, overflow := gethmath.SafeMul(uint64(len(input)), s.GasPerInputByte)
+	if overflow {
+		return gethmath.MaxUint64
+	}
+	gas, overflow := gethmath.SafeAdd(s.MethodBaseGas(precompile, method), inputGas)
+	if overflow {
+		return gethmath.MaxUint64
+	}
+	return gas
+}
+
+// ParseCreatePolicyAddr parses an address of the allowlist of a
+// [CreatePolicy], given in hex (0x) or Bech32 (nibi) format.
+func ParseCreatePolicyAddr(addr string) (common.Address, error) {
+	if common.IsHexAddress(addr) {
+		return common.HexToAddress(addr), nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return common.Address{}, fmt.Errorf(
+			"invalid create policy address \"%s\": must be a hex or Bech32 address", addr,
+		)
+	}
+	return common.BytesToAddress(accAddr), nil
+}
+
+// Validate checks that the policy type is known and that each address of the
+// allowlist is valid and listed once, regardless of its format.
+func (p CreatePolicy) Validate() error {
+	if _, ok := CreatePolicyType_name[int32(p.Type)]; !ok {
+		return fmt.Errorf("invalid create policy: unknown type %d", p.Type)
+	}
+	seen := make(map[common.Address]struct{})
+	for _, addrStr := range p.Allowlist {
+		addr, err := ParseCreatePolicyAddr(addrStr)
+		if err != nil {
+			return fmt.Errorf("invalid create policy: %w", err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("invalid create policy: duplicate address %s", addr.Hex())
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+// IsAllowlisted returns true if the address is on the allowlist of the policy.
+// Invalid entries never match.
+func (p CreatePolicy) IsAllowlisted(addr common.Address) bool {
+	for _, addrStr := range p.Allowlist {
+		allowed, err := ParseCreatePolicyAddr(addrStr)
+		if err == nil && allowed == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckCreate returns an error if the policy forbids a contract deployment.
+// The "origin" is the signer of the tx, and the "creator" is the account that
+// deploys the contract: the signer itself for a contract creation tx, or the
+// contract that runs CREATE or CREATE2 when "nested" is true.
+func (p CreatePolicy) CheckCreate(origin, creator common.Address, nested bool) error {
+	switch p.Type {
+	case CreatePolicyType_CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST:
+		if !p.IsAllowlisted(origin) {
+			return errorsmod.Wrapf(
+				ErrCreateNotAllowed, "deployer %s is not on the allowlist", origin.Hex(),
+			)
+		}
+	case CreatePolicyType_CREATE_POLICY_TYPE_FACTORY_ALLOWLIST:
+		if !nested {
+			return errorsmod.Wrap(
+				ErrCreateNotAllowed,
+				"contracts can only be deployed by the factories of the allowlist",
+			)
+		}
+		if !p.IsAllowlisted(creator) {
+			return errorsmod.Wrapf(
+				ErrCreateNotAllowed, "factory %s is not on the allowlist", creator.Hex(),
+			)
+		}
+	}
+	return nil
+}
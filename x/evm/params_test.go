@@ -0,0 +1,306 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+func (s *TestSuite) TestPrecompileGasSchedule() {
+	precompileAddr := "0x0000000000000000000000000000000000000800"
+
+	s.Run("default params are valid", func() {
+		s.Require().NoError(evm.DefaultParams().Validate())
+	})
+
+	for _, tc := range []struct {
+		name     string
+		schedule evm.PrecompileGasSchedule
+		wantErr  string
+	}{
+		{
+			name:     "empty schedule",
+			schedule: evm.PrecompileGasSchedule{},
+		},
+		{
+			name: "invalid precompile address",
+			schedule: evm.PrecompileGasSchedule{
+				MethodGas: []evm.PrecompileMethodGas{
+					{Precompile: "not-an-address", Method: "bankSend", Gas: 1},
+				},
+			},
+			wantErr: "invalid precompile",
+		},
+		{
+			name: "empty method",
+			schedule: evm.PrecompileGasSchedule{
+				MethodGas: []evm.PrecompileMethodGas{
+					{Precompile: precompileAddr, Method: " ", Gas: 1},
+				},
+			},
+			wantErr: "empty method",
+		},
+		{
+			name: "duplicate entry",
+			schedule: evm.PrecompileGasSchedule{
+				MethodGas: []evm.PrecompileMethodGas{
+					{Precompile: precompileAddr, Method: "bankSend", Gas: 1},
+					{Precompile: "0x0000000000000000000000000000000000000800", Method: "bankSend", Gas: 2},
+				},
+			},
+			wantErr: "duplicate entry",
+		},
+	} {
+		s.Run(tc.name, func() {
+			params := evm.DefaultParams()
+			params.PrecompileGas = tc.schedule
+			err := params.Validate()
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	s.Run("required gas", func() {
+		schedule := evm.PrecompileGasSchedule{
+			BaseGas:         100,
+			GasPerInputByte: 3,
+			MethodGas: []evm.PrecompileMethodGas{
+				{Precompile: precompileAddr, Method: "bankSend", Gas: 1_000},
+			},
+		}
+		addr := gethcommon.HexToAddress(precompileAddr)
+		input := make([]byte, 10)
+
+		s.Equal(uint64(1_030), schedule.RequiredGas(addr, "bankSend", input))
+		s.Equal(uint64(130), schedule.RequiredGas(addr, "otherMethod", input))
+		s.Equal(uint64(130), schedule.RequiredGas(
+			gethcommon.HexToAddress("0x0000000000000000000000000000000000000801"),
+			"bankSend", input,
+		))
+
+		schedule.GasPerInputByte = gethmath.MaxUint64
+		s.Equal(uint64(gethmath.MaxUint64), schedule.RequiredGas(addr, "bankSend", input))
+	})
+}
+
+func (s *TestSuite) TestCreateFunTokenFeeParam() {
+	params := evm.DefaultParams()
+	s.Require().Equal(evm.DefaultCreateFunTokenFee(), params.CreateFuntokenFee)
+
+	params.CreateFuntokenFee = sdk.Coins{sdk.Coin{Denom: "unibi", Amount: sdkmath.NewInt(-1)}}
+	s.Require().ErrorContains(params.Validate(), "invalid create_funtoken_fee")
+
+	params.CreateFuntokenFee = sdk.NewCoins()
+	s.Require().NoError(params.Validate())
+}
+
+func (s *TestSuite) TestFeeMarketParams() {
+	for _, tc := range []struct {
+		name    string
+		modify  func(p *evm.Params)
+		wantErr string
+	}{
+		{
+			name:    "zero base fee change denominator",
+			modify:  func(p *evm.Params) { p.BaseFeeChangeDenominator = 0 },
+			wantErr: "base_fee_change_denominator",
+		},
+		{
+			name:    "zero elasticity multiplier",
+			modify:  func(p *evm.Params) { p.ElasticityMultiplier = 0 },
+			wantErr: "elasticity_multiplier",
+		},
+		{
+			name:    "negative min base fee",
+			modify:  func(p *evm.Params) { p.MinBaseFee = sdkmath.NewInt(-1) },
+			wantErr: "min_base_fee",
+		},
+		{
+			name:    "nil min base fee",
+			modify:  func(p *evm.Params) { p.MinBaseFee = sdkmath.Int{} },
+			wantErr: "min_base_fee",
+		},
+		{
+			name:   "positive min base fee",
+			modify: func(p *evm.Params) { p.MinBaseFee = sdkmath.NewInt(1) },
+		},
+	} {
+		s.Run(tc.name, func() {
+			params := evm.DefaultParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *TestSuite) TestNextBaseFee() {
+	// gas target of 1_000_000 with the default elasticity multiplier of 2
+	const gasLimit uint64 = 2_000_000
+	for _, tc := range []struct {
+		name          string
+		minBaseFee    int64
+		parentBaseFee int64
+		parentGasUsed uint64
+		gasLimit      uint64
+		want          int64
+	}{
+		{
+			name:          "at the gas target",
+			parentBaseFee: 1_000,
+			parentGasUsed: 1_000_000,
+			gasLimit:      gasLimit,
+			want:          1_000,
+		},
+		{
+			name:          "full block raises the base fee by 1/8",
+			parentBaseFee: 1_000,
+			parentGasUsed: 2_000_000,
+			gasLimit:      gasLimit,
+			want:          1_125,
+		},
+		{
+			name:          "empty block lowers the base fee by 1/8",
+			parentBaseFee: 1_000,
+			parentGasUsed: 0,
+			gasLimit:      gasLimit,
+			want:          875,
+		},
+		{
+			name:          "base fee rises from zero",
+			parentBaseFee: 0,
+			parentGasUsed: 1_500_000,
+			gasLimit:      gasLimit,
+			want:          1,
+		},
+		{
+			name:          "base fee does not fall below the min base fee",
+			minBaseFee:    900,
+			parentBaseFee: 1_000,
+			parentGasUsed: 0,
+			gasLimit:      gasLimit,
+			want:          900,
+		},
+		{
+			name:          "no block gas limit",
+			parentBaseFee: 1_000,
+			parentGasUsed: 5_000_000,
+			gasLimit:      gethmath.MaxUint64,
+			want:          1_000,
+		},
+	} {
+		s.Run(tc.name, func() {
+			params := evm.DefaultParams()
+			params.MinBaseFee = sdkmath.NewInt(tc.minBaseFee)
+			got := params.NextBaseFee(big.NewInt(tc.parentBaseFee), tc.parentGasUsed, tc.gasLimit)
+			s.Require().Equal(big.NewInt(tc.want).String(), got.String())
+		})
+	}
+
+	s.Run("params without a fee market keep the base fee", func() {
+		params := evm.DefaultParams()
+		params.BaseFeeChangeDenominator = 0
+		got := params.NextBaseFee(big.NewInt(1_000), 0, gasLimit)
+		s.Require().Equal("1000", got.String())
+	})
+}
+
+func (s *TestSuite) TestCreatePolicy() {
+	deployer := gethcommon.HexToAddress("0x000000000000000000000000000000000000dead")
+	factory := gethcommon.HexToAddress("0x000000000000000000000000000000000000beef")
+	outsider := gethcommon.HexToAddress("0x0000000000000000000000000000000000000bad")
+
+	for _, tc := range []struct {
+		name    string
+		policy  evm.CreatePolicy
+		wantErr string
+	}{
+		{
+			name:   "permissionless",
+			policy: evm.CreatePolicy{},
+		},
+		{
+			name: "hex and Bech32 addresses",
+			policy: evm.CreatePolicy{
+				Type: evm.CreatePolicyType_CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST,
+				Allowlist: []string{
+					deployer.Hex(), sdk.AccAddress(factory.Bytes()).String(),
+				},
+			},
+		},
+		{
+			name:    "unknown type",
+			policy:  evm.CreatePolicy{Type: 3},
+			wantErr: "unknown type",
+		},
+		{
+			name: "invalid address",
+			policy: evm.CreatePolicy{
+				Type:      evm.CreatePolicyType_CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST,
+				Allowlist: []string{"not-an-address"},
+			},
+			wantErr: "must be a hex or Bech32 address",
+		},
+		{
+			name: "duplicate address in another format",
+			policy: evm.CreatePolicy{
+				Type: evm.CreatePolicyType_CREATE_POLICY_TYPE_FACTORY_ALLOWLIST,
+				Allowlist: []string{
+					factory.Hex(), sdk.AccAddress(factory.Bytes()).String(),
+				},
+			},
+			wantErr: "duplicate address",
+		},
+	} {
+		s.Run(tc.name, func() {
+			params := evm.DefaultParams()
+			params.CreatePolicy = tc.policy
+			err := params.Validate()
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	s.Run("permissionless allows every deployment", func() {
+		policy := evm.CreatePolicy{Allowlist: []string{deployer.Hex()}}
+		s.NoError(policy.CheckCreate(outsider, outsider, false))
+		s.NoError(policy.CheckCreate(outsider, factory, true))
+	})
+
+	s.Run("deployer allowlist checks the signer of the tx", func() {
+		policy := evm.CreatePolicy{
+			Type:      evm.CreatePolicyType_CREATE_POLICY_TYPE_DEPLOYER_ALLOWLIST,
+			Allowlist: []string{sdk.AccAddress(deployer.Bytes()).String()},
+		}
+		s.NoError(policy.CheckCreate(deployer, deployer, false))
+		s.NoError(policy.CheckCreate(deployer, factory, true))
+		s.ErrorIs(policy.CheckCreate(outsider, outsider, false), evm.ErrCreateNotAllowed)
+		s.ErrorIs(policy.CheckCreate(outsider, factory, true), evm.ErrCreateNotAllowed)
+	})
+
+	s.Run("factory allowlist checks the creating contract", func() {
+		policy := evm.CreatePolicy{
+			Type:      evm.CreatePolicyType_CREATE_POLICY_TYPE_FACTORY_ALLOWLIST,
+			Allowlist: []string{factory.Hex(), deployer.Hex()},
+		}
+		s.NoError(policy.CheckCreate(outsider, factory, true))
+		s.ErrorIs(policy.CheckCreate(deployer, deployer, false), evm.ErrCreateNotAllowed)
+		s.ErrorIs(policy.CheckCreate(factory, outsider, true), evm.ErrCreateNotAllowed)
+	})
+}
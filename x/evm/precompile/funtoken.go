@@ -6,7 +6,6 @@ import (
 	"reflect"
 	"sync"
 
-	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -14,7 +13,6 @@ import (
 
 	"github.com/NibiruChain/nibiru/app/keepers"
 	"github.com/NibiruChain/nibiru/eth"
-	"github.com/NibiruChain/nibiru/x/evm"
 	"github.com/NibiruChain/nibiru/x/evm/embeds"
 )
 
@@ -34,9 +32,11 @@ func (p precompileFunToken) Address() gethcommon.Address {
 	return PrecompileAddr_FuntokenGateway.ToAddr()
 }
 
-func (p precompileFunToken) RequiredGas(input []byte) (gasPrice uint64) {
-	// TODO: UD-DEBUG: not implemented yet. Currently set to 0 gasPrice
-	return 22
+// RequiredGas returns zero because the gas schedule lives in the module
+// params, which require an sdk.Context. The gas of the call is charged inside
+// of "Run" by "RunWithGasSchedule".
+func (p precompileFunToken) RequiredGas(input []byte) (gasCost uint64) {
+	return 0
 }
 
 const (
@@ -59,23 +59,26 @@ func (p precompileFunToken) Run(
 	}()
 
 	contractInput := contract.Input
-	ctx, method, args, err := OnStart(p, evm, contractInput)
+	ctx, method, args, err := OnStart(p, evm, contractInput, readonly)
 	if err != nil {
 		return nil, err
 	}
 
 	caller := contract.CallerAddress
-	switch FunTokenMethod(method.Name) {
-	case FunTokenMethod_BankSend:
-		// TODO: UD-DEBUG: Test that calling non-method on the right address does
-		// nothing.
-		bz, err = p.bankSend(ctx, caller, method, args, readonly)
-	default:
-		// TODO: UD-DEBUG: test invalid method called
-		err = fmt.Errorf("invalid method called with name \"%s\"", method.Name)
-		return
-	}
-	return
+	gasSchedule := p.EvmKeeper.GetParams(ctx).PrecompileGas
+	return RunWithGasSchedule(ctx, contract, gasSchedule, method,
+		func(ctx sdk.Context) ([]byte, error) {
+			switch FunTokenMethod(method.Name) {
+			case FunTokenMethod_BankSend:
+				// TODO: UD-DEBUG: Test that calling non-method on the right address does
+				// nothing.
+				return p.bankSend(ctx, caller, method, args, readonly)
+			default:
+				// TODO: UD-DEBUG: test invalid method called
+				return nil, fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+			}
+		},
+	)
 }
 
 func PrecompileFunToken(keepers keepers.PublicKeepers) vm.PrecompiledContract {
@@ -139,12 +142,6 @@ func (p precompileFunToken) bankSend(
 	}
 	funtoken := funtokens[0]
 
-	// Amount should be positive
-	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
-		err = fmt.Errorf("transfer amount must be positive")
-		return
-	}
-
 	// The "to" argument must be a valid Nibiru address
 	toAddr, err := sdk.AccAddressFromBech32(to)
 	if err != nil {
@@ -152,46 +149,14 @@ func (p precompileFunToken) bankSend(
 		return
 	}
 
-	// Caller transfers ERC20 to the EVM account
-	transferTo := evm.ModuleAddressEVM()
-	_, err = p.EvmKeeper.ERC20().Transfer(erc20, caller, transferTo, amount, ctx)
+	// Caller transfers ERC20 to the EVM account, which then credits the bank
+	// coin of the FunToken mapping to "to".
+	_, err = p.EvmKeeper.ConvertErc20ToCoin(ctx, funtoken, caller, toAddr, amount)
 	if err != nil {
-		err = fmt.Errorf("failed to send from caller to the EVM account: %w", err)
+		err = fmt.Errorf("contract caller %s: %w", caller.Hex(), err)
 		return
 	}
 
-	// EVM account mints FunToken.BankDenom to module account
-	amt := math.NewIntFromBigInt(amount)
-	coins := sdk.NewCoins(sdk.NewCoin(funtoken.BankDenom, amt))
-	err = p.BankKeeper.MintCoins(ctx, evm.ModuleName, coins)
-	if err != nil {
-		err = fmt.Errorf("mint failed for module \"%s\" (%s): contract caller %s: %w",
-			evm.ModuleName, evm.ModuleAddressEVM().Hex(), caller.Hex(), err,
-		)
-		return
-	}
-
-	err = p.BankKeeper.SendCoinsFromModuleToAccount(ctx, evm.ModuleName, toAddr, coins)
-	if err != nil {
-		err = fmt.Errorf("send failed for module \"%s\" (%s): contract caller %s: %w",
-			evm.ModuleName, evm.ModuleAddressEVM().Hex(), caller.Hex(), err,
-		)
-		return
-	}
-
-	// If the FunToken mapping was created from a bank coin, then the EVM account
-	// owns the ERC20 contract and was the original minter of the ERC20 tokens.
-	// Since we're sending them away and want accurate total supply tracking, the
-	// tokens need to be burned.
-	if funtoken.IsMadeFromCoin {
-		caller := evm.ModuleAddressEVM()
-		_, err = p.EvmKeeper.ERC20().Burn(erc20, caller, amount, ctx)
-		if err != nil {
-			err = fmt.Errorf("ERC20.Burn: %w", err)
-			return
-		}
-	}
-
 	// TODO: UD-DEBUG: feat: Emit EVM events
 	// TODO: UD-DEBUG: feat: Emit ABCI events
 
@@ -1,11 +1,18 @@
 package precompile_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	srvconfig "github.com/NibiruChain/nibiru/app/server/config"
+	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/x/evm"
 	"github.com/NibiruChain/nibiru/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/x/evm/evmtest"
@@ -27,6 +34,7 @@ func TestPrecompileSuite(t *testing.T) {
 func (s *Suite) TestPrecompile_FunToken() {
 	s.Run("PrecompileExists", s.FunToken_PrecompileExists)
 	s.Run("HappyPath", s.FunToken_HappyPath)
+	s.Run("GasSchedule", s.FunToken_GasSchedule)
 }
 
 // PrecompileExists: An integration test showing that a "PrecompileError" occurs
@@ -99,14 +107,15 @@ func (s *Suite) FunToken_HappyPath() {
 		s.ErrorContains(err, "Ownable: caller is not the owner")
 	}
 
-	s.T().Log("Mint tokens - Success")
+	s.T().Log("Send bank coins to the ERC20 - Success")
 	{
-		from := theEvm
-		to := theUser
-		input, err := embeds.Contract_ERC20Minter.ABI.Pack("mint", to, big.NewInt(69_420))
-		s.NoError(err)
-
-		_, err = evmtest.DoEthTx(&deps, contract, from, input)
+		coins := sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 69_420))
+		s.NoError(testapp.FundAccount(deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr, coins))
+		_, err := deps.K.SendFunTokenToEvm(deps.GoCtx(), &evm.MsgSendFunTokenToEvm{
+			ToEthAddr: eth.NewHexAddr(theUser),
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  coins[0],
+		})
 		s.NoError(err)
 		evmtest.AssertERC20BalanceEqual(s.T(), &deps, contract, theUser, big.NewInt(69_420))
 		evmtest.AssertERC20BalanceEqual(s.T(), &deps, contract, theEvm, big.NewInt(0))
@@ -142,6 +151,11 @@ func (s *Suite) FunToken_HappyPath() {
 	s.Equal(fmt.Sprintf("%d", amtToSend),
 		deps.Chain.BankKeeper.GetBalance(deps.Ctx, randomAcc, funtoken.BankDenom).Amount.String(),
 	)
+	s.T().Log("bankSend releases the escrowed coins instead of minting new ones")
+	s.Equal("69420", deps.Chain.BankKeeper.GetSupply(deps.Ctx, bankDenom).Amount.String())
+	s.Equal(fmt.Sprintf("%d", 69_420-amtToSend),
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, evm.ModuleAddressEVM().Bytes(), bankDenom).Amount.String(),
+	)
 
 	evmtest.AssertERC20BalanceEqual(s.T(), &deps, contract, theUser, big.NewInt(69_000))
 	evmtest.AssertERC20BalanceEqual(s.T(), &deps, contract, theEvm, big.NewInt(1))
@@ -149,3 +163,84 @@ func (s *Suite) FunToken_HappyPath() {
 		deps.Chain.BankKeeper.GetBalance(deps.Ctx, randomAcc, funtoken.BankDenom).Amount.String(),
 	)
 }
+
+// FunToken_GasSchedule: Shows that the gas of a "bankSend" call follows the
+// precompile gas schedule of the module params and that the schedule is
+// reflected in gas estimation.
+func (s *Suite) FunToken_GasSchedule() {
+	precompileAddr := precompile.PrecompileAddr_FuntokenGateway.ToAddr()
+	deps := evmtest.NewTestDeps()
+	theUser := deps.Sender.EthAddr
+
+	bankDenom := "ibc/usdc"
+	funtoken := evmtest.CreateFunTokenForBankCoin(&deps, bankDenom, &s.Suite)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 69_420))
+	s.NoError(testapp.FundAccount(deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr, coins))
+	_, err := deps.K.SendFunTokenToEvm(deps.GoCtx(), &evm.MsgSendFunTokenToEvm{
+		ToEthAddr: eth.NewHexAddr(theUser),
+		Sender:    deps.Sender.NibiruAddr.String(),
+		BankCoin:  coins[0],
+	})
+	s.Require().NoError(err)
+
+	input, err := embeds.Contract_Funtoken.ABI.Pack(
+		string(precompile.FunTokenMethod_BankSend),
+		precompile.ArgsFunTokenBankSend(
+			funtoken.Erc20Addr.ToAddr(), big.NewInt(420), testutil.AccAddress(),
+		)...,
+	)
+	s.Require().NoError(err)
+
+	estimateGas := func() (uint64, error) {
+		jsonTxArgs, err := json.Marshal(&evm.JsonTxArgs{
+			From: &theUser,
+			To:   &precompileAddr,
+			Data: (*hexutil.Bytes)(&input),
+		})
+		s.Require().NoError(err)
+		resp, err := deps.K.EstimateGas(deps.GoCtx(), &evm.EthCallRequest{
+			Args:   jsonTxArgs,
+			GasCap: srvconfig.DefaultEthCallGasLimit,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return resp.Gas, nil
+	}
+	setSchedule := func(schedule evm.PrecompileGasSchedule) {
+		params := deps.K.GetParams(deps.Ctx)
+		params.PrecompileGas = schedule
+		deps.K.SetParams(deps.Ctx, params)
+	}
+
+	defaultSchedule := evm.DefaultPrecompileGasSchedule()
+	gasDefault, err := estimateGas()
+	s.Require().NoError(err)
+	s.Greater(gasDefault, defaultSchedule.RequiredGas(precompileAddr, "bankSend", input),
+		"gas must include the nested EVM and bank operations")
+
+	s.T().Log("Raising the method gas raises the estimate by the same amount")
+	schedule := evm.DefaultPrecompileGasSchedule()
+	schedule.MethodGas[0].Gas += 50_000
+	setSchedule(schedule)
+	gas, err := estimateGas()
+	s.Require().NoError(err)
+	// The delta allows for the params, which grow by a few bytes, being read
+	// from the store during the nested EVM call.
+	s.InDelta(gasDefault+50_000, gas, 100)
+
+	s.T().Log("Raising the per-byte gas raises the estimate by the input size")
+	schedule = evm.DefaultPrecompileGasSchedule()
+	schedule.GasPerInputByte += 10
+	setSchedule(schedule)
+	gas, err = estimateGas()
+	s.Require().NoError(err)
+	s.InDelta(gasDefault+10*uint64(len(input)), gas, 100)
+
+	s.T().Log("A method gas above the gas cap runs out of gas")
+	schedule = evm.DefaultPrecompileGasSchedule()
+	schedule.MethodGas[0].Gas = srvconfig.DefaultEthCallGasLimit
+	setSchedule(schedule)
+	_, err = estimateGas()
+	s.ErrorContains(err, "out of gas")
+}
@@ -0,0 +1,187 @@
+package precompile
+
+import (
+	"fmt"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/app/keepers"
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+)
+
+var (
+	_ vm.PrecompiledContract = (*precompileOracle)(nil)
+	_ NibiruPrecompile       = (*precompileOracle)(nil)
+)
+
+// Precompile address for "IOracle.sol", the contract that gives EVM contracts
+// read access to the exchange rates of the oracle module.
+var PrecompileAddr_Oracle = eth.MustNewHexAddrFromStr(
+	"0x0000000000000000000000000000000000000801",
+)
+
+func (p precompileOracle) Address() gethcommon.Address {
+	return PrecompileAddr_Oracle.ToAddr()
+}
+
+// RequiredGas returns zero because the gas schedule lives in the module
+// params, which require an sdk.Context. The gas of the call is charged inside
+// of "Run" by "RunWithGasSchedule".
+func (p precompileOracle) RequiredGas(input []byte) (gasCost uint64) {
+	return 0
+}
+
+const (
+	OracleMethod_GetExchangeRate      OracleMethod = "getExchangeRate"
+	OracleMethod_GetExchangeRateTwap  OracleMethod = "getExchangeRateTwap"
+	OracleMethod_GetDatedExchangeRate OracleMethod = "getDatedExchangeRate"
+)
+
+type OracleMethod string
+
+// Run runs the precompiled contract
+func (p precompileOracle) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	// This is a `defer` pattern to add behavior that runs in the case that the error is
+	// non-nil, creating a concise way to add extra information.
+	defer func() {
+		if err != nil {
+			precompileType := reflect.TypeOf(p).Name()
+			err = fmt.Errorf("Precompile error: failed to run %s: %w", precompileType, err)
+		}
+	}()
+
+	ctx, method, args, err := OnStart(p, evm, contract.Input, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	gasSchedule := p.EvmKeeper.GetParams(ctx).PrecompileGas
+	return RunWithGasSchedule(ctx, contract, gasSchedule, method,
+		func(ctx sdk.Context) ([]byte, error) {
+			switch OracleMethod(method.Name) {
+			case OracleMethod_GetExchangeRate:
+				return p.getExchangeRate(ctx, method, args)
+			case OracleMethod_GetExchangeRateTwap:
+				return p.getExchangeRateTwap(ctx, method, args)
+			case OracleMethod_GetDatedExchangeRate:
+				return p.getDatedExchangeRate(ctx, method, args)
+			default:
+				return nil, fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+			}
+		},
+	)
+}
+
+func PrecompileOracle(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileOracle{
+		PublicKeepers: keepers,
+	}
+}
+
+func (p precompileOracle) ABI() gethabi.ABI {
+	return embeds.Contract_Oracle.ABI
+}
+
+type precompileOracle struct {
+	keepers.PublicKeepers
+	NibiruPrecompile
+}
+
+/*
+getExchangeRate: Implements "IOracle.getExchangeRate"
+
+```solidity
+function getExchangeRate(string memory pair) external view returns (uint256 price);
+```
+*/
+func (p precompileOracle) getExchangeRate(
+	ctx sdk.Context, method *gethabi.Method, args []interface{},
+) (bz []byte, err error) {
+	pair, err := p.parseWhitelistedPair(ctx, args)
+	if err != nil {
+		return
+	}
+	price, err := p.OracleKeeper.GetExchangeRate(ctx, pair)
+	if err != nil {
+		return nil, fmt.Errorf("no exchange rate for pair \"%s\": %w", pair, err)
+	}
+	return method.Outputs.Pack(price.BigInt())
+}
+
+/*
+getExchangeRateTwap: Implements "IOracle.getExchangeRateTwap"
+
+```solidity
+function getExchangeRateTwap(string memory pair) external view returns (uint256 price);
+```
+*/
+func (p precompileOracle) getExchangeRateTwap(
+	ctx sdk.Context, method *gethabi.Method, args []interface{},
+) (bz []byte, err error) {
+	pair, err := p.parseWhitelistedPair(ctx, args)
+	if err != nil {
+		return
+	}
+	price, err := p.OracleKeeper.GetExchangeRateTwap(ctx, pair)
+	if err != nil {
+		return nil, fmt.Errorf("no TWAP for pair \"%s\": %w", pair, err)
+	}
+	return method.Outputs.Pack(price.BigInt())
+}
+
+/*
+getDatedExchangeRate: Implements "IOracle.getDatedExchangeRate"
+
+```solidity
+function getDatedExchangeRate(string memory pair) external view returns (uint256 price, uint64 createdBlock);
+```
+*/
+func (p precompileOracle) getDatedExchangeRate(
+	ctx sdk.Context, method *gethabi.Method, args []interface{},
+) (bz []byte, err error) {
+	pair, err := p.parseWhitelistedPair(ctx, args)
+	if err != nil {
+		return
+	}
+	datedPrice, err := p.OracleKeeper.ExchangeRates.Get(ctx, pair)
+	if err != nil {
+		return nil, fmt.Errorf("no exchange rate for pair \"%s\": %w", pair, err)
+	}
+	return method.Outputs.Pack(datedPrice.ExchangeRate.BigInt(), datedPrice.CreatedBlock)
+}
+
+// parseWhitelistedPair: Parses the single "pair" argument shared by every
+// method of "IOracle" and checks that the pair is on the oracle whitelist.
+func (p precompileOracle) parseWhitelistedPair(
+	ctx sdk.Context, args []interface{},
+) (pair asset.Pair, err error) {
+	if err = AssertArgCount(args, 1); err != nil {
+		return
+	}
+	pairStr, ok := args[0].(string)
+	if !ok {
+		return pair, fmt.Errorf("type validation failed for \"pair\": expected string, got %T", args[0])
+	}
+	pair, err = asset.TryNewPair(pairStr)
+	if err != nil {
+		return pair, fmt.Errorf("invalid pair \"%s\": %w", pairStr, err)
+	}
+	if !p.OracleKeeper.IsWhitelistedPair(ctx, pair) {
+		return pair, fmt.Errorf("pair \"%s\" is not whitelisted by the oracle", pair)
+	}
+	return pair, nil
+}
+
+// ArgsOracle: Constructor for an "args" array of arguments for the "IOracle"
+// methods, which all take a single pair.
+func ArgsOracle(pair asset.Pair) []any {
+	return []any{pair.String()}
+}
@@ -0,0 +1,112 @@
+package precompile_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
+)
+
+func (s *Suite) TestPrecompile_Oracle() {
+	s.Run("PrecompileExists", s.Oracle_PrecompileExists)
+	s.Run("HappyPath", s.Oracle_HappyPath)
+	s.Run("SadPath", s.Oracle_SadPath)
+}
+
+func (s *Suite) Oracle_PrecompileExists() {
+	precompileAddr := precompile.PrecompileAddr_Oracle
+	deps := evmtest.NewTestDeps()
+
+	codeResp, err := deps.K.Code(
+		deps.GoCtx(),
+		&evm.QueryCodeRequest{
+			Address: precompileAddr.String(),
+		},
+	)
+	s.NoError(err)
+	s.Equal(string(codeResp.Code), "")
+
+	s.True(deps.K.PrecompileSet().Has(precompileAddr.ToAddr()),
+		"did not see precompile address during \"InitPrecompiles\"")
+}
+
+// callOracle: Calls a method of the oracle precompile without committing and
+// returns the unpacked outputs.
+func (s *Suite) callOracle(
+	deps *evmtest.TestDeps, method precompile.OracleMethod, pair string,
+) ([]any, error) {
+	abi := embeds.Contract_Oracle.ABI
+	input, err := abi.Pack(string(method), pair)
+	s.Require().NoError(err)
+
+	contractAddr := precompile.PrecompileAddr_Oracle.ToAddr()
+	commit := false
+	evmResp, err := deps.K.CallContractWithInput(
+		deps.Ctx, deps.Sender.EthAddr, &contractAddr, commit, input,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return abi.Unpack(string(method), evmResp.Ret)
+}
+
+func (s *Suite) Oracle_HappyPath() {
+	deps := evmtest.NewTestDeps()
+	pair := asset.MustNewPair("unibi:uusd")
+	price := math.LegacyMustNewDecFromStr("0.067")
+	wantPrice := big.NewInt(67_000_000_000_000_000) // 0.067 with 18 decimals
+
+	deps.Chain.OracleKeeper.WhitelistedPairs.Insert(deps.Ctx, pair)
+	deps.Chain.OracleKeeper.SetPrice(deps.Ctx, pair, price)
+	createdBlock := uint64(deps.Ctx.BlockHeight())
+
+	s.T().Log("getExchangeRate")
+	out, err := s.callOracle(&deps, precompile.OracleMethod_GetExchangeRate, pair.String())
+	s.Require().NoError(err)
+	s.Require().Len(out, 1)
+	s.Equal(wantPrice.String(), out[0].(*big.Int).String())
+
+	s.T().Log("getExchangeRateTwap")
+	out, err = s.callOracle(&deps, precompile.OracleMethod_GetExchangeRateTwap, pair.String())
+	s.Require().NoError(err)
+	s.Require().Len(out, 1)
+	s.Equal(wantPrice.String(), out[0].(*big.Int).String())
+
+	s.T().Log("getDatedExchangeRate")
+	out, err = s.callOracle(&deps, precompile.OracleMethod_GetDatedExchangeRate, pair.String())
+	s.Require().NoError(err)
+	s.Require().Len(out, 2)
+	s.Equal(wantPrice.String(), out[0].(*big.Int).String())
+	s.Equal(createdBlock, out[1].(uint64))
+}
+
+func (s *Suite) Oracle_SadPath() {
+	deps := evmtest.NewTestDeps()
+	pair := asset.MustNewPair("unibi:uusd")
+
+	s.T().Log("Pair must be valid")
+	_, err := s.callOracle(&deps, precompile.OracleMethod_GetExchangeRate, "not-a-pair")
+	s.ErrorContains(err, "invalid pair")
+
+	s.T().Log("Pair must be whitelisted")
+	deps.Chain.OracleKeeper.SetPrice(deps.Ctx, pair, math.LegacyOneDec())
+	_, err = s.callOracle(&deps, precompile.OracleMethod_GetExchangeRate, pair.String())
+	s.ErrorContains(err, "is not whitelisted")
+
+	s.T().Log("Whitelisted pair without a price")
+	otherPair := asset.MustNewPair("ubtc:uusd")
+	deps.Chain.OracleKeeper.WhitelistedPairs.Insert(deps.Ctx, otherPair)
+	for method, wantErr := range map[precompile.OracleMethod]string{
+		precompile.OracleMethod_GetExchangeRate:      "no exchange rate",
+		precompile.OracleMethod_GetExchangeRateTwap:  "no TWAP",
+		precompile.OracleMethod_GetDatedExchangeRate: "no exchange rate",
+	} {
+		_, err = s.callOracle(&deps, method, otherPair.String())
+		s.ErrorContains(err, wantErr, "method %s", method)
+	}
+}
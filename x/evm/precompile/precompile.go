@@ -5,15 +5,19 @@ import (
 	"fmt"
 	"sync"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/collections"
 	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/NibiruChain/nibiru/app/keepers"
 	"github.com/NibiruChain/nibiru/x/common/set"
+	"github.com/NibiruChain/nibiru/x/evm"
+	evmkeeper "github.com/NibiruChain/nibiru/x/evm/keeper"
 	"github.com/NibiruChain/nibiru/x/evm/statedb"
 )
 
@@ -41,6 +45,9 @@ func InitPrecompiles(
 	// Custom precompiles
 	for _, precompileSetupFn := range []func(k keepers.PublicKeepers) vm.PrecompiledContract{
 		PrecompileFunToken,
+		PrecompileOracle,
+		PrecompileWasm,
+		PrecompileStaking,
 	} {
 		pc := precompileSetupFn(k)
 		addPrecompileToVM(pc)
@@ -96,18 +103,15 @@ func ABIMethodByID(abi gethabi.ABI, sigdata []byte) (*gethabi.Method, error) {
 	return nil, fmt.Errorf("no method with id: %#x", sigdata[:4])
 }
 
+// OnStart parses the ABI method and arguments of a precompile call and loads
+// the sdk.Context the call runs on. Calls to non-view methods outside of a
+// read-only (static) call get a journaled branch of the EVM StateDB, so their
+// writes follow the reverts of the EVM frame. All other calls get a throwaway
+// branch that is never committed.
 func OnStart(
-	p NibiruPrecompile, evm *vm.EVM, input []byte,
+	p NibiruPrecompile, evm *vm.EVM, input []byte, readonly bool,
 ) (ctx sdk.Context, method *gethabi.Method, args []interface{}, err error) {
-	// 1 | Get context from StateDB
-	stateDB, ok := evm.StateDB.(*statedb.StateDB)
-	if !ok {
-		err = fmt.Errorf("failed to load the sdk.Context from the EVM StateDB")
-		return
-	}
-	ctx = stateDB.GetContext()
-
-	// 2 | Parse the ABI method
+	// 1 | Parse the ABI method
 	// ABI method IDs are at least 4 bytes according to "gethabi.ABI.MethodByID".
 	methodIdBytes := 4
 	if len(input) < methodIdBytes {
@@ -130,5 +134,98 @@ func OnStart(
 		return
 	}
 
+	// 2 | Get context from StateDB
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		err = fmt.Errorf("failed to load the sdk.Context from the EVM StateDB")
+		return
+	}
+	if readonly || method.IsConstant() {
+		ctx, err = stateDB.ReadCtxForPrecompile()
+		if err != nil {
+			return
+		}
+	} else {
+		ctx, err = stateDB.CacheCtxForPrecompile()
+		if err != nil {
+			return
+		}
+	}
+
 	return ctx, method, args, nil
 }
+
+// RunWithGasSchedule charges the gas of a precompile call to the EVM contract
+// and executes "run" on a cached context that is only committed on success.
+//
+// The gas charged to the caller has two parts:
+//  1. The static cost from the gas schedule: the base gas of the method plus
+//     the per-byte cost of the call input.
+//  2. The gas consumed by "run" itself, which includes store reads and writes
+//     (e.g., bank operations) and any nested EVM calls.
+//
+// Running out of gas in either part returns "vm.ErrOutOfGas".
+func RunWithGasSchedule(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	gasSchedule evm.PrecompileGasSchedule,
+	method *gethabi.Method,
+	run func(ctx sdk.Context) ([]byte, error),
+) (bz []byte, err error) {
+	requiredGas := gasSchedule.RequiredGas(
+		contract.Address(), method.Name, contract.Input,
+	)
+	if !contract.UseGas(requiredGas) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	gasMeter := sdk.NewGasMeter(contract.Gas)
+	cacheCtx, commit := evmkeeper.WithPrecompileCall(ctx).
+		WithGasMeter(gasMeter).
+		WithKVGasConfig(storetypes.KVGasConfig()).
+		WithTransientKVGasConfig(storetypes.TransientGasConfig()).
+		CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOutOfGas := r.(sdk.ErrorOutOfGas); !isOutOfGas {
+				panic(r)
+			}
+			bz, err = nil, vm.ErrOutOfGas
+		}
+	}()
+
+	bz, err = run(cacheCtx)
+	if err != nil {
+		return nil, err
+	}
+	if !contract.UseGas(gasMeter.GasConsumed()) {
+		return nil, vm.ErrOutOfGas
+	}
+	commit()
+	return bz, nil
+}
+
+// EmitEvmLog adds an event of a precompile to the logs of the current EVM
+// transaction. The log is journaled by the StateDB, so it is discarded if the
+// call reverts. "topics" holds the indexed arguments of the event, and "data"
+// holds the non-indexed ones in order.
+func EmitEvmLog(
+	evm *vm.EVM,
+	precompileAddr gethcommon.Address,
+	event gethabi.Event,
+	topics []gethcommon.Hash,
+	data ...any,
+) error {
+	logData, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return fmt.Errorf("failed to pack EVM log for event \"%s\": %w", event.Name, err)
+	}
+	evm.StateDB.AddLog(&gethcore.Log{
+		Address:     precompileAddr,
+		Topics:      append([]gethcommon.Hash{event.ID}, topics...),
+		Data:        logData,
+		BlockNumber: evm.Context.BlockNumber.Uint64(),
+	})
+	return nil
+}
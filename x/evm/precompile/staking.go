@@ -0,0 +1,515 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/app/keepers"
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+)
+
+var (
+	_ vm.PrecompiledContract = (*precompileStaking)(nil)
+	_ NibiruPrecompile       = (*precompileStaking)(nil)
+)
+
+// Precompile address for "IStaking.sol", the contract that enables EVM
+// accounts to delegate, undelegate, redelegate, and claim staking rewards.
+var PrecompileAddr_Staking = eth.MustNewHexAddrFromStr(
+	"0x0000000000000000000000000000000000000803",
+)
+
+func (p precompileStaking) Address() gethcommon.Address {
+	return PrecompileAddr_Staking.ToAddr()
+}
+
+// RequiredGas returns zero because the gas schedule lives in the module
+// params, which require an sdk.Context. The gas of the call is charged inside
+// of "Run" by "RunWithGasSchedule".
+func (p precompileStaking) RequiredGas(input []byte) (gasCost uint64) {
+	return 0
+}
+
+const (
+	StakingMethod_Delegate                 StakingMethod = "delegate"
+	StakingMethod_Undelegate               StakingMethod = "undelegate"
+	StakingMethod_Redelegate               StakingMethod = "redelegate"
+	StakingMethod_WithdrawDelegatorRewards StakingMethod = "withdrawDelegatorRewards"
+	StakingMethod_Delegation               StakingMethod = "delegation"
+	StakingMethod_DelegationRewards        StakingMethod = "delegationRewards"
+	StakingMethod_Validator                StakingMethod = "validator"
+	StakingMethod_Validators               StakingMethod = "validators"
+)
+
+type StakingMethod string
+
+// Names of the events of "IStaking.sol". Each state-changing method emits one
+// of these as an EVM log alongside the Cosmos events of the staking and
+// distribution modules.
+const (
+	StakingEvent_Delegate                 = "Delegate"
+	StakingEvent_Undelegate               = "Undelegate"
+	StakingEvent_Redelegate               = "Redelegate"
+	StakingEvent_WithdrawDelegatorRewards = "WithdrawDelegatorRewards"
+)
+
+// Run runs the precompiled contract
+func (p precompileStaking) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	// This is a `defer` pattern to add behavior that runs in the case that the error is
+	// non-nil, creating a concise way to add extra information.
+	defer func() {
+		if err != nil {
+			precompileType := reflect.TypeOf(p).Name()
+			err = fmt.Errorf("Precompile error: failed to run %s: %w", precompileType, err)
+		}
+	}()
+
+	ctx, method, args, err := OnStart(p, evm, contract.Input, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	caller := contract.CallerAddress
+	gasSchedule := p.EvmKeeper.GetParams(ctx).PrecompileGas
+	return RunWithGasSchedule(ctx, contract, gasSchedule, method,
+		func(ctx sdk.Context) ([]byte, error) {
+			switch StakingMethod(method.Name) {
+			case StakingMethod_Delegate:
+				return p.delegate(ctx, evm, caller, method, args, readonly)
+			case StakingMethod_Undelegate:
+				return p.undelegate(ctx, evm, caller, method, args, readonly)
+			case StakingMethod_Redelegate:
+				return p.redelegate(ctx, evm, caller, method, args, readonly)
+			case StakingMethod_WithdrawDelegatorRewards:
+				return p.withdrawDelegatorRewards(ctx, evm, caller, method, args, readonly)
+			case StakingMethod_Delegation:
+				return p.delegation(ctx, method, args)
+			case StakingMethod_DelegationRewards:
+				return p.delegationRewards(ctx, method, args)
+			case StakingMethod_Validator:
+				return p.validator(ctx, method, args)
+			case StakingMethod_Validators:
+				return p.validators(ctx, method)
+			default:
+				return nil, fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+			}
+		},
+	)
+}
+
+func PrecompileStaking(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileStaking{
+		PublicKeepers: keepers,
+	}
+}
+
+func (p precompileStaking) ABI() gethabi.ABI {
+	return embeds.Contract_Staking.ABI
+}
+
+type precompileStaking struct {
+	keepers.PublicKeepers
+	NibiruPrecompile
+}
+
+// ArgsStakingDelegate: Arguments of "IStaking.delegate" and
+// "IStaking.undelegate".
+type ArgsStakingDelegate struct {
+	ValidatorAddr string
+	Amount        *big.Int
+}
+
+// ArgsStakingRedelegate: Arguments of "IStaking.redelegate".
+type ArgsStakingRedelegate struct {
+	SrcValidatorAddr string
+	DstValidatorAddr string
+	Amount           *big.Int
+}
+
+// ArgsStakingDelegation: Arguments of "IStaking.delegation" and
+// "IStaking.delegationRewards".
+type ArgsStakingDelegation struct {
+	Delegator     gethcommon.Address
+	ValidatorAddr string
+}
+
+// StakingValidator: Go representation of "IStaking.Validator".
+type StakingValidator struct {
+	OperatorAddr    string
+	Jailed          bool
+	Status          uint8
+	Tokens          *big.Int
+	DelegatorShares *big.Int
+	CommissionRate  *big.Int
+	Moniker         string
+}
+
+/*
+delegate: Implements "IStaking.delegate"
+
+```solidity
+function delegate(string memory validatorAddr, uint256 amount) external returns (bool success);
+```
+*/
+func (p precompileStaking) delegate(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []interface{},
+	readOnly bool,
+) (bz []byte, err error) {
+	if err = assertNotReadonlyTx(readOnly); err != nil {
+		return
+	}
+	var stakingArgs ArgsStakingDelegate
+	if err = method.Inputs.Copy(&stakingArgs, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+	amount, err := p.bondCoin(ctx, stakingArgs.Amount)
+	if err != nil {
+		return
+	}
+
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: sdk.AccAddress(caller.Bytes()).String(),
+		ValidatorAddress: stakingArgs.ValidatorAddr,
+		Amount:           amount,
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid delegation: %w", err)
+	}
+	if _, err = stakingkeeper.NewMsgServerImpl(p.StakingKeeper).Delegate(
+		sdk.WrapSDKContext(ctx), msg,
+	); err != nil {
+		return nil, fmt.Errorf("delegate failed: %w", err)
+	}
+
+	if err = p.emitEvmLog(evm, StakingEvent_Delegate, caller,
+		stakingArgs.ValidatorAddr, stakingArgs.Amount,
+	); err != nil {
+		return
+	}
+	return method.Outputs.Pack(true)
+}
+
+/*
+undelegate: Implements "IStaking.undelegate"
+
+```solidity
+function undelegate(string memory validatorAddr, uint256 amount) external returns (int64 completionTime);
+```
+*/
+func (p precompileStaking) undelegate(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []interface{},
+	readOnly bool,
+) (bz []byte, err error) {
+	if err = assertNotReadonlyTx(readOnly); err != nil {
+		return
+	}
+	var stakingArgs ArgsStakingDelegate
+	if err = method.Inputs.Copy(&stakingArgs, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+	amount, err := p.bondCoin(ctx, stakingArgs.Amount)
+	if err != nil {
+		return
+	}
+
+	msg := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: sdk.AccAddress(caller.Bytes()).String(),
+		ValidatorAddress: stakingArgs.ValidatorAddr,
+		Amount:           amount,
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid undelegation: %w", err)
+	}
+	resp, err := stakingkeeper.NewMsgServerImpl(p.StakingKeeper).Undelegate(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("undelegate failed: %w", err)
+	}
+
+	completionTime := resp.CompletionTime.Unix()
+	if err = p.emitEvmLog(evm, StakingEvent_Undelegate, caller,
+		stakingArgs.ValidatorAddr, stakingArgs.Amount, completionTime,
+	); err != nil {
+		return
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+/*
+redelegate: Implements "IStaking.redelegate"
+
+```solidity
+function redelegate(string memory srcValidatorAddr, string memory dstValidatorAddr, uint256 amount) external returns (int64 completionTime);
+```
+*/
+func (p precompileStaking) redelegate(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []interface{},
+	readOnly bool,
+) (bz []byte, err error) {
+	if err = assertNotReadonlyTx(readOnly); err != nil {
+		return
+	}
+	var stakingArgs ArgsStakingRedelegate
+	if err = method.Inputs.Copy(&stakingArgs, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+	amount, err := p.bondCoin(ctx, stakingArgs.Amount)
+	if err != nil {
+		return
+	}
+
+	msg := &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    sdk.AccAddress(caller.Bytes()).String(),
+		ValidatorSrcAddress: stakingArgs.SrcValidatorAddr,
+		ValidatorDstAddress: stakingArgs.DstValidatorAddr,
+		Amount:              amount,
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid redelegation: %w", err)
+	}
+	resp, err := stakingkeeper.NewMsgServerImpl(p.StakingKeeper).BeginRedelegate(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("redelegate failed: %w", err)
+	}
+
+	completionTime := resp.CompletionTime.Unix()
+	if err = p.emitEvmLog(evm, StakingEvent_Redelegate, caller,
+		stakingArgs.SrcValidatorAddr, stakingArgs.DstValidatorAddr,
+		stakingArgs.Amount, completionTime,
+	); err != nil {
+		return
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+/*
+withdrawDelegatorRewards: Implements "IStaking.withdrawDelegatorRewards"
+
+```solidity
+function withdrawDelegatorRewards(string memory validatorAddr) external returns (BankCoin[] memory rewards);
+```
+*/
+func (p precompileStaking) withdrawDelegatorRewards(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []interface{},
+	readOnly bool,
+) (bz []byte, err error) {
+	if err = assertNotReadonlyTx(readOnly); err != nil {
+		return
+	}
+	var validatorAddr string
+	if err = method.Inputs.Copy(&validatorAddr, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+
+	msg := &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: sdk.AccAddress(caller.Bytes()).String(),
+		ValidatorAddress: validatorAddr,
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid reward withdrawal: %w", err)
+	}
+	resp, err := distrkeeper.NewMsgServerImpl(p.DistrKeeper).WithdrawDelegatorReward(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("withdraw delegator rewards failed: %w", err)
+	}
+
+	rewards := toBankCoins(resp.Amount)
+	if err = p.emitEvmLog(evm, StakingEvent_WithdrawDelegatorRewards, caller,
+		validatorAddr, rewards,
+	); err != nil {
+		return
+	}
+	return method.Outputs.Pack(rewards)
+}
+
+/*
+delegation: Implements "IStaking.delegation"
+
+```solidity
+function delegation(address delegator, string memory validatorAddr) external view returns (uint256 shares, uint256 balance);
+```
+*/
+func (p precompileStaking) delegation(
+	ctx sdk.Context, method *gethabi.Method, args []interface{},
+) (bz []byte, err error) {
+	var stakingArgs ArgsStakingDelegation
+	if err = method.Inputs.Copy(&stakingArgs, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+	validator, err := p.getValidator(ctx, stakingArgs.ValidatorAddr)
+	if err != nil {
+		return
+	}
+
+	delegation, found := p.StakingKeeper.GetDelegation(
+		ctx, sdk.AccAddress(stakingArgs.Delegator.Bytes()), validator.GetOperator(),
+	)
+	if !found {
+		return method.Outputs.Pack(big.NewInt(0), big.NewInt(0))
+	}
+	balance := validator.TokensFromShares(delegation.Shares).TruncateInt()
+	return method.Outputs.Pack(delegation.Shares.BigInt(), balance.BigInt())
+}
+
+/*
+delegationRewards: Implements "IStaking.delegationRewards"
+
+```solidity
+function delegationRewards(address delegator, string memory validatorAddr) external view returns (BankCoin[] memory rewards);
+```
+*/
+func (p precompileStaking) delegationRewards(
+	ctx sdk.Context, method *gethabi.Method, args []interface{},
+) (bz []byte, err error) {
+	var stakingArgs ArgsStakingDelegation
+	if err = method.Inputs.Copy(&stakingArgs, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+
+	resp, err := distrkeeper.NewQuerier(p.DistrKeeper).DelegationRewards(
+		sdk.WrapSDKContext(ctx),
+		&distrtypes.QueryDelegationRewardsRequest{
+			DelegatorAddress: sdk.AccAddress(stakingArgs.Delegator.Bytes()).String(),
+			ValidatorAddress: stakingArgs.ValidatorAddr,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query delegation rewards: %w", err)
+	}
+	rewards, _ := resp.Rewards.TruncateDecimal()
+	return method.Outputs.Pack(toBankCoins(rewards))
+}
+
+/*
+validator: Implements "IStaking.validator"
+
+```solidity
+function validator(string memory validatorAddr) external view returns (Validator memory);
+```
+*/
+func (p precompileStaking) validator(
+	ctx sdk.Context, method *gethabi.Method, args []interface{},
+) (bz []byte, err error) {
+	var validatorAddr string
+	if err = method.Inputs.Copy(&validatorAddr, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+	validator, err := p.getValidator(ctx, validatorAddr)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(toStakingValidator(validator))
+}
+
+/*
+validators: Implements "IStaking.validators"
+
+```solidity
+function validators() external view returns (Validator[] memory);
+```
+*/
+func (p precompileStaking) validators(
+	ctx sdk.Context, method *gethabi.Method,
+) (bz []byte, err error) {
+	bonded := p.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	validators := make([]StakingValidator, len(bonded))
+	for i, validator := range bonded {
+		validators[i] = toStakingValidator(validator)
+	}
+	return method.Outputs.Pack(validators)
+}
+
+// getValidator: Parses a validator operator address and returns the
+// validator from the staking module.
+func (p precompileStaking) getValidator(
+	ctx sdk.Context, validatorAddr string,
+) (validator stakingtypes.Validator, err error) {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return validator, fmt.Errorf("invalid validator address \"%s\": %w", validatorAddr, err)
+	}
+	validator, found := p.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return validator, fmt.Errorf("validator \"%s\" does not exist", validatorAddr)
+	}
+	return validator, nil
+}
+
+// bondCoin: Converts an amount argument into a coin of the bond denom.
+func (p precompileStaking) bondCoin(
+	ctx sdk.Context, amount *big.Int,
+) (coin sdk.Coin, err error) {
+	if amount == nil || amount.Sign() <= 0 {
+		return coin, fmt.Errorf("amount must be positive, got %s", amount)
+	}
+	return sdk.NewCoin(p.StakingKeeper.BondDenom(ctx), math.NewIntFromBigInt(amount)), nil
+}
+
+// emitEvmLog: Adds an "IStaking" event to the logs of the current EVM
+// transaction. The delegator is the only indexed argument of every event.
+func (p precompileStaking) emitEvmLog(
+	evm *vm.EVM, eventName string, delegator gethcommon.Address, data ...any,
+) error {
+	event := p.ABI().Events[eventName]
+	return EmitEvmLog(evm, p.Address(), event,
+		[]gethcommon.Hash{gethcommon.BytesToHash(delegator.Bytes())}, data...,
+	)
+}
+
+// toBankCoins: Converts sdk.Coins into the "BankCoin" ABI representation.
+func toBankCoins(coins sdk.Coins) []BankCoin {
+	bankCoins := make([]BankCoin, len(coins))
+	for i, coin := range coins {
+		bankCoins[i] = BankCoin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}
+	}
+	return bankCoins
+}
+
+// toStakingValidator: Converts a validator of the staking module into the
+// "IStaking.Validator" ABI representation. Decimal values have 18 decimals.
+func toStakingValidator(validator stakingtypes.Validator) StakingValidator {
+	return StakingValidator{
+		OperatorAddr:    validator.OperatorAddress,
+		Jailed:          validator.Jailed,
+		Status:          uint8(validator.Status),
+		Tokens:          validator.Tokens.BigInt(),
+		DelegatorShares: validator.DelegatorShares.BigInt(),
+		CommissionRate:  validator.Commission.Rate.BigInt(),
+		Moniker:         validator.Description.Moniker,
+	}
+}
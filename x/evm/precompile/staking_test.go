@@ -0,0 +1,273 @@
+package precompile_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
+)
+
+func (s *Suite) TestPrecompile_Staking() {
+	s.Run("PrecompileExists", s.Staking_PrecompileExists)
+	s.Run("HappyPath", s.Staking_HappyPath)
+	s.Run("Redelegate", s.Staking_Redelegate)
+	s.Run("DelegateWithValue", s.Staking_DelegateWithValue)
+	s.Run("SadPath", s.Staking_SadPath)
+}
+
+func (s *Suite) Staking_PrecompileExists() {
+	deps := evmtest.NewTestDeps()
+	s.True(deps.K.PrecompileSet().Has(precompile.PrecompileAddr_Staking.ToAddr()),
+		"did not see precompile address during \"InitPrecompiles\"")
+}
+
+// callStaking: Calls a method of the staking precompile from the
+// "TestDeps.Sender" and returns the unpacked outputs with the EVM logs of
+// the call.
+func (s *Suite) callStaking(
+	deps *evmtest.TestDeps, commit bool, method precompile.StakingMethod, args ...any,
+) ([]any, []*evm.Log, error) {
+	abi := embeds.Contract_Staking.ABI
+	input, err := abi.Pack(string(method), args...)
+	s.Require().NoError(err)
+
+	contractAddr := precompile.PrecompileAddr_Staking.ToAddr()
+	evmResp, err := deps.K.CallContractWithInput(
+		deps.Ctx, deps.Sender.EthAddr, &contractAddr, commit, input,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	out, err := abi.Unpack(string(method), evmResp.Ret)
+	return out, evmResp.Logs, err
+}
+
+// fundSenderWithBondDenom: Gives the "TestDeps.Sender" coins of the bond
+// denom to stake with.
+func (s *Suite) fundSenderWithBondDenom(deps *evmtest.TestDeps, amount int64) {
+	bondDenom := deps.Chain.StakingKeeper.BondDenom(deps.Ctx)
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount)),
+	))
+}
+
+// createValidator: Creates a new (unbonded) validator with a self-delegation.
+func (s *Suite) createValidator(deps *evmtest.TestDeps) (valAddr sdk.ValAddress) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valAddr = sdk.ValAddress(pubKey.Address())
+	bondDenom := deps.Chain.StakingKeeper.BondDenom(deps.Ctx)
+	selfDelegation := sdk.NewInt64Coin(bondDenom, 1_000_000)
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, sdk.AccAddress(valAddr),
+		sdk.NewCoins(selfDelegation),
+	))
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, pubKey, selfDelegation, stakingtypes.Description{Moniker: "val"},
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+		math.OneInt(),
+	)
+	s.Require().NoError(err)
+	_, err = stakingkeeper.NewMsgServerImpl(deps.Chain.StakingKeeper).CreateValidator(
+		sdk.WrapSDKContext(deps.Ctx), msg,
+	)
+	s.Require().NoError(err)
+	return valAddr
+}
+
+// assertStakingLog: Checks that "log" is an "IStaking" event emitted by the
+// precompile with the sender as the indexed delegator.
+func (s *Suite) assertStakingLog(deps *evmtest.TestDeps, log *evm.Log, eventName string) {
+	event := embeds.Contract_Staking.ABI.Events[eventName]
+	s.Equal(precompile.PrecompileAddr_Staking.ToAddr().Hex(), log.Address)
+	s.Require().Len(log.Topics, 2)
+	s.Equal(event.ID.Hex(), log.Topics[0])
+	s.Equal(gethcommon.BytesToHash(deps.Sender.EthAddr.Bytes()).Hex(), log.Topics[1])
+}
+
+func (s *Suite) Staking_HappyPath() {
+	deps := evmtest.NewTestDeps()
+	s.fundSenderWithBondDenom(&deps, 10_000)
+	validators := deps.Chain.StakingKeeper.GetBondedValidatorsByPower(deps.Ctx)
+	s.Require().NotEmpty(validators)
+	valAddr := validators[0].OperatorAddress
+
+	s.T().Log("validators and validator")
+	out, _, err := s.callStaking(&deps, false, precompile.StakingMethod_Validators)
+	s.Require().NoError(err)
+	s.Len(out[0], len(validators))
+	out, _, err = s.callStaking(&deps, false, precompile.StakingMethod_Validator, valAddr)
+	s.Require().NoError(err)
+	validator := *gethabi.ConvertType(out[0], new(precompile.StakingValidator)).(*precompile.StakingValidator)
+	s.Equal(valAddr, validator.OperatorAddr)
+	s.Equal(uint8(stakingtypes.Bonded), validator.Status)
+
+	s.T().Log("delegation: zero before delegating")
+	out, _, err = s.callStaking(&deps, false, precompile.StakingMethod_Delegation,
+		deps.Sender.EthAddr, valAddr,
+	)
+	s.Require().NoError(err)
+	s.Equal("0", out[1].(*big.Int).String())
+
+	s.T().Log("delegate: emits an EVM log and the Cosmos events")
+	deps.Ctx = deps.Ctx.WithEventManager(sdk.NewEventManager())
+	out, logs, err := s.callStaking(&deps, true, precompile.StakingMethod_Delegate,
+		valAddr, big.NewInt(4_000),
+	)
+	s.Require().NoError(err)
+	s.True(out[0].(bool))
+	s.Require().Len(logs, 1)
+	s.assertStakingLog(&deps, logs[0], precompile.StakingEvent_Delegate)
+	s.True(hasEventType(deps.Ctx.EventManager().Events(), stakingtypes.EventTypeDelegate))
+
+	out, _, err = s.callStaking(&deps, false, precompile.StakingMethod_Delegation,
+		deps.Sender.EthAddr, valAddr,
+	)
+	s.Require().NoError(err)
+	s.Equal("4000", out[1].(*big.Int).String())
+
+	s.T().Log("delegationRewards and withdrawDelegatorRewards")
+	_, _, err = s.callStaking(&deps, false, precompile.StakingMethod_DelegationRewards,
+		deps.Sender.EthAddr, valAddr,
+	)
+	s.Require().NoError(err)
+	_, logs, err = s.callStaking(&deps, true, precompile.StakingMethod_WithdrawDelegatorRewards,
+		valAddr,
+	)
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+	s.assertStakingLog(&deps, logs[0], precompile.StakingEvent_WithdrawDelegatorRewards)
+
+	s.T().Log("undelegate")
+	out, logs, err = s.callStaking(&deps, true, precompile.StakingMethod_Undelegate,
+		valAddr, big.NewInt(1_000),
+	)
+	s.Require().NoError(err)
+	s.Greater(out[0].(int64), deps.Ctx.BlockTime().Unix()-1)
+	s.Require().Len(logs, 1)
+	s.assertStakingLog(&deps, logs[0], precompile.StakingEvent_Undelegate)
+
+	out, _, err = s.callStaking(&deps, false, precompile.StakingMethod_Delegation,
+		deps.Sender.EthAddr, valAddr,
+	)
+	s.Require().NoError(err)
+	s.Equal("3000", out[1].(*big.Int).String())
+}
+
+func (s *Suite) Staking_Redelegate() {
+	deps := evmtest.NewTestDeps()
+	s.fundSenderWithBondDenom(&deps, 10_000)
+	srcVal := deps.Chain.StakingKeeper.GetBondedValidatorsByPower(deps.Ctx)[0].OperatorAddress
+	dstVal := s.createValidator(&deps).String()
+
+	_, _, err := s.callStaking(&deps, true, precompile.StakingMethod_Delegate,
+		srcVal, big.NewInt(5_000),
+	)
+	s.Require().NoError(err)
+	_, logs, err := s.callStaking(&deps, true, precompile.StakingMethod_Redelegate,
+		srcVal, dstVal, big.NewInt(2_000),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+	s.assertStakingLog(&deps, logs[0], precompile.StakingEvent_Redelegate)
+
+	for valAddr, wantBalance := range map[string]string{
+		srcVal: "3000",
+		dstVal: "2000",
+	} {
+		out, _, err := s.callStaking(&deps, false, precompile.StakingMethod_Delegation,
+			deps.Sender.EthAddr, valAddr,
+		)
+		s.Require().NoError(err)
+		s.Equal(wantBalance, out[1].(*big.Int).String(), "validator %s", valAddr)
+	}
+}
+
+// Staking_DelegateWithValue: Delegates the EVM denom in a call that also sends
+// value to the precompile. The balance moved by the EVM must not overwrite the
+// balance moved by the precompile when the StateDB is committed.
+func (s *Suite) Staking_DelegateWithValue() {
+	deps := evmtest.NewTestDeps()
+	bondDenom := deps.Chain.StakingKeeper.BondDenom(deps.Ctx)
+	params := deps.K.GetParams(deps.Ctx)
+	params.EvmDenom = bondDenom
+	deps.K.SetParams(deps.Ctx, params)
+	s.fundSenderWithBondDenom(&deps, 10_000)
+	supplyBefore := deps.Chain.BankKeeper.GetSupply(deps.Ctx, bondDenom)
+	valAddr := deps.Chain.StakingKeeper.GetBondedValidatorsByPower(deps.Ctx)[0].OperatorAddress
+
+	input, err := embeds.Contract_Staking.ABI.Pack(
+		string(precompile.StakingMethod_Delegate), valAddr, big.NewInt(4_000),
+	)
+	s.Require().NoError(err)
+	contractAddr := precompile.PrecompileAddr_Staking.ToAddr()
+	s.applyWithValue(&deps, contractAddr, big.NewInt(1), input)
+
+	s.Equal("5999", deps.Chain.BankKeeper.GetBalance(
+		deps.Ctx, deps.Sender.NibiruAddr, bondDenom).Amount.String())
+	s.Equal("1", deps.Chain.BankKeeper.GetBalance(
+		deps.Ctx, contractAddr.Bytes(), bondDenom).Amount.String())
+	s.Equal(supplyBefore.String(),
+		deps.Chain.BankKeeper.GetSupply(deps.Ctx, bondDenom).String(),
+		"the delegation must not mint or burn the EVM denom")
+	out, _, err := s.callStaking(&deps, false, precompile.StakingMethod_Delegation,
+		deps.Sender.EthAddr, valAddr,
+	)
+	s.Require().NoError(err)
+	s.Equal("4000", out[1].(*big.Int).String())
+}
+
+func (s *Suite) Staking_SadPath() {
+	deps := evmtest.NewTestDeps()
+	s.fundSenderWithBondDenom(&deps, 10_000)
+	valAddr := deps.Chain.StakingKeeper.GetBondedValidatorsByPower(deps.Ctx)[0].OperatorAddress
+
+	s.T().Log("validator: invalid address")
+	_, _, err := s.callStaking(&deps, false, precompile.StakingMethod_Validator, "not-a-valoper")
+	s.ErrorContains(err, "invalid validator address")
+
+	s.T().Log("validator: unknown validator")
+	unknownVal := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	_, _, err = s.callStaking(&deps, false, precompile.StakingMethod_Validator, unknownVal)
+	s.ErrorContains(err, "does not exist")
+
+	s.T().Log("delegate: amount must be positive")
+	_, _, err = s.callStaking(&deps, true, precompile.StakingMethod_Delegate,
+		valAddr, big.NewInt(0),
+	)
+	s.ErrorContains(err, "amount must be positive")
+
+	s.T().Log("delegate: insufficient funds")
+	_, _, err = s.callStaking(&deps, true, precompile.StakingMethod_Delegate,
+		valAddr, big.NewInt(1_000_000),
+	)
+	s.ErrorContains(err, "delegate failed")
+
+	s.T().Log("undelegate: no delegation")
+	_, _, err = s.callStaking(&deps, true, precompile.StakingMethod_Undelegate,
+		valAddr, big.NewInt(1),
+	)
+	s.ErrorContains(err, "undelegate failed")
+}
+
+// hasEventType: Returns true if "events" contains an event of the given type.
+func hasEventType(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,326 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/app/keepers"
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+)
+
+var (
+	_ vm.PrecompiledContract = (*precompileWasm)(nil)
+	_ NibiruPrecompile       = (*precompileWasm)(nil)
+)
+
+// Precompile address for "IWasm.sol", the contract that enables EVM contracts
+// to execute, query, and instantiate CosmWasm contracts.
+var PrecompileAddr_Wasm = eth.MustNewHexAddrFromStr(
+	"0x0000000000000000000000000000000000000802",
+)
+
+func (p precompileWasm) Address() gethcommon.Address {
+	return PrecompileAddr_Wasm.ToAddr()
+}
+
+// RequiredGas returns zero because the gas schedule lives in the module
+// params, which require an sdk.Context. The gas of the call is charged inside
+// of "Run" by "RunWithGasSchedule".
+func (p precompileWasm) RequiredGas(input []byte) (gasCost uint64) {
+	return 0
+}
+
+const (
+	WasmMethod_Execute      WasmMethod = "execute"
+	WasmMethod_Query        WasmMethod = "query"
+	WasmMethod_Instantiate  WasmMethod = "instantiate"
+	WasmMethod_ExecuteMulti WasmMethod = "executeMulti"
+)
+
+type WasmMethod string
+
+// Run runs the precompiled contract
+func (p precompileWasm) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	// This is a `defer` pattern to add behavior that runs in the case that the error is
+	// non-nil, creating a concise way to add extra information.
+	defer func() {
+		if err != nil {
+			precompileType := reflect.TypeOf(p).Name()
+			err = fmt.Errorf("Precompile error: failed to run %s: %w", precompileType, err)
+		}
+	}()
+
+	ctx, method, args, err := OnStart(p, evm, contract.Input, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	caller := contract.CallerAddress
+	gasSchedule := p.EvmKeeper.GetParams(ctx).PrecompileGas
+	return RunWithGasSchedule(ctx, contract, gasSchedule, method,
+		func(ctx sdk.Context) ([]byte, error) {
+			switch WasmMethod(method.Name) {
+			case WasmMethod_Execute:
+				return p.execute(ctx, caller, method, args, readonly)
+			case WasmMethod_Query:
+				return p.query(ctx, method, args)
+			case WasmMethod_Instantiate:
+				return p.instantiate(ctx, caller, method, args, readonly)
+			case WasmMethod_ExecuteMulti:
+				return p.executeMulti(ctx, caller, method, args, readonly)
+			default:
+				return nil, fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+			}
+		},
+	)
+}
+
+func PrecompileWasm(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileWasm{
+		PublicKeepers: keepers,
+		Wasm:          wasmkeeper.NewDefaultPermissionKeeper(keepers.WasmKeeper),
+	}
+}
+
+func (p precompileWasm) ABI() gethabi.ABI {
+	return embeds.Contract_Wasm.ABI
+}
+
+type precompileWasm struct {
+	keepers.PublicKeepers
+	NibiruPrecompile
+	// Wasm: Wasm keeper with the permissioned methods for executing and
+	// instantiating contracts.
+	Wasm *wasmkeeper.PermissionedKeeper
+}
+
+// BankCoin: Go representation of "IWasm.BankCoin".
+type BankCoin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+// WasmExecuteMsg: Go representation of "IWasm.WasmExecuteMsg". It also holds
+// the arguments of "IWasm.execute".
+type WasmExecuteMsg struct {
+	ContractAddr string
+	MsgArgs      []byte
+	Funds        []BankCoin
+}
+
+// ArgsWasmQuery: Arguments of "IWasm.query".
+type ArgsWasmQuery struct {
+	ContractAddr string
+	Req          []byte
+}
+
+// ArgsWasmInstantiate: Arguments of "IWasm.instantiate".
+type ArgsWasmInstantiate struct {
+	Admin   string
+	CodeID  uint64
+	MsgArgs []byte
+	Label   string
+	Funds   []BankCoin
+}
+
+// ArgsWasmExecuteMulti: Arguments of "IWasm.executeMulti".
+type ArgsWasmExecuteMulti struct {
+	ExecuteMsgs []WasmExecuteMsg
+}
+
+/*
+execute: Implements "IWasm.execute"
+
+```solidity
+function execute(string memory contractAddr, bytes memory msgArgs, BankCoin[] memory funds) external returns (bytes memory response);
+```
+*/
+func (p precompileWasm) execute(
+	ctx sdk.Context,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []interface{},
+	readOnly bool,
+) (bz []byte, err error) {
+	if err = assertNotReadonlyTx(readOnly); err != nil {
+		return
+	}
+	var wasmArgs WasmExecuteMsg
+	if err = method.Inputs.Copy(&wasmArgs, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+
+	data, err := p.executeWasm(ctx, caller, wasmArgs)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(data)
+}
+
+/*
+query: Implements "IWasm.query"
+
+```solidity
+function query(string memory contractAddr, bytes memory req) external view returns (bytes memory response);
+```
+*/
+func (p precompileWasm) query(
+	ctx sdk.Context,
+	method *gethabi.Method,
+	args []interface{},
+) (bz []byte, err error) {
+	var wasmArgs ArgsWasmQuery
+	if err = method.Inputs.Copy(&wasmArgs, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(wasmArgs.ContractAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wasm contract address \"%s\": %w", wasmArgs.ContractAddr, err)
+	}
+	respBz, err := p.WasmKeeper.QuerySmart(ctx, contractAddr, wasmArgs.Req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm query failed: %w", err)
+	}
+	return method.Outputs.Pack(respBz)
+}
+
+/*
+instantiate: Implements "IWasm.instantiate"
+
+```solidity
+function instantiate(string memory admin, uint64 codeID, bytes memory msgArgs, string memory label, BankCoin[] memory funds) external returns (string memory contractAddr, bytes memory data);
+```
+*/
+func (p precompileWasm) instantiate(
+	ctx sdk.Context,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []interface{},
+	readOnly bool,
+) (bz []byte, err error) {
+	if err = assertNotReadonlyTx(readOnly); err != nil {
+		return
+	}
+	var wasmArgs ArgsWasmInstantiate
+	if err = method.Inputs.Copy(&wasmArgs, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+
+	var admin sdk.AccAddress
+	if wasmArgs.Admin != "" {
+		admin, err = sdk.AccAddressFromBech32(wasmArgs.Admin)
+		if err != nil {
+			return nil, fmt.Errorf("invalid admin address \"%s\": %w", wasmArgs.Admin, err)
+		}
+	}
+	funds, err := parseBankCoins(wasmArgs.Funds)
+	if err != nil {
+		return
+	}
+
+	creator := sdk.AccAddress(caller.Bytes())
+	contractAddr, data, err := p.Wasm.Instantiate(
+		ctx, wasmArgs.CodeID, creator, admin, wasmArgs.MsgArgs, wasmArgs.Label, funds,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("wasm instantiate failed: %w", err)
+	}
+	return method.Outputs.Pack(contractAddr.String(), data)
+}
+
+/*
+executeMulti: Implements "IWasm.executeMulti"
+
+```solidity
+function executeMulti(WasmExecuteMsg[] memory executeMsgs) external returns (bytes[] memory responses);
+```
+*/
+func (p precompileWasm) executeMulti(
+	ctx sdk.Context,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []interface{},
+	readOnly bool,
+) (bz []byte, err error) {
+	if err = assertNotReadonlyTx(readOnly); err != nil {
+		return
+	}
+	var wasmArgs ArgsWasmExecuteMulti
+	if err = method.Inputs.Copy(&wasmArgs, args); err != nil {
+		return nil, fmt.Errorf("failed to parse args of \"%s\": %w", method.Name, err)
+	}
+
+	// Any failure discards the whole call because "ctx" is only committed by
+	// "RunWithGasSchedule" when every execute call succeeds.
+	responses := make([][]byte, len(wasmArgs.ExecuteMsgs))
+	for i, executeMsg := range wasmArgs.ExecuteMsgs {
+		data, err := p.executeWasm(ctx, caller, executeMsg)
+		if err != nil {
+			return nil, fmt.Errorf("execute msg %d: %w", i, err)
+		}
+		responses[i] = data
+	}
+	return method.Outputs.Pack(responses)
+}
+
+// executeWasm: Executes a Wasm contract with the EVM caller as the sender.
+func (p precompileWasm) executeWasm(
+	ctx sdk.Context, caller gethcommon.Address, executeMsg WasmExecuteMsg,
+) (data []byte, err error) {
+	contractAddr, err := sdk.AccAddressFromBech32(executeMsg.ContractAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wasm contract address \"%s\": %w", executeMsg.ContractAddr, err)
+	}
+	funds, err := parseBankCoins(executeMsg.Funds)
+	if err != nil {
+		return
+	}
+	data, err = p.Wasm.Execute(
+		ctx, contractAddr, sdk.AccAddress(caller.Bytes()), executeMsg.MsgArgs, funds,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("wasm execute failed: %w", err)
+	}
+	return data, nil
+}
+
+// parseBankCoins: Converts the "IWasm.BankCoin" arguments of a call into
+// valid sdk.Coins.
+func parseBankCoins(bankCoins []BankCoin) (sdk.Coins, error) {
+	coins := sdk.Coins{}
+	for _, bankCoin := range bankCoins {
+		if bankCoin.Amount == nil {
+			return nil, fmt.Errorf("missing amount for funds of denom \"%s\"", bankCoin.Denom)
+		}
+		coin := sdk.Coin{
+			Denom:  bankCoin.Denom,
+			Amount: math.NewIntFromBigInt(bankCoin.Amount),
+		}
+		if err := coin.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid funds: %w", err)
+		}
+		coins = coins.Add(coin)
+	}
+	return coins, nil
+}
+
+// assertNotReadonlyTx: Returns an error for state-changing methods called
+// within a read-only (static) call.
+func assertNotReadonlyTx(readOnly bool) error {
+	if readOnly {
+		return fmt.Errorf("cannot write state from staticcall (a read-only call)")
+	}
+	return nil
+}
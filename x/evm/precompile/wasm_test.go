@@ -0,0 +1,237 @@
+package precompile_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
+)
+
+func (s *Suite) TestPrecompile_Wasm() {
+	s.Run("PrecompileExists", s.Wasm_PrecompileExists)
+	s.Run("HappyPath", s.Wasm_HappyPath)
+	s.Run("ExecuteMultiIsAtomic", s.Wasm_ExecuteMultiIsAtomic)
+	s.Run("FundsWithValue", s.Wasm_FundsWithValue)
+	s.Run("SadPath", s.Wasm_SadPath)
+}
+
+func (s *Suite) Wasm_PrecompileExists() {
+	deps := evmtest.NewTestDeps()
+	s.True(deps.K.PrecompileSet().Has(precompile.PrecompileAddr_Wasm.ToAddr()),
+		"did not see precompile address during \"InitPrecompiles\"")
+}
+
+// storeNameserviceCode: Stores the "cw_nameservice" Wasm test contract and
+// returns its code ID.
+func (s *Suite) storeNameserviceCode(deps *evmtest.TestDeps) (codeID uint64) {
+	pkgDir, err := testutil.GetPackageDir()
+	s.Require().NoError(err)
+	repoDir := path.Dir(path.Dir(path.Dir(pkgDir)))
+	wasmBytecode, err := os.ReadFile(path.Join(
+		repoDir, "app/wasmext/wasm_cli_test/testdata/cw_nameservice.wasm",
+	))
+	s.Require().NoError(err)
+
+	codeID, _, err = wasmkeeper.NewDefaultPermissionKeeper(deps.Chain.WasmKeeper).Create(
+		deps.Ctx, deps.Sender.NibiruAddr, wasmBytecode,
+		&wasmtypes.AccessConfig{Permission: wasmtypes.AccessTypeEverybody},
+	)
+	s.Require().NoError(err)
+	return codeID
+}
+
+// callWasm: Calls a method of the Wasm precompile from the "TestDeps.Sender"
+// and returns the unpacked outputs.
+func (s *Suite) callWasm(
+	deps *evmtest.TestDeps, commit bool, method precompile.WasmMethod, args ...any,
+) ([]any, error) {
+	abi := embeds.Contract_Wasm.ABI
+	input, err := abi.Pack(string(method), args...)
+	s.Require().NoError(err)
+
+	contractAddr := precompile.PrecompileAddr_Wasm.ToAddr()
+	evmResp, err := deps.K.CallContractWithInput(
+		deps.Ctx, deps.Sender.EthAddr, &contractAddr, commit, input,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return abi.Unpack(string(method), evmResp.Ret)
+}
+
+// applyWithValue: Applies an EVM message from the "TestDeps.Sender" that sends
+// "value" to the contract and commits it. Sending value leaves a dirty balance
+// for the sender in the StateDB.
+func (s *Suite) applyWithValue(
+	deps *evmtest.TestDeps, contractAddr gethcommon.Address, value *big.Int, input []byte,
+) {
+	msg := gethcore.NewMessage(
+		deps.Sender.EthAddr,
+		&contractAddr,
+		deps.K.GetAccNonce(deps.Ctx, deps.Sender.EthAddr),
+		value,
+		1_000_000,     // gasLimit
+		big.NewInt(0), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		input,
+		gethcore.AccessList{},
+		false, // isFake
+	)
+	evmResp, err := deps.K.ApplyEvmMsgWithEmptyTxConfig(deps.Ctx, msg, nil, true)
+	s.Require().NoError(err)
+	s.Require().Empty(evmResp.VmError)
+}
+
+// instantiateNameservice: Instantiates the "cw_nameservice" contract through
+// the Wasm precompile.
+func (s *Suite) instantiateNameservice(deps *evmtest.TestDeps) (contractAddr string) {
+	codeID := s.storeNameserviceCode(deps)
+	out, err := s.callWasm(deps, true, precompile.WasmMethod_Instantiate,
+		"", codeID, []byte(`{}`), "nameservice", []precompile.BankCoin{},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(out, 2)
+	return out[0].(string)
+}
+
+// resolveName: Queries the owner of a name in the "cw_nameservice" contract
+// through the Wasm precompile.
+func (s *Suite) resolveName(
+	deps *evmtest.TestDeps, contractAddr string, name string,
+) (owner string) {
+	req := fmt.Sprintf(`{"resolve_record": {"name": "%s"}}`, name)
+	out, err := s.callWasm(deps, false, precompile.WasmMethod_Query,
+		contractAddr, []byte(req),
+	)
+	s.Require().NoError(err)
+	var resp struct {
+		Address *string `json:"address"`
+	}
+	s.Require().NoError(json.Unmarshal(out[0].([]byte), &resp))
+	if resp.Address == nil {
+		return ""
+	}
+	return *resp.Address
+}
+
+func registerMsg(name string) []byte {
+	return []byte(fmt.Sprintf(`{"register": {"name": "%s"}}`, name))
+}
+
+func (s *Suite) Wasm_HappyPath() {
+	deps := evmtest.NewTestDeps()
+	contractAddr := s.instantiateNameservice(&deps)
+	_, err := sdk.AccAddressFromBech32(contractAddr)
+	s.Require().NoError(err)
+
+	s.T().Log("execute: The EVM caller is the sender of the Wasm message")
+	_, err = s.callWasm(&deps, true, precompile.WasmMethod_Execute,
+		contractAddr, registerMsg("nibiru"), []precompile.BankCoin{},
+	)
+	s.Require().NoError(err)
+	s.Equal(deps.Sender.NibiruAddr.String(), s.resolveName(&deps, contractAddr, "nibiru"))
+
+	s.T().Log("executeMulti")
+	_, err = s.callWasm(&deps, true, precompile.WasmMethod_ExecuteMulti,
+		[]precompile.WasmExecuteMsg{
+			{ContractAddr: contractAddr, MsgArgs: registerMsg("alice"), Funds: []precompile.BankCoin{}},
+			{ContractAddr: contractAddr, MsgArgs: registerMsg("bobby"), Funds: []precompile.BankCoin{}},
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(deps.Sender.NibiruAddr.String(), s.resolveName(&deps, contractAddr, "alice"))
+	s.Equal(deps.Sender.NibiruAddr.String(), s.resolveName(&deps, contractAddr, "bobby"))
+}
+
+func (s *Suite) Wasm_ExecuteMultiIsAtomic() {
+	deps := evmtest.NewTestDeps()
+	contractAddr := s.instantiateNameservice(&deps)
+
+	s.T().Log("The second message fails, so the first one must not apply")
+	_, err := s.callWasm(&deps, true, precompile.WasmMethod_ExecuteMulti,
+		[]precompile.WasmExecuteMsg{
+			{ContractAddr: contractAddr, MsgArgs: registerMsg("alice"), Funds: []precompile.BankCoin{}},
+			{ContractAddr: contractAddr, MsgArgs: []byte(`{"not_a_msg": {}}`), Funds: []precompile.BankCoin{}},
+		},
+	)
+	s.Require().ErrorContains(err, "execute msg 1")
+	s.Equal("", s.resolveName(&deps, contractAddr, "alice"))
+}
+
+// Wasm_FundsWithValue: Sends funds in the EVM denom to a Wasm contract in a
+// call that also sends value to the precompile. The balance moved by the EVM
+// must not overwrite the funds moved by the precompile when the StateDB is
+// committed.
+func (s *Suite) Wasm_FundsWithValue() {
+	deps := evmtest.NewTestDeps()
+	contractAddr := s.instantiateNameservice(&deps)
+	evmDenom := deps.K.GetParams(deps.Ctx).EvmDenom
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 10_000)),
+	))
+	supplyBefore := deps.Chain.BankKeeper.GetSupply(deps.Ctx, evmDenom)
+
+	input, err := embeds.Contract_Wasm.ABI.Pack(
+		string(precompile.WasmMethod_Execute), contractAddr, registerMsg("nibiru"),
+		[]precompile.BankCoin{{Denom: evmDenom, Amount: big.NewInt(4_000)}},
+	)
+	s.Require().NoError(err)
+	wasmAddr := precompile.PrecompileAddr_Wasm.ToAddr()
+	s.applyWithValue(&deps, wasmAddr, big.NewInt(1), input)
+
+	bankKeeper := deps.Chain.BankKeeper
+	s.Equal("5999", bankKeeper.GetBalance(
+		deps.Ctx, deps.Sender.NibiruAddr, evmDenom).Amount.String())
+	s.Equal("1", bankKeeper.GetBalance(
+		deps.Ctx, wasmAddr.Bytes(), evmDenom).Amount.String())
+	s.Equal("4000", bankKeeper.GetBalance(
+		deps.Ctx, sdk.MustAccAddressFromBech32(contractAddr), evmDenom).Amount.String())
+	s.Equal(supplyBefore.String(), bankKeeper.GetSupply(deps.Ctx, evmDenom).String(),
+		"the Wasm funds must not mint or burn the EVM denom")
+	s.Equal(deps.Sender.NibiruAddr.String(), s.resolveName(&deps, contractAddr, "nibiru"))
+}
+
+func (s *Suite) Wasm_SadPath() {
+	deps := evmtest.NewTestDeps()
+	contractAddr := s.instantiateNameservice(&deps)
+
+	s.T().Log("execute: invalid contract address")
+	_, err := s.callWasm(&deps, true, precompile.WasmMethod_Execute,
+		"not-an-address", registerMsg("nibiru"), []precompile.BankCoin{},
+	)
+	s.ErrorContains(err, "invalid wasm contract address")
+
+	s.T().Log("execute: unknown Wasm message")
+	_, err = s.callWasm(&deps, true, precompile.WasmMethod_Execute,
+		contractAddr, []byte(`{"not_a_msg": {}}`), []precompile.BankCoin{},
+	)
+	s.ErrorContains(err, "wasm execute failed")
+
+	s.T().Log("execute: invalid funds")
+	_, err = s.callWasm(&deps, true, precompile.WasmMethod_Execute,
+		contractAddr, registerMsg("nibiru"),
+		[]precompile.BankCoin{{Denom: "!!", Amount: big.NewInt(1)}},
+	)
+	s.ErrorContains(err, "invalid funds")
+
+	s.T().Log("query: invalid request")
+	_, err = s.callWasm(&deps, false, precompile.WasmMethod_Query,
+		contractAddr, []byte(`{"not_a_query": {}}`),
+	)
+	s.ErrorContains(err, "wasm query failed")
+}
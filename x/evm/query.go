@@ -29,6 +29,15 @@ func (m QueryTraceBlockRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker
 	return nil
 }
 
+func (m QueryIntermediateRootsRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range m.Txs {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (req *QueryEthAccountRequest) Validate() error {
 	if req == nil {
 		return common.ErrNilGrpcMsg
@@ -139,3 +148,21 @@ func (req *QueryTraceBlockRequest) Validate() error {
 	}
 	return nil
 }
+
+func (req *QueryTraceCallRequest) Validate() error {
+	if req == nil {
+		return common.ErrNilGrpcMsg
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+	return nil
+}
+
+func (req *QueryIntermediateRootsRequest) Validate() error {
+	if req == nil {
+		return common.ErrNilGrpcMsg
+	}
+	return nil
+}
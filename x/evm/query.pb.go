@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -797,6 +798,12 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// state_overrides is the JSON of the accounts to override before the call,
+	// in the format of the json rpc api.
+	StateOverrides []byte `protobuf:"bytes,5,opt,name=state_overrides,json=stateOverrides,proto3" json:"state_overrides,omitempty"`
+	// block_overrides is the JSON of the block fields to override for the call,
+	// in the format of the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -860,6 +867,20 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetStateOverrides() []byte {
+	if m != nil {
+		return m.StateOverrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -906,6 +927,71 @@ func (m *EstimateGasResponse) GetGas() uint64 {
 	return 0
 }
 
+// QueryCreateAccessListResponse defines CreateAccessList response
+type QueryCreateAccessListResponse struct {
+	// access_list is the EIP-2930 access list of the accounts and storage slots
+	// that the call touches
+	AccessList AccessList `protobuf:"bytes,1,rep,name=access_list,json=accessList,proto3,castrepeated=AccessList" json:"accessList"`
+	// gas_used is the gas used by the call with the access list
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm_error is the error returned by the EVM execution of the call, if any
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *QueryCreateAccessListResponse) Reset()         { *m = QueryCreateAccessListResponse{} }
+func (m *QueryCreateAccessListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCreateAccessListResponse) ProtoMessage()    {}
+func (*QueryCreateAccessListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{18}
+}
+func (m *QueryCreateAccessListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreateAccessListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreateAccessListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreateAccessListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreateAccessListResponse.Merge(m, src)
+}
+func (m *QueryCreateAccessListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreateAccessListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreateAccessListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreateAccessListResponse proto.InternalMessageInfo
+
+func (m *QueryCreateAccessListResponse) GetAccessList() AccessList {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *QueryCreateAccessListResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QueryCreateAccessListResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -933,7 +1019,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{18}
+	return fileDescriptor_ffa36cdc5add14ed, []int{19}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1035,7 +1121,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{19}
+	return fileDescriptor_ffa36cdc5add14ed, []int{20}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1181,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{20}
+	return fileDescriptor_ffa36cdc5add14ed, []int{21}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1190,7 +1276,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{21}
+	return fileDescriptor_ffa36cdc5add14ed, []int{22}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,23 +1312,46 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
-// QueryBaseFeeRequest defines the request type for querying the EIP1559 base
-// fee.
-type QueryBaseFeeRequest struct {
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// state_overrides is the JSON of the accounts to override before the call,
+	// in the format of the json rpc api.
+	StateOverrides []byte `protobuf:"bytes,4,opt,name=state_overrides,json=stateOverrides,proto3" json:"state_overrides,omitempty"`
+	// block_overrides is the JSON of the block fields to override for the call,
+	// in the format of the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,5,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+	// block_number of the block whose state the call runs on
+	BlockNumber int64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash (hex) of the block whose state the call runs on
+	BlockHash string `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the block whose state the call runs on
+	BlockTime time.Time `protobuf:"bytes,8,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,9,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,10,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the block
+	BlockMaxGas int64 `protobuf:"varint,11,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
 }
 
-func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
-func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBaseFeeRequest) ProtoMessage()    {}
-func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{22}
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{23}
 }
-func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1252,36 +1361,113 @@ func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
 }
-func (m *QueryBaseFeeRequest) XXX_Size() int {
+func (m *QueryTraceCallRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
 
-// QueryBaseFeeResponse returns the EIP1559 base fee.
-type QueryBaseFeeResponse struct {
-	// base_fee is the EIP1559 base fee
-	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
 }
 
-func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
-func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBaseFeeResponse) ProtoMessage()    {}
-func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{23}
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
 }
-func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetStateOverrides() []byte {
+	if m != nil {
+		return m.StateOverrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryTraceCallRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockMaxGas() int64 {
+	if m != nil {
+		return m.BlockMaxGas
+	}
+	return 0
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{24}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1291,35 +1477,55 @@ func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
 }
-func (m *QueryBaseFeeResponse) XXX_Size() int {
+func (m *QueryTraceCallResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
 
-type QueryTokenMappingRequest struct {
-	// either the 0x contract address of the ERC-20 token or the cosmos denom
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *QueryTokenMappingRequest) Reset()         { *m = QueryTokenMappingRequest{} }
-func (m *QueryTokenMappingRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTokenMappingRequest) ProtoMessage()    {}
-func (*QueryTokenMappingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{24}
+// QueryIntermediateRootsRequest defines the IntermediateRoots request
+type QueryIntermediateRootsRequest struct {
+	// txs is an array of messages in the block
+	Txs []*MsgEthereumTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	// block_number of the replayed block
+	BlockNumber int64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash (hex) of the replayed block
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the replayed block
+	BlockTime time.Time `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the address of the requested block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,5,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the replayed block
+	BlockMaxGas int64 `protobuf:"varint,7,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
 }
-func (m *QueryTokenMappingRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryIntermediateRootsRequest) Reset()         { *m = QueryIntermediateRootsRequest{} }
+func (m *QueryIntermediateRootsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediateRootsRequest) ProtoMessage()    {}
+func (*QueryIntermediateRootsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{25}
+}
+func (m *QueryIntermediateRootsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokenMappingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIntermediateRootsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokenMappingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIntermediateRootsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1329,35 +1535,89 @@ func (m *QueryTokenMappingRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryTokenMappingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokenMappingRequest.Merge(m, src)
+func (m *QueryIntermediateRootsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediateRootsRequest.Merge(m, src)
 }
-func (m *QueryTokenMappingRequest) XXX_Size() int {
+func (m *QueryIntermediateRootsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokenMappingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokenMappingRequest.DiscardUnknown(m)
+func (m *QueryIntermediateRootsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediateRootsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokenMappingRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryIntermediateRootsRequest proto.InternalMessageInfo
 
-type QueryTokenMappingResponse struct {
-	// fun_token is a mapping between the Cosmos native coin and the ERC20 contract address
-	FunToken *FunToken `protobuf:"bytes,1,opt,name=fun_token,json=funToken,proto3" json:"fun_token,omitempty"`
+func (m *QueryIntermediateRootsRequest) GetTxs() []*MsgEthereumTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
 }
 
-func (m *QueryTokenMappingResponse) Reset()         { *m = QueryTokenMappingResponse{} }
-func (m *QueryTokenMappingResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTokenMappingResponse) ProtoMessage()    {}
-func (*QueryTokenMappingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{25}
+func (m *QueryIntermediateRootsRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
 }
-func (m *QueryTokenMappingResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryIntermediateRootsRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryIntermediateRootsRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryIntermediateRootsRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryIntermediateRootsRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryIntermediateRootsRequest) GetBlockMaxGas() int64 {
+	if m != nil {
+		return m.BlockMaxGas
+	}
+	return 0
+}
+
+// QueryIntermediateRootsResponse defines the IntermediateRoots response
+type QueryIntermediateRootsResponse struct {
+	// roots holds the hex-encoded state commitment after each tx, in order
+	Roots []string `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	// errors holds the error of each tx, in order. It is empty for the txs
+	// that were applied. A failed tx changes no state, so its root is the root
+	// of the previous tx.
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *QueryIntermediateRootsResponse) Reset()         { *m = QueryIntermediateRootsResponse{} }
+func (m *QueryIntermediateRootsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediateRootsResponse) ProtoMessage()    {}
+func (*QueryIntermediateRootsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{26}
+}
+func (m *QueryIntermediateRootsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokenMappingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIntermediateRootsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokenMappingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIntermediateRootsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1367,22 +1627,284 @@ func (m *QueryTokenMappingResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryTokenMappingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokenMappingResponse.Merge(m, src)
+func (m *QueryIntermediateRootsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediateRootsResponse.Merge(m, src)
 }
-func (m *QueryTokenMappingResponse) XXX_Size() int {
+func (m *QueryIntermediateRootsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokenMappingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokenMappingResponse.DiscardUnknown(m)
+func (m *QueryIntermediateRootsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediateRootsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokenMappingResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryIntermediateRootsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryEthAccountRequest)(nil), "eth.evm.v1.QueryEthAccountRequest")
-	proto.RegisterType((*QueryEthAccountResponse)(nil), "eth.evm.v1.QueryEthAccountResponse")
-	proto.RegisterType((*QueryNibiruAccountRequest)(nil), "eth.evm.v1.QueryNibiruAccountRequest")
+func (m *QueryIntermediateRootsResponse) GetRoots() []string {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
+func (m *QueryIntermediateRootsResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+// QueryBaseFeeRequest defines the request type for querying the EIP1559 base
+// fee.
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{27}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse returns the EIP1559 base fee.
+type QueryBaseFeeResponse struct {
+	// base_fee is the EIP1559 base fee
+	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{28}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+type QueryTokenMappingRequest struct {
+	// either the 0x contract address of the ERC-20 token or the cosmos denom
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryTokenMappingRequest) Reset()         { *m = QueryTokenMappingRequest{} }
+func (m *QueryTokenMappingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenMappingRequest) ProtoMessage()    {}
+func (*QueryTokenMappingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{29}
+}
+func (m *QueryTokenMappingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenMappingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenMappingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenMappingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenMappingRequest.Merge(m, src)
+}
+func (m *QueryTokenMappingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenMappingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenMappingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenMappingRequest proto.InternalMessageInfo
+
+type QueryTokenMappingResponse struct {
+	// fun_token is a mapping between the Cosmos native coin and the ERC20 contract address
+	FunToken *FunToken `protobuf:"bytes,1,opt,name=fun_token,json=funToken,proto3" json:"fun_token,omitempty"`
+}
+
+func (m *QueryTokenMappingResponse) Reset()         { *m = QueryTokenMappingResponse{} }
+func (m *QueryTokenMappingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenMappingResponse) ProtoMessage()    {}
+func (*QueryTokenMappingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{30}
+}
+func (m *QueryTokenMappingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenMappingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenMappingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenMappingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenMappingResponse.Merge(m, src)
+}
+func (m *QueryTokenMappingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenMappingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenMappingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenMappingResponse proto.InternalMessageInfo
+
+// QueryCreateFunTokenFeeRequest is the request type for the
+// Query/CreateFunTokenFee RPC method.
+type QueryCreateFunTokenFeeRequest struct {
+}
+
+func (m *QueryCreateFunTokenFeeRequest) Reset()         { *m = QueryCreateFunTokenFeeRequest{} }
+func (m *QueryCreateFunTokenFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCreateFunTokenFeeRequest) ProtoMessage()    {}
+func (*QueryCreateFunTokenFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{31}
+}
+func (m *QueryCreateFunTokenFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreateFunTokenFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreateFunTokenFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreateFunTokenFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreateFunTokenFeeRequest.Merge(m, src)
+}
+func (m *QueryCreateFunTokenFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreateFunTokenFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreateFunTokenFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreateFunTokenFeeRequest proto.InternalMessageInfo
+
+// QueryCreateFunTokenFeeResponse is the response type for the
+// Query/CreateFunTokenFee RPC method.
+type QueryCreateFunTokenFeeResponse struct {
+	// fee is the fee paid by the sender of a "MsgCreateFunToken".
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// burned: If true, the fee is burned. Otherwise, it funds the community
+	// pool.
+	Burned bool `protobuf:"varint,2,opt,name=burned,proto3" json:"burned,omitempty"`
+}
+
+func (m *QueryCreateFunTokenFeeResponse) Reset()         { *m = QueryCreateFunTokenFeeResponse{} }
+func (m *QueryCreateFunTokenFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCreateFunTokenFeeResponse) ProtoMessage()    {}
+func (*QueryCreateFunTokenFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{32}
+}
+func (m *QueryCreateFunTokenFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreateFunTokenFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreateFunTokenFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreateFunTokenFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreateFunTokenFeeResponse.Merge(m, src)
+}
+func (m *QueryCreateFunTokenFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreateFunTokenFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreateFunTokenFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreateFunTokenFeeResponse proto.InternalMessageInfo
+
+func (m *QueryCreateFunTokenFeeResponse) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *QueryCreateFunTokenFeeResponse) GetBurned() bool {
+	if m != nil {
+		return m.Burned
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryEthAccountRequest)(nil), "eth.evm.v1.QueryEthAccountRequest")
+	proto.RegisterType((*QueryEthAccountResponse)(nil), "eth.evm.v1.QueryEthAccountResponse")
+	proto.RegisterType((*QueryNibiruAccountRequest)(nil), "eth.evm.v1.QueryNibiruAccountRequest")
 	proto.RegisterType((*QueryNibiruAccountResponse)(nil), "eth.evm.v1.QueryNibiruAccountResponse")
 	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "eth.evm.v1.QueryValidatorAccountRequest")
 	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "eth.evm.v1.QueryValidatorAccountResponse")
@@ -1398,117 +1920,150 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "eth.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "eth.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "eth.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*QueryCreateAccessListResponse)(nil), "eth.evm.v1.QueryCreateAccessListResponse")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "eth.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "eth.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "eth.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "eth.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "eth.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "eth.evm.v1.QueryTraceCallResponse")
+	proto.RegisterType((*QueryIntermediateRootsRequest)(nil), "eth.evm.v1.QueryIntermediateRootsRequest")
+	proto.RegisterType((*QueryIntermediateRootsResponse)(nil), "eth.evm.v1.QueryIntermediateRootsResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "eth.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "eth.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryTokenMappingRequest)(nil), "eth.evm.v1.QueryTokenMappingRequest")
 	proto.RegisterType((*QueryTokenMappingResponse)(nil), "eth.evm.v1.QueryTokenMappingResponse")
+	proto.RegisterType((*QueryCreateFunTokenFeeRequest)(nil), "eth.evm.v1.QueryCreateFunTokenFeeRequest")
+	proto.RegisterType((*QueryCreateFunTokenFeeResponse)(nil), "eth.evm.v1.QueryCreateFunTokenFeeResponse")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/query.proto", fileDescriptor_ffa36cdc5add14ed) }
 
 var fileDescriptor_ffa36cdc5add14ed = []byte{
-	// 1544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4d, 0x6f, 0x1b, 0x55,
-	0x17, 0xce, 0xc4, 0x4e, 0xec, 0x1c, 0xa7, 0x6d, 0xde, 0xdb, 0xb4, 0x49, 0xa6, 0x89, 0x9d, 0x4e,
-	0xda, 0x24, 0xed, 0xdb, 0xce, 0x34, 0x01, 0x81, 0xa8, 0xa8, 0x50, 0x13, 0xa5, 0xa1, 0xf4, 0x43,
-	0xc5, 0x44, 0x08, 0x21, 0x21, 0xeb, 0xda, 0xbe, 0x19, 0x8f, 0xe2, 0x99, 0xeb, 0xce, 0xbd, 0x0e,
-	0x0e, 0x25, 0x1b, 0xba, 0x01, 0xb1, 0xa0, 0x12, 0x7f, 0xa0, 0x2b, 0xfe, 0x02, 0x7f, 0xa1, 0xcb,
-	0x22, 0x36, 0x88, 0x45, 0x41, 0x2d, 0x0b, 0xd6, 0x2c, 0x59, 0xa1, 0xfb, 0x31, 0xf1, 0x8c, 0x3d,
-	0x4e, 0x28, 0x1f, 0x3b, 0x56, 0x33, 0xf7, 0xde, 0x73, 0xce, 0xf3, 0x9c, 0xfb, 0x71, 0xce, 0x03,
-	0xa7, 0x09, 0x6f, 0x38, 0x64, 0xd7, 0x77, 0x76, 0x57, 0x9c, 0xfb, 0x6d, 0x12, 0xee, 0xd9, 0xad,
-	0x90, 0x72, 0x8a, 0x80, 0xf0, 0x86, 0x4d, 0x76, 0x7d, 0x7b, 0x77, 0xc5, 0xbc, 0x58, 0xa3, 0xcc,
-	0xa7, 0xcc, 0xa9, 0x62, 0x46, 0x94, 0x91, 0xb3, 0xbb, 0x52, 0x25, 0x1c, 0xaf, 0x38, 0x2d, 0xec,
-	0x7a, 0x01, 0xe6, 0x1e, 0x0d, 0x94, 0x9f, 0x39, 0x19, 0x8b, 0x27, 0xdc, 0xd5, 0xec, 0xc9, 0xd8,
-	0x2c, 0xef, 0x44, 0xa6, 0x2e, 0x75, 0xa9, 0xfc, 0x75, 0xc4, 0x9f, 0x9e, 0x9d, 0x75, 0x29, 0x75,
-	0x9b, 0xc4, 0xc1, 0x2d, 0xcf, 0xc1, 0x41, 0x40, 0xb9, 0x8c, 0xce, 0xf4, 0x6a, 0x49, 0xaf, 0xca,
-	0x51, 0xb5, 0xbd, 0xed, 0x70, 0xcf, 0x27, 0x8c, 0x63, 0xbf, 0xa5, 0x0c, 0xac, 0x37, 0xe1, 0xf4,
-	0xbb, 0x82, 0xe1, 0x06, 0x6f, 0x5c, 0xaf, 0xd5, 0x68, 0x3b, 0xe0, 0x65, 0x72, 0xbf, 0x4d, 0x18,
-	0x47, 0xd3, 0x90, 0xc3, 0xf5, 0x7a, 0x48, 0x18, 0x9b, 0x36, 0xe6, 0x8d, 0xe5, 0xb1, 0x72, 0x34,
-	0xbc, 0x9a, 0xff, 0xfc, 0x71, 0x69, 0xe8, 0xd7, 0xc7, 0xa5, 0x21, 0x6b, 0x1b, 0xa6, 0xfa, 0xbc,
-	0x59, 0x8b, 0x06, 0x8c, 0x08, 0xf7, 0x2a, 0x6e, 0xe2, 0xa0, 0x46, 0x22, 0x77, 0x3d, 0x44, 0x67,
-	0x60, 0xac, 0x46, 0xeb, 0xa4, 0xd2, 0xc0, 0xac, 0x31, 0x3d, 0x2c, 0xd7, 0xf2, 0x62, 0xe2, 0x6d,
-	0xcc, 0x1a, 0x68, 0x12, 0x46, 0x02, 0x2a, 0x9c, 0x32, 0xf3, 0xc6, 0x72, 0xb6, 0xac, 0x06, 0xd6,
-	0x5b, 0x30, 0x23, 0x71, 0xee, 0x7a, 0x55, 0x2f, 0x6c, 0xff, 0x05, 0xa2, 0x7b, 0x60, 0xa6, 0x05,
-	0xe8, 0x72, 0x4d, 0x8f, 0x80, 0x4c, 0xc8, 0x33, 0x01, 0x23, 0x18, 0x0d, 0x4b, 0x46, 0x07, 0x63,
-	0x74, 0x1e, 0x8e, 0x63, 0x15, 0xa8, 0x12, 0xb4, 0xfd, 0x2a, 0x09, 0x35, 0xe7, 0x63, 0x7a, 0xf6,
-	0xae, 0x9c, 0xb4, 0x6e, 0xc1, 0xac, 0x84, 0x7e, 0x1f, 0x37, 0xbd, 0x3a, 0xe6, 0x34, 0xec, 0xa1,
-	0x7f, 0x16, 0xc6, 0x6b, 0x34, 0x60, 0x95, 0x24, 0x83, 0x82, 0x98, 0xbb, 0xde, 0x97, 0xc7, 0x97,
-	0x06, 0xcc, 0x0d, 0x88, 0xa6, 0x73, 0x59, 0x82, 0x13, 0x11, 0xab, 0x64, 0xc4, 0x88, 0xec, 0xf5,
-	0x7f, 0x2e, 0xb5, 0x37, 0xe0, 0xa4, 0x24, 0xb3, 0xa6, 0x4e, 0xf6, 0x65, 0x0e, 0xe4, 0x0a, 0x4c,
-	0x26, 0x5d, 0x8f, 0xba, 0x36, 0xd6, 0x2d, 0x0d, 0xf6, 0x1e, 0xa7, 0x21, 0x76, 0x8f, 0x06, 0x43,
-	0x13, 0x90, 0xd9, 0x21, 0x7b, 0xfa, 0x86, 0x89, 0xdf, 0x18, 0xfc, 0x25, 0x0d, 0x7f, 0x10, 0x4c,
-	0xc3, 0x4f, 0xc2, 0xc8, 0x2e, 0x6e, 0xb6, 0x23, 0x70, 0x35, 0xb0, 0x5e, 0x83, 0x09, 0x69, 0xbd,
-	0x4e, 0xeb, 0x2f, 0x95, 0xe4, 0x12, 0xfc, 0x2f, 0xe6, 0xa7, 0x21, 0x10, 0x64, 0xc5, 0x6d, 0x97,
-	0x5e, 0xe3, 0x65, 0xf9, 0x6f, 0x7d, 0x02, 0x48, 0x1a, 0x6e, 0x75, 0x6e, 0x53, 0x97, 0x45, 0x10,
-	0x08, 0xb2, 0xf2, 0x8d, 0xa8, 0xf8, 0xf2, 0x1f, 0xdd, 0x00, 0xe8, 0xd6, 0x10, 0x99, 0x5b, 0x61,
-	0x75, 0xd1, 0x56, 0x05, 0xc7, 0x16, 0x05, 0xc7, 0x56, 0x55, 0x49, 0x17, 0x1c, 0xfb, 0x5e, 0x77,
-	0xab, 0xca, 0x31, 0xcf, 0x18, 0xc9, 0x87, 0x86, 0xde, 0xd8, 0x08, 0x5c, 0xf3, 0x5c, 0x80, 0x6c,
-	0x93, 0xba, 0x22, 0xbb, 0xcc, 0x72, 0x61, 0xf5, 0x84, 0xdd, 0x2d, 0x70, 0xf6, 0x6d, 0xea, 0x96,
-	0xe5, 0x22, 0xda, 0x4c, 0xa1, 0xb3, 0x74, 0x24, 0x1d, 0x85, 0x10, 0xe7, 0x63, 0x4d, 0xea, 0x1d,
-	0xb8, 0x87, 0x43, 0xec, 0x47, 0x3b, 0x60, 0x6d, 0x6a, 0x6a, 0xd1, 0xac, 0xa6, 0x76, 0x05, 0x46,
-	0x5b, 0x72, 0x46, 0x6e, 0x4d, 0x61, 0x15, 0xc5, 0xc9, 0x29, 0xdb, 0xb5, 0xec, 0x93, 0x67, 0xa5,
-	0xa1, 0xb2, 0xb6, 0xb3, 0xbe, 0x35, 0xe0, 0xf8, 0x06, 0x6f, 0xac, 0xe3, 0x66, 0x33, 0xb6, 0xbb,
-	0x38, 0x74, 0x59, 0x74, 0x0e, 0xe2, 0x1f, 0x4d, 0x41, 0xce, 0xc5, 0xac, 0x52, 0xc3, 0x2d, 0xfd,
-	0x24, 0x46, 0x5d, 0xcc, 0xd6, 0x71, 0x0b, 0x7d, 0x04, 0x13, 0xad, 0x90, 0xb6, 0x28, 0x23, 0xe1,
-	0xc1, 0xb3, 0x12, 0x4f, 0x62, 0x7c, 0x6d, 0xf5, 0xf7, 0x67, 0x25, 0xdb, 0xf5, 0x78, 0xa3, 0x5d,
-	0xb5, 0x6b, 0xd4, 0x77, 0x74, 0xed, 0x57, 0x9f, 0xcb, 0xac, 0xbe, 0xe3, 0xf0, 0xbd, 0x16, 0x61,
-	0xf6, 0x7a, 0xf7, 0x3d, 0x97, 0x4f, 0x44, 0xb1, 0xa2, 0xb7, 0x38, 0x03, 0xf9, 0x5a, 0x03, 0x7b,
-	0x41, 0xc5, 0xab, 0x4f, 0x67, 0xe7, 0x8d, 0xe5, 0x4c, 0x39, 0x27, 0xc7, 0x37, 0xeb, 0xd6, 0x12,
-	0x9c, 0xdc, 0x60, 0xdc, 0xf3, 0x31, 0x27, 0x9b, 0xb8, 0xbb, 0x05, 0x13, 0x90, 0x71, 0xb1, 0x22,
-	0x9f, 0x2d, 0x8b, 0x5f, 0xeb, 0xb7, 0x4c, 0x74, 0x8e, 0x21, 0xae, 0x91, 0xad, 0x4e, 0x94, 0xe7,
-	0xff, 0x21, 0xe3, 0x33, 0x57, 0xef, 0xd4, 0x4c, 0x7c, 0xa7, 0xee, 0x30, 0x77, 0x83, 0x37, 0x48,
-	0x48, 0xda, 0xfe, 0x56, 0xa7, 0x2c, 0xac, 0xd0, 0x55, 0x18, 0xe7, 0xc2, 0xbd, 0x52, 0xa3, 0xc1,
-	0xb6, 0xe7, 0xca, 0x1c, 0x0b, 0xab, 0x53, 0x71, 0x2f, 0x19, 0x7e, 0x5d, 0x2e, 0x97, 0x0b, 0xbc,
-	0x3b, 0x40, 0xd7, 0x60, 0xbc, 0x15, 0x92, 0x3a, 0xa9, 0x11, 0xc6, 0x68, 0xc8, 0xa6, 0xb3, 0xf2,
-	0xe2, 0x1c, 0x82, 0x98, 0x30, 0x17, 0x75, 0xb0, 0xda, 0xa4, 0xb5, 0x9d, 0xa8, 0xe2, 0x8c, 0xc8,
-	0x7d, 0x28, 0xc8, 0x39, 0x55, 0x6f, 0xd0, 0x1c, 0x80, 0x32, 0x91, 0xcf, 0x62, 0x54, 0x3e, 0x8b,
-	0x31, 0x39, 0x23, 0x7b, 0xc7, 0x7a, 0xb4, 0x2c, 0x9a, 0xdc, 0x74, 0x4e, 0x52, 0x37, 0x6d, 0xd5,
-	0x01, 0xed, 0xa8, 0x03, 0xda, 0x5b, 0x51, 0x07, 0x5c, 0xcb, 0x8b, 0x2b, 0xf2, 0xe8, 0xa7, 0x92,
-	0xa1, 0x83, 0x88, 0x95, 0xd4, 0x93, 0xce, 0xff, 0x3b, 0x27, 0x3d, 0x96, 0x38, 0x69, 0x64, 0xc1,
-	0x31, 0x45, 0xdf, 0xc7, 0x9d, 0x8a, 0x38, 0x5c, 0x88, 0xed, 0xc0, 0x1d, 0xdc, 0xd9, 0xc4, 0xec,
-	0x9d, 0x6c, 0x7e, 0x78, 0x22, 0x53, 0xce, 0xf3, 0x4e, 0xc5, 0x0b, 0xea, 0xa4, 0x63, 0x5d, 0xd4,
-	0x75, 0xec, 0xe0, 0xcc, 0xbb, 0x45, 0xa6, 0x8e, 0x39, 0x8e, 0x2e, 0xb7, 0xf8, 0xb7, 0xbe, 0xc9,
-	0xe8, 0x5e, 0x2f, 0x8d, 0xd7, 0x44, 0xd4, 0xd8, 0x1d, 0xe1, 0x9d, 0xe8, 0xa9, 0x1f, 0x76, 0x47,
-	0x78, 0x87, 0xfd, 0xad, 0x3b, 0xf2, 0xdf, 0x21, 0x1f, 0x7d, 0xc8, 0xd6, 0x65, 0xad, 0xaa, 0xe2,
-	0xe7, 0x74, 0xc8, 0xb9, 0x9e, 0x3a, 0xe8, 0xc2, 0x8c, 0xdc, 0x20, 0x51, 0xb5, 0xb7, 0x6e, 0x1f,
-	0x74, 0x58, 0x3d, 0xad, 0x43, 0xbc, 0x0a, 0x79, 0x51, 0x98, 0x2b, 0xdb, 0x44, 0x77, 0xb9, 0xb5,
-	0x99, 0x1f, 0x9f, 0x95, 0x4e, 0xa9, 0x0c, 0x59, 0x7d, 0xc7, 0xf6, 0xa8, 0xe3, 0x63, 0xde, 0xb0,
-	0x6f, 0x06, 0x5c, 0x74, 0x5f, 0xe9, 0x6d, 0x5d, 0x85, 0x69, 0xc5, 0x89, 0xee, 0x90, 0xe0, 0x0e,
-	0x6e, 0xb5, 0xbc, 0xc0, 0x8d, 0x6e, 0xcf, 0x24, 0x8c, 0x70, 0x31, 0x1d, 0x35, 0x4d, 0x39, 0x88,
-	0x75, 0x98, 0x0f, 0xb4, 0x7a, 0x4b, 0xfa, 0x6a, 0x3a, 0x2b, 0x30, 0xb6, 0xdd, 0x0e, 0x2a, 0xdd,
-	0x00, 0x85, 0xd5, 0xc9, 0xf8, 0x55, 0xba, 0xd1, 0x0e, 0xa4, 0x5f, 0x39, 0xbf, 0xad, 0xff, 0xba,
-	0x91, 0x57, 0xbf, 0x1b, 0x87, 0x11, 0x19, 0x1a, 0x3d, 0x34, 0x00, 0xba, 0x2a, 0x14, 0x59, 0xf1,
-	0x10, 0xe9, 0x02, 0xd7, 0x5c, 0x38, 0xd4, 0x46, 0xd1, 0xb3, 0x2e, 0x7d, 0xf6, 0xfd, 0x2f, 0x5f,
-	0x0f, 0x2f, 0xa2, 0x73, 0x4e, 0x20, 0xa5, 0xe3, 0x81, 0x56, 0xe7, 0x8d, 0x8a, 0x16, 0x43, 0xce,
-	0x03, 0x7d, 0x85, 0xf6, 0xd1, 0x57, 0x06, 0x1c, 0x4b, 0x48, 0x4c, 0x74, 0xbe, 0x0f, 0x24, 0x4d,
-	0xc3, 0x9a, 0x8b, 0x47, 0x99, 0x69, 0x3a, 0x8e, 0xa4, 0x73, 0x01, 0x2d, 0xf5, 0xd0, 0x51, 0xa3,
-	0x14, 0x46, 0x8f, 0x0d, 0x98, 0xe8, 0xd5, 0x8a, 0x68, 0xb9, 0x0f, 0x6d, 0x80, 0x38, 0x35, 0x2f,
-	0xfc, 0x09, 0x4b, 0x4d, 0xed, 0x75, 0x49, 0x6d, 0x05, 0x39, 0x3d, 0xd4, 0x76, 0x23, 0x87, 0x2e,
-	0xbb, 0xb8, 0xde, 0xdd, 0x47, 0x1f, 0x43, 0x4e, 0xab, 0x40, 0x54, 0xea, 0x83, 0x4b, 0x4a, 0x4b,
-	0x73, 0x7e, 0xb0, 0x81, 0xa6, 0x71, 0x41, 0xd2, 0x58, 0x40, 0x67, 0x7b, 0x68, 0x68, 0x19, 0xc9,
-	0x62, 0x7b, 0xf3, 0x29, 0xe4, 0xb4, 0xfe, 0x4b, 0x01, 0x4e, 0xca, 0xcc, 0x14, 0xe0, 0x1e, 0xe9,
-	0x68, 0xd9, 0x12, 0x78, 0x19, 0x2d, 0xf6, 0x00, 0x33, 0x65, 0xd7, 0xc5, 0x75, 0x1e, 0xec, 0x90,
-	0xbd, 0x7d, 0xb4, 0x03, 0x59, 0xa1, 0x0b, 0xd1, 0x6c, 0x5f, 0xe4, 0x98, 0xcc, 0x34, 0xe7, 0x06,
-	0xac, 0x6a, 0xd0, 0x45, 0x09, 0x3a, 0x8f, 0x8a, 0x3d, 0xa0, 0x42, 0x55, 0xc6, 0x53, 0x6d, 0xc0,
-	0xa8, 0xd2, 0x45, 0xa8, 0xd8, 0x17, 0x30, 0x21, 0xb9, 0xcc, 0xd2, 0xc0, 0x75, 0x0d, 0x39, 0x27,
-	0x21, 0xa7, 0xd0, 0xa9, 0x1e, 0x48, 0xa5, 0xb4, 0x90, 0x07, 0x39, 0x2d, 0xb4, 0x90, 0x19, 0x0f,
-	0x95, 0x54, 0x5f, 0xe6, 0xd9, 0xc1, 0x4d, 0x26, 0x02, 0x2a, 0x49, 0xa0, 0x19, 0x34, 0x95, 0xf2,
-	0xf4, 0x6a, 0x22, 0x3e, 0x85, 0x42, 0x4c, 0x1a, 0x1d, 0x0a, 0x97, 0xc8, 0x2a, 0x45, 0x4f, 0x59,
-	0x0b, 0x12, 0x6c, 0x0e, 0x9d, 0xe9, 0x05, 0xd3, 0xb6, 0xa2, 0x56, 0x23, 0x1f, 0x72, 0xba, 0xd1,
-	0xa6, 0x5c, 0x98, 0xa4, 0xec, 0x4a, 0xb9, 0x30, 0x3d, 0x3d, 0x7a, 0x60, 0x7e, 0xaa, 0xb9, 0xf2,
-	0x0e, 0xda, 0x03, 0xe8, 0xb6, 0x80, 0x94, 0x92, 0xd6, 0xd7, 0xc7, 0x53, 0x4a, 0x5a, 0x7f, 0x0f,
-	0xb1, 0x2c, 0x89, 0x3b, 0x8b, 0xcc, 0x54, 0x5c, 0xd9, 0x88, 0x44, 0xa6, 0xba, 0x6f, 0xa4, 0xbe,
-	0xc9, 0x78, 0xa3, 0x49, 0x7d, 0x93, 0x89, 0x96, 0x33, 0x30, 0xd3, 0xa8, 0x0f, 0xa1, 0x2f, 0x0c,
-	0x18, 0x8f, 0x77, 0x07, 0x74, 0xae, 0x3f, 0x91, 0xfe, 0xc6, 0x63, 0x9e, 0x3f, 0xc2, 0xea, 0x88,
-	0x1a, 0x2e, 0x7b, 0x4e, 0xc5, 0x57, 0xd6, 0xce, 0x03, 0x39, 0xdc, 0x5f, 0xbb, 0xf6, 0xe4, 0x79,
-	0xd1, 0x78, 0xfa, 0xbc, 0x68, 0xfc, 0xfc, 0xbc, 0x68, 0x3c, 0x7a, 0x51, 0x1c, 0x7a, 0xfa, 0xa2,
-	0x38, 0xf4, 0xc3, 0x8b, 0xe2, 0xd0, 0x87, 0x0b, 0x31, 0x5d, 0xa0, 0xca, 0xf3, 0xba, 0xe8, 0xea,
-	0x51, 0xd4, 0x8e, 0x88, 0x5b, 0x1d, 0x95, 0x1a, 0xe4, 0x95, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xf0, 0xb1, 0xd3, 0x70, 0x29, 0x12, 0x00, 0x00,
+	// 1956 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xaf, 0x63, 0xc7, 0x76, 0xc6, 0x69, 0x9b, 0x4e, 0x93, 0x26, 0xd9, 0x26, 0x71, 0xb2, 0x69,
+	0x93, 0x34, 0xb4, 0xbb, 0x4d, 0x40, 0x20, 0x2a, 0x2a, 0x54, 0x5b, 0x6d, 0x29, 0xb4, 0xa5, 0x98,
+	0x80, 0x50, 0xa5, 0x6a, 0xb5, 0xb6, 0x27, 0xf6, 0x2a, 0xf6, 0xae, 0xd9, 0x5d, 0x07, 0x87, 0x12,
+	0x09, 0x01, 0x07, 0x2a, 0x0e, 0x54, 0x42, 0xc0, 0xb5, 0x27, 0x0e, 0xdc, 0xf8, 0x2f, 0x7a, 0xac,
+	0xc4, 0x05, 0x71, 0x48, 0x11, 0x70, 0x40, 0xdc, 0xe0, 0x82, 0xc4, 0x89, 0xf9, 0xf4, 0x8e, 0xbd,
+	0x6b, 0x3b, 0xfd, 0xba, 0x71, 0xb0, 0xbc, 0xf3, 0xe6, 0xcd, 0xfb, 0xbd, 0x79, 0xef, 0xcd, 0x9b,
+	0xf7, 0x06, 0x1c, 0x43, 0x7e, 0x55, 0x47, 0xdb, 0x75, 0x7d, 0x7b, 0x4d, 0x7f, 0xbf, 0x89, 0xdc,
+	0x1d, 0xad, 0xe1, 0x3a, 0xbe, 0x03, 0x01, 0xa6, 0x6b, 0x98, 0xae, 0x6d, 0xaf, 0x29, 0xab, 0x25,
+	0xc7, 0xab, 0x3b, 0x9e, 0x5e, 0x34, 0x3d, 0xc4, 0x98, 0x30, 0x77, 0x11, 0xf9, 0xe6, 0x9a, 0xde,
+	0x30, 0x2b, 0x96, 0x6d, 0xfa, 0x96, 0x63, 0xb3, 0x75, 0xca, 0x9c, 0xcc, 0x2b, 0xb8, 0x4a, 0x8e,
+	0x25, 0xe6, 0xc7, 0x25, 0x3c, 0x22, 0x9e, 0x51, 0x8f, 0x4a, 0x54, 0xbf, 0x25, 0x58, 0x2b, 0x4e,
+	0xc5, 0xa1, 0x9f, 0x3a, 0xf9, 0xe2, 0xd4, 0x99, 0x8a, 0xe3, 0x54, 0x6a, 0x48, 0x37, 0x1b, 0x96,
+	0x6e, 0xda, 0xb6, 0xe3, 0x53, 0x74, 0x8f, 0xcf, 0x66, 0xf9, 0x2c, 0x1d, 0x15, 0x9b, 0x9b, 0xba,
+	0x6f, 0xd5, 0x91, 0xe7, 0x9b, 0xf5, 0x06, 0x63, 0x50, 0x5f, 0x01, 0xc7, 0xde, 0x22, 0x3b, 0xb8,
+	0xe8, 0x57, 0x2f, 0x94, 0x4a, 0x4e, 0xd3, 0xf6, 0x0b, 0x08, 0x6f, 0xc9, 0xf3, 0xe1, 0x14, 0x48,
+	0x99, 0xe5, 0xb2, 0x8b, 0x3c, 0x6f, 0x2a, 0x36, 0x1f, 0x5b, 0x19, 0x29, 0x88, 0xe1, 0xb9, 0xf4,
+	0xe7, 0xf7, 0xb2, 0x07, 0xfe, 0xc0, 0x3f, 0x75, 0x13, 0x4c, 0x86, 0x56, 0x7b, 0x0d, 0x0c, 0x8f,
+	0xc8, 0xf2, 0xa2, 0x59, 0x33, 0xed, 0x12, 0x12, 0xcb, 0xf9, 0x10, 0x1e, 0x07, 0x23, 0x25, 0xa7,
+	0x8c, 0x8c, 0xaa, 0xe9, 0x55, 0xa7, 0x86, 0xe8, 0x5c, 0x9a, 0x10, 0x5e, 0xc3, 0x63, 0x38, 0x0e,
+	0x86, 0x6d, 0x87, 0x2c, 0x8a, 0xe3, 0x89, 0x44, 0x81, 0x0d, 0xd4, 0x57, 0xc1, 0x34, 0xc5, 0xb9,
+	0x6e, 0x15, 0x2d, 0xb7, 0xf9, 0x18, 0x8a, 0xee, 0x00, 0x25, 0x4a, 0x40, 0xa0, 0x6b, 0xb4, 0x04,
+	0xa8, 0x80, 0xb4, 0x47, 0x60, 0x88, 0x46, 0x43, 0x54, 0xa3, 0xf6, 0x18, 0x9e, 0x04, 0x87, 0x4c,
+	0x26, 0xc8, 0xb0, 0x9b, 0xf5, 0x22, 0x72, 0xb9, 0xce, 0x07, 0x39, 0xf5, 0x3a, 0x25, 0xaa, 0x6f,
+	0x80, 0x19, 0x0a, 0xfd, 0xae, 0x59, 0xb3, 0xca, 0xa6, 0xef, 0xb8, 0x5d, 0xea, 0x2f, 0x80, 0xd1,
+	0x12, 0xd6, 0xc2, 0xe8, 0xd4, 0x20, 0x43, 0x68, 0x17, 0x42, 0xfb, 0xf8, 0x22, 0x06, 0x66, 0x7b,
+	0x48, 0xe3, 0x7b, 0x59, 0x06, 0x87, 0x85, 0x56, 0x9d, 0x12, 0x85, 0xb2, 0x17, 0x9e, 0xde, 0xd6,
+	0x5e, 0x06, 0x47, 0xa9, 0x32, 0x39, 0xe6, 0xd9, 0x47, 0x71, 0xc8, 0x59, 0x30, 0xde, 0xb9, 0x74,
+	0x50, 0xd8, 0x60, 0x3b, 0x32, 0xb0, 0xb7, 0xf1, 0xa6, 0xcd, 0xca, 0x60, 0x30, 0x38, 0x06, 0xe2,
+	0x5b, 0x68, 0x87, 0x47, 0x18, 0xf9, 0x94, 0xe0, 0x4f, 0x73, 0xf8, 0xb6, 0x30, 0x0e, 0x8f, 0xc3,
+	0x6f, 0xdb, 0xac, 0x35, 0x05, 0x38, 0x1b, 0xa8, 0x2f, 0x82, 0x31, 0xca, 0x9d, 0xc7, 0x51, 0xfa,
+	0x28, 0x9b, 0x5c, 0x06, 0x47, 0xa4, 0x75, 0x1c, 0x02, 0x82, 0x04, 0x89, 0x76, 0xba, 0x6a, 0xb4,
+	0x40, 0xbf, 0xd5, 0x0f, 0x01, 0xa4, 0x8c, 0x1b, 0xad, 0xab, 0x4e, 0xc5, 0x13, 0x10, 0x98, 0x93,
+	0x9e, 0x11, 0x26, 0x9f, 0x7e, 0xc3, 0x4b, 0x00, 0x04, 0x39, 0x86, 0xee, 0x2d, 0xb3, 0xbe, 0xa4,
+	0xb1, 0x24, 0xa3, 0x91, 0x24, 0xa3, 0xb1, 0xac, 0xc5, 0x53, 0x8d, 0x76, 0x23, 0x30, 0x55, 0x41,
+	0x5a, 0x29, 0x29, 0xf9, 0x69, 0x8c, 0x1b, 0x56, 0x80, 0x73, 0x3d, 0x17, 0x41, 0xa2, 0x86, 0xc7,
+	0x18, 0x3d, 0x8e, 0x31, 0x0e, 0x6b, 0x41, 0x02, 0xd4, 0x30, 0x5f, 0x81, 0x4e, 0xc2, 0xcb, 0x11,
+	0xea, 0x2c, 0x0f, 0x54, 0x87, 0x21, 0xc8, 0xfa, 0xa8, 0xe3, 0xdc, 0x02, 0x37, 0x4c, 0xd7, 0xac,
+	0x0b, 0x0b, 0xa8, 0x97, 0xb9, 0x6a, 0x82, 0xca, 0x55, 0x3b, 0x0b, 0x92, 0x0d, 0x4a, 0xa1, 0xa6,
+	0xc9, 0xac, 0x43, 0x59, 0x39, 0xc6, 0x9b, 0x4b, 0xdc, 0xdf, 0xcb, 0x1e, 0x28, 0x70, 0x3e, 0xf5,
+	0xce, 0x10, 0x38, 0x84, 0x93, 0x54, 0xde, 0xac, 0xd5, 0x24, 0xeb, 0x9a, 0x6e, 0xc5, 0x13, 0x7e,
+	0x20, 0xdf, 0x70, 0x12, 0xa4, 0x2a, 0xa6, 0x67, 0x94, 0xcc, 0x06, 0x3f, 0x12, 0x49, 0x3c, 0xcc,
+	0x9b, 0x0d, 0x78, 0x0b, 0x8c, 0xe1, 0x7c, 0xd9, 0x70, 0x3c, 0xe4, 0xb6, 0x8f, 0x15, 0x39, 0x12,
+	0xa3, 0xb9, 0xf5, 0x7f, 0xf7, 0xb2, 0x5a, 0xc5, 0xf2, 0xab, 0xcd, 0x22, 0xde, 0x77, 0x5d, 0xe7,
+	0xf9, 0x9e, 0xfd, 0x9d, 0xf1, 0xca, 0x5b, 0xba, 0xbf, 0xd3, 0x40, 0x9e, 0x96, 0x0f, 0xce, 0x73,
+	0xe1, 0xb0, 0x90, 0x25, 0xce, 0xe2, 0x34, 0x48, 0x97, 0xaa, 0xa6, 0x65, 0x1b, 0x56, 0x79, 0x2a,
+	0x81, 0xc5, 0xc6, 0x0b, 0x29, 0x3a, 0xbe, 0x52, 0x26, 0xe7, 0x19, 0xe7, 0x6b, 0x1f, 0x19, 0xce,
+	0x36, 0x72, 0x5d, 0xab, 0x8c, 0xbc, 0xa9, 0x61, 0xaa, 0xf1, 0x21, 0x4a, 0x7e, 0x53, 0x50, 0x09,
+	0x63, 0xb1, 0xe6, 0x94, 0xb6, 0x24, 0xc6, 0x24, 0x63, 0xa4, 0xe4, 0x36, 0x23, 0x8e, 0xca, 0xa3,
+	0x17, 0x3d, 0x7c, 0x0f, 0xe0, 0xd5, 0x97, 0xcd, 0xc0, 0xa8, 0xf8, 0xb8, 0xe0, 0xcd, 0x52, 0x73,
+	0x24, 0x0a, 0xe4, 0x53, 0xfd, 0x41, 0x24, 0x9b, 0xbc, 0x8b, 0x30, 0x33, 0xce, 0x34, 0x58, 0xd7,
+	0xab, 0x96, 0x17, 0x24, 0x9b, 0x9b, 0x20, 0x63, 0x52, 0xaa, 0x51, 0xc3, 0x64, 0x1e, 0x2a, 0x93,
+	0xb2, 0x37, 0xd8, 0xa2, 0x8d, 0x66, 0xa3, 0x86, 0x72, 0xf3, 0xc4, 0x25, 0x7f, 0xee, 0x65, 0x81,
+	0xd9, 0x96, 0xf4, 0xfd, 0xc3, 0x2c, 0x90, 0xe4, 0x4a, 0x33, 0xc4, 0x26, 0xc4, 0x17, 0x4d, 0x0f,
+	0x95, 0xb9, 0x33, 0x88, 0x6f, 0xde, 0xc1, 0x43, 0x32, 0xb5, 0x5d, 0x37, 0xf0, 0x7e, 0x1c, 0x96,
+	0x98, 0xf0, 0xe1, 0xdb, 0xae, 0x5f, 0x24, 0x43, 0xf5, 0xef, 0xb8, 0x88, 0x66, 0xd7, 0x2c, 0xa1,
+	0x8d, 0x96, 0xf0, 0xf6, 0x73, 0x20, 0x5e, 0xf7, 0x2a, 0x3c, 0x5e, 0xa6, 0x65, 0x0d, 0xaf, 0x79,
+	0x15, 0x1c, 0x19, 0xc8, 0x45, 0xcd, 0x3a, 0x66, 0x27, 0x5c, 0xf0, 0x1c, 0x18, 0xf5, 0xc9, 0x72,
+	0x03, 0x27, 0xe1, 0x4d, 0xab, 0x42, 0x31, 0xba, 0xf6, 0x45, 0xc5, 0xe7, 0xe9, 0x74, 0x21, 0xe3,
+	0x07, 0x03, 0x78, 0x1e, 0x8c, 0x36, 0x5c, 0x54, 0x46, 0x64, 0x1f, 0x8e, 0xeb, 0x61, 0x77, 0xc6,
+	0xfb, 0x23, 0x76, 0xb0, 0x93, 0xdb, 0x80, 0x79, 0x91, 0xe7, 0xdd, 0x61, 0x1a, 0x0d, 0x19, 0x4a,
+	0x63, 0x59, 0x17, 0xce, 0x02, 0xc0, 0x58, 0x68, 0x72, 0x48, 0xd2, 0xfd, 0x8f, 0x50, 0x0a, 0xbd,
+	0x41, 0xf3, 0x62, 0x9a, 0x5c, 0xf5, 0x53, 0x29, 0xaa, 0xba, 0xa2, 0xb1, 0x3a, 0x40, 0x13, 0x75,
+	0x80, 0xb6, 0x21, 0xea, 0x80, 0x5c, 0x9a, 0x78, 0xe5, 0xee, 0xc3, 0x6c, 0x8c, 0x0b, 0x21, 0x33,
+	0x91, 0xf1, 0x9e, 0x7e, 0x36, 0xf1, 0x3e, 0xd2, 0x19, 0xef, 0x2a, 0x38, 0xc8, 0xd4, 0xaf, 0x9b,
+	0x2d, 0x83, 0x04, 0x24, 0x90, 0x2c, 0x70, 0xcd, 0x6c, 0xe1, 0x90, 0x7d, 0x3d, 0x91, 0x1e, 0x1a,
+	0x8b, 0x17, 0xd2, 0x7e, 0xcb, 0xb0, 0xec, 0x32, 0x6a, 0xa9, 0xab, 0x3c, 0x9b, 0xb7, 0x7d, 0x1e,
+	0xa4, 0x5a, 0x7c, 0x47, 0x9a, 0xe2, 0x88, 0x93, 0x6f, 0xf5, 0xbb, 0x38, 0xaf, 0x78, 0x28, 0x73,
+	0x8e, 0x48, 0x95, 0x62, 0xc4, 0x6f, 0x89, 0x84, 0xd7, 0x2f, 0x46, 0x30, 0xd7, 0x13, 0xc5, 0xc8,
+	0xff, 0x4e, 0x1e, 0xec, 0x64, 0xf5, 0x0c, 0xaf, 0x2d, 0x65, 0x3f, 0xf5, 0xf1, 0xeb, 0x3f, 0x71,
+	0x30, 0x11, 0xf0, 0x3f, 0x76, 0xa2, 0x7f, 0x12, 0xb7, 0x46, 0xa4, 0xea, 0xc4, 0x7e, 0x53, 0xf5,
+	0x70, 0x54, 0xaa, 0x0e, 0x05, 0x4a, 0x72, 0x50, 0xa0, 0xa4, 0xfa, 0x07, 0x4a, 0xfa, 0xe9, 0x05,
+	0xca, 0xc8, 0xb3, 0x09, 0x14, 0x30, 0x20, 0x50, 0x32, 0xe1, 0x40, 0x39, 0x2d, 0x1f, 0x68, 0xe6,
+	0xf8, 0x3e, 0x71, 0xf2, 0xd7, 0x10, 0xbf, 0xd4, 0xae, 0xd8, 0x3e, 0x72, 0xeb, 0xa8, 0x6c, 0x61,
+	0xd7, 0x14, 0x1c, 0xc7, 0xf7, 0x1e, 0x2b, 0x0d, 0x74, 0x7b, 0x68, 0x68, 0x90, 0x87, 0xe2, 0xfd,
+	0x3d, 0x94, 0x78, 0x7a, 0x1e, 0x1a, 0x7e, 0x36, 0x1e, 0x4a, 0x0e, 0xf0, 0x50, 0x2a, 0xec, 0xa1,
+	0xeb, 0x60, 0xae, 0x97, 0xc9, 0x83, 0xba, 0xdb, 0x25, 0x04, 0x6a, 0x75, 0x5c, 0x77, 0xd3, 0x01,
+	0x3c, 0x06, 0x92, 0xf4, 0x92, 0xf7, 0xb0, 0x59, 0x09, 0x99, 0x8f, 0xd4, 0x89, 0x76, 0xdf, 0xe1,
+	0xa1, 0x4b, 0x48, 0xd4, 0xb7, 0xea, 0xd5, 0x76, 0x4f, 0xc1, 0xc9, 0x5c, 0xf8, 0x0b, 0x20, 0x4d,
+	0x4a, 0x51, 0x63, 0x13, 0xf1, 0xba, 0x3e, 0x37, 0xfd, 0xf3, 0x5e, 0x76, 0x82, 0x99, 0x00, 0x5b,
+	0x40, 0xb3, 0x1c, 0x1d, 0x57, 0x43, 0x55, 0x0d, 0xeb, 0x47, 0xfa, 0x0d, 0xba, 0x5a, 0x3d, 0x07,
+	0xa6, 0x58, 0x58, 0x39, 0x5b, 0xc8, 0xbe, 0x66, 0x36, 0x1a, 0x96, 0x5d, 0x11, 0x21, 0x82, 0xd5,
+	0xf5, 0x09, 0x59, 0xb4, 0x09, 0x74, 0x20, 0xd5, 0xd4, 0xef, 0xf1, 0x7e, 0xb5, 0x73, 0x2d, 0x57,
+	0x67, 0x0d, 0x8c, 0x6c, 0x36, 0x6d, 0x23, 0x10, 0x90, 0x59, 0x1f, 0x97, 0xa3, 0xec, 0x52, 0xd3,
+	0xa6, 0xeb, 0x0a, 0xe9, 0x4d, 0xfe, 0x25, 0x49, 0xce, 0x76, 0x94, 0x64, 0x82, 0x55, 0x32, 0xc2,
+	0xb7, 0x31, 0x6e, 0xec, 0x08, 0x0e, 0xae, 0xc0, 0x2d, 0x10, 0x67, 0xa6, 0x60, 0x01, 0x2e, 0x57,
+	0xeb, 0xa2, 0x4e, 0xcf, 0x3b, 0x96, 0x9d, 0x3b, 0x4b, 0x22, 0x0d, 0x57, 0x68, 0x2b, 0xfb, 0x0a,
+	0x1f, 0xcb, 0xf6, 0x0a, 0x44, 0x2e, 0xf1, 0x5a, 0xb1, 0xe9, 0xda, 0xbc, 0x6c, 0x4b, 0x17, 0xf8,
+	0x68, 0xfd, 0xe3, 0x23, 0x60, 0x98, 0x6a, 0x06, 0x71, 0xcb, 0x01, 0x82, 0x27, 0x03, 0xa8, 0xca,
+	0xbb, 0x8f, 0x7e, 0x8d, 0x50, 0x16, 0xfb, 0xf2, 0xb0, 0x8d, 0xa9, 0xa7, 0x3f, 0xf9, 0xf1, 0xf7,
+	0xaf, 0x86, 0x96, 0xe0, 0x09, 0xdd, 0xa6, 0x7d, 0x7e, 0xfb, 0x61, 0xc5, 0xaf, 0x1a, 0xbc, 0x73,
+	0xd5, 0x6f, 0xf3, 0xe3, 0xb1, 0x0b, 0xbf, 0x8c, 0x81, 0x83, 0x1d, 0xef, 0x01, 0xf0, 0x64, 0x08,
+	0x24, 0xea, 0xc1, 0x41, 0x59, 0x1a, 0xc4, 0xc6, 0xd5, 0xd1, 0xa9, 0x3a, 0xa7, 0xe0, 0x72, 0x97,
+	0x3a, 0x6c, 0x14, 0xa1, 0xd1, 0xbd, 0x18, 0x18, 0xeb, 0x6e, 0xec, 0xe1, 0x4a, 0x08, 0xad, 0xc7,
+	0x4b, 0x82, 0x72, 0x6a, 0x1f, 0x9c, 0x5c, 0xb5, 0x97, 0xa8, 0x6a, 0x6b, 0x50, 0xef, 0x52, 0x6d,
+	0x5b, 0x2c, 0x08, 0xb4, 0x93, 0x1f, 0x27, 0x76, 0xe1, 0x07, 0x20, 0xc5, 0x5b, 0x76, 0x98, 0x0d,
+	0xc1, 0x75, 0xbe, 0x03, 0x28, 0xf3, 0xbd, 0x19, 0xb8, 0x1a, 0xa7, 0xa8, 0x1a, 0x8b, 0x70, 0xa1,
+	0x4b, 0x0d, 0xde, 0xf3, 0x7b, 0x92, 0x6d, 0x3e, 0x02, 0x29, 0xde, 0xac, 0x47, 0x00, 0x77, 0xbe,
+	0x09, 0x44, 0x00, 0x77, 0xf5, 0xf9, 0xaa, 0x46, 0x81, 0x57, 0xe0, 0x52, 0x17, 0xb0, 0xc7, 0xf8,
+	0x02, 0x5c, 0xfd, 0xf6, 0x16, 0xda, 0xd9, 0x85, 0x5b, 0x20, 0x41, 0x9a, 0x78, 0x38, 0x13, 0x92,
+	0x2c, 0xbd, 0x09, 0x28, 0xb3, 0x3d, 0x66, 0x39, 0xe8, 0x12, 0x05, 0x9d, 0x87, 0x73, 0x5d, 0xa0,
+	0xe4, 0x09, 0x40, 0xde, 0x6a, 0x15, 0x24, 0x59, 0x13, 0x0b, 0xe7, 0x42, 0x02, 0x3b, 0xfa, 0x63,
+	0x25, 0xdb, 0x73, 0x9e, 0x43, 0xce, 0x52, 0xc8, 0x49, 0x38, 0xd1, 0x05, 0xc9, 0xda, 0x62, 0x68,
+	0x81, 0x14, 0xef, 0x8a, 0xa1, 0x22, 0x8b, 0xea, 0x6c, 0x95, 0x95, 0x85, 0xde, 0x97, 0xa0, 0x00,
+	0xca, 0x52, 0xa0, 0x69, 0x38, 0x19, 0x71, 0xf4, 0x4a, 0x44, 0xbe, 0x03, 0x32, 0x52, 0xd7, 0xd9,
+	0x17, 0xae, 0x63, 0x57, 0x11, 0xad, 0xaa, 0xba, 0x48, 0xc1, 0x66, 0xe1, 0xf1, 0x6e, 0x30, 0xce,
+	0x4b, 0xee, 0x21, 0xf8, 0x19, 0x3e, 0x4c, 0xdd, 0x8d, 0x6b, 0x5f, 0xd8, 0xf0, 0xf1, 0xe9, 0xd5,
+	0xf7, 0xf6, 0x8c, 0xdb, 0x12, 0x5d, 0x60, 0x48, 0x3d, 0x31, 0xac, 0x83, 0x14, 0x6f, 0x4b, 0x22,
+	0xe2, 0xb6, 0xb3, 0x49, 0x8d, 0x88, 0xdb, 0xae, 0x8e, 0xa6, 0xa7, 0x99, 0x59, 0xcd, 0xea, 0xb7,
+	0xe0, 0x0e, 0x00, 0x41, 0xc1, 0x1c, 0x91, 0x59, 0x43, 0x5d, 0x4f, 0x44, 0x66, 0x0d, 0x57, 0xdc,
+	0xaa, 0x4a, 0x71, 0x67, 0xa0, 0x12, 0x89, 0x4b, 0xef, 0x7a, 0xd8, 0x04, 0x23, 0xed, 0x12, 0x0c,
+	0x2e, 0x44, 0x4b, 0x95, 0xed, 0xad, 0xf6, 0x63, 0xe1, 0xb8, 0x0b, 0x14, 0xf7, 0x38, 0x9c, 0x8e,
+	0xc4, 0xa5, 0x81, 0xf5, 0x75, 0x0c, 0x1c, 0x09, 0x15, 0x16, 0x30, 0xec, 0xcc, 0x5e, 0xf5, 0x9e,
+	0xb2, 0xba, 0x1f, 0xd6, 0x01, 0x8e, 0xb7, 0xa4, 0x15, 0x06, 0x2b, 0x5e, 0xea, 0x24, 0x53, 0xd2,
+	0x52, 0x22, 0x32, 0x53, 0xca, 0x95, 0x4b, 0x64, 0xa6, 0xec, 0xa8, 0x61, 0x7a, 0x3a, 0x5e, 0x14,
+	0x36, 0xf0, 0x4e, 0x0c, 0x8c, 0xca, 0xe5, 0x06, 0x3c, 0x11, 0x36, 0x6f, 0xb8, 0x92, 0x51, 0x4e,
+	0x0e, 0xe0, 0x1a, 0x70, 0xb3, 0xd2, 0x22, 0x06, 0x97, 0x7e, 0x94, 0x5b, 0xbf, 0x4d, 0x87, 0xbb,
+	0xf0, 0x1b, 0xec, 0x92, 0x50, 0xf9, 0x01, 0x7b, 0x9d, 0xaf, 0x70, 0x11, 0x13, 0xe1, 0x92, 0x9e,
+	0xd5, 0x8c, 0xba, 0x4a, 0x55, 0x3b, 0x01, 0xd5, 0xe8, 0xb3, 0x88, 0x6b, 0x28, 0xa6, 0x24, 0x36,
+	0x52, 0xee, 0xfc, 0xfd, 0x5f, 0xe7, 0x62, 0x0f, 0xf0, 0xef, 0x17, 0xfc, 0xbb, 0xfb, 0xdb, 0xdc,
+	0x81, 0x07, 0xf8, 0xf7, 0x13, 0xfe, 0xdd, 0x5c, 0x94, 0x6a, 0x1c, 0x76, 0x9b, 0xe7, 0x49, 0x81,
+	0x2b, 0x64, 0xb6, 0x88, 0xd4, 0x62, 0x92, 0x96, 0xe3, 0xcf, 0xff, 0x07, 0x6b, 0x57, 0xd4, 0x8a,
+	0x25, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1543,14 +2098,25 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*QueryCreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
+	// IntermediateRoots implements the `debug_intermediateRoots` rpc api. It
+	// replays the EVM txs of a block and returns a state commitment after each
+	// of them.
+	IntermediateRoots(ctx context.Context, in *QueryIntermediateRootsRequest, opts ...grpc.CallOption) (*QueryIntermediateRootsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// Similar to feemarket module's method
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	TokenMapping(ctx context.Context, in *QueryTokenMappingRequest, opts ...grpc.CallOption) (*QueryTokenMappingResponse, error)
+	// CreateFunTokenFee queries the fee to register a new FunToken mapping with
+	// "MsgCreateFunToken".
+	CreateFunTokenFee(ctx context.Context, in *QueryCreateFunTokenFeeRequest, opts ...grpc.CallOption) (*QueryCreateFunTokenFeeResponse, error)
 }
 
 type queryClient struct {
@@ -1642,6 +2208,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*QueryCreateAccessListResponse, error) {
+	out := new(QueryCreateAccessListResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/CreateAccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1660,6 +2235,24 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntermediateRoots(ctx context.Context, in *QueryIntermediateRootsRequest, opts ...grpc.CallOption) (*QueryIntermediateRootsResponse, error) {
+	out := new(QueryIntermediateRootsResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/IntermediateRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/BaseFee", in, out, opts...)
@@ -1678,6 +2271,15 @@ func (c *queryClient) TokenMapping(ctx context.Context, in *QueryTokenMappingReq
 	return out, nil
 }
 
+func (c *queryClient) CreateFunTokenFee(ctx context.Context, in *QueryCreateFunTokenFeeRequest, opts ...grpc.CallOption) (*QueryCreateFunTokenFeeResponse, error) {
+	out := new(QueryCreateFunTokenFeeResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/CreateFunTokenFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EthAccount queries an Ethereum account.
@@ -1700,14 +2302,25 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(context.Context, *EthCallRequest) (*QueryCreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
+	// IntermediateRoots implements the `debug_intermediateRoots` rpc api. It
+	// replays the EVM txs of a block and returns a state commitment after each
+	// of them.
+	IntermediateRoots(context.Context, *QueryIntermediateRootsRequest) (*QueryIntermediateRootsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// Similar to feemarket module's method
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	TokenMapping(context.Context, *QueryTokenMappingRequest) (*QueryTokenMappingResponse, error)
+	// CreateFunTokenFee queries the fee to register a new FunToken mapping with
+	// "MsgCreateFunToken".
+	CreateFunTokenFee(context.Context, *QueryCreateFunTokenFeeRequest) (*QueryCreateFunTokenFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1741,18 +2354,30 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) CreateAccessList(ctx context.Context, req *EthCallRequest) (*QueryCreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
+func (*UnimplementedQueryServer) IntermediateRoots(ctx context.Context, req *QueryIntermediateRootsRequest) (*QueryIntermediateRootsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntermediateRoots not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
 func (*UnimplementedQueryServer) TokenMapping(ctx context.Context, req *QueryTokenMappingRequest) (*QueryTokenMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenMapping not implemented")
 }
+func (*UnimplementedQueryServer) CreateFunTokenFee(ctx context.Context, req *QueryCreateFunTokenFeeRequest) (*QueryCreateFunTokenFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFunTokenFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1920,6 +2545,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/CreateAccessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -1956,6 +2599,42 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntermediateRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntermediateRootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntermediateRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/IntermediateRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntermediateRoots(ctx, req.(*QueryIntermediateRootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -1992,6 +2671,24 @@ func _Query_TokenMapping_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CreateFunTokenFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreateFunTokenFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateFunTokenFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/CreateFunTokenFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateFunTokenFee(ctx, req.(*QueryCreateFunTokenFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "eth.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2032,6 +2729,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _Query_CreateAccessList_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2040,6 +2741,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
+		{
+			MethodName: "IntermediateRoots",
+			Handler:    _Query_IntermediateRoots_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -2048,6 +2757,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TokenMapping",
 			Handler:    _Query_TokenMapping_Handler,
 		},
+		{
+			MethodName: "CreateFunTokenFee",
+			Handler:    _Query_CreateFunTokenFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/query.proto",
@@ -2619,6 +3332,20 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StateOverrides) > 0 {
+		i -= len(m.StateOverrides)
+		copy(dAtA[i:], m.StateOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateOverrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2674,6 +3401,55 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCreateAccessListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreateAccessListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreateAccessListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2918,7 +3694,7 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2928,20 +3704,95 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x42
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StateOverrides) > 0 {
+		i -= len(m.StateOverrides)
+		copy(dAtA[i:], m.StateOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateOverrides)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2951,32 +3802,27 @@ func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		{
-			size := m.BaseFee.Size()
-			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenMappingRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryIntermediateRootsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2986,27 +3832,71 @@ func (m *QueryTokenMappingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTokenMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIntermediateRootsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokenMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIntermediateRootsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x38
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenMappingResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryIntermediateRootsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3016,53 +3906,252 @@ func (m *QueryTokenMappingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTokenMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIntermediateRootsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokenMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIntermediateRootsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FunToken != nil {
-		{
-			size, err := m.FunToken.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Roots) > 0 {
+		for iNdEx := len(m.Roots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roots[iNdEx])
+			copy(dAtA[i:], m.Roots[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Roots[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryEthAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FunToken != nil {
+		{
+			size, err := m.FunToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreateFunTokenFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreateFunTokenFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreateFunTokenFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreateFunTokenFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreateFunTokenFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreateFunTokenFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Burned {
+		i--
+		if m.Burned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEthAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryEthAccountResponse) Size() (n int) {
@@ -3307,6 +4396,14 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.StateOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3322,6 +4419,28 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCreateAccessListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3428,23 +4547,138 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
+func (m *QueryTraceCallRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StateOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
 	return n
 }
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
+func (m *QueryTraceCallResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediateRootsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryIntermediateRootsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roots) > 0 {
+		for _, s := range m.Roots {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -3476,6 +4710,33 @@ func (m *QueryTokenMappingResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCreateFunTokenFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCreateFunTokenFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Burned {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5133,6 +6394,74 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateOverrides = append(m.StateOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateOverrides == nil {
+				m.StateOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5223,7 +6552,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCreateAccessListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5246,15 +6575,15 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCreateAccessListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCreateAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5281,18 +6610,35 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccessList = append(m.AccessList, AccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5302,31 +6648,77 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5346,19 +6738,898 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &MsgEthereumTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateOverrides = append(m.StateOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateOverrides == nil {
+				m.StateOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
@@ -5377,7 +7648,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
@@ -5409,7 +7680,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
@@ -5442,7 +7713,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -5476,7 +7747,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -5495,7 +7766,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
+		case 11:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
 			}
@@ -5535,7 +7806,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5558,10 +7829,10 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5619,7 +7890,7 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIntermediateRootsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5642,10 +7913,10 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntermediateRootsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntermediateRootsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5682,43 +7953,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
@@ -5737,7 +7972,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
@@ -5769,7 +8004,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
@@ -5802,7 +8037,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -5836,7 +8071,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -5855,7 +8090,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
 			}
@@ -5895,7 +8130,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIntermediateRootsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5918,17 +8153,17 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntermediateRootsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntermediateRootsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5938,25 +8173,55 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Roots = append(m.Roots, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6283,6 +8548,160 @@ func (m *QueryTokenMappingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCreateFunTokenFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreateFunTokenFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreateFunTokenFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreateFunTokenFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreateFunTokenFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreateFunTokenFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,56 @@
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding evm type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch collections.Namespace(kvA.Key[0]) {
+		case evm.KeyPrefixAccCodes:
+			return fmt.Sprintf("%x\n%x", kvA.Value, kvB.Value)
+		case evm.KeyPrefixAccState:
+			return fmt.Sprintf("%s\n%s", gethcommon.BytesToHash(kvA.Value).Hex(), gethcommon.BytesToHash(kvB.Value).Hex())
+		case evm.KeyPrefixParams:
+			var paramsA, paramsB evm.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case evm.KeyPrefixEthAddrIndex:
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+		case evm.KeyPrefixFunTokens:
+			var funtokenA, funtokenB evm.FunToken
+			cdc.MustUnmarshal(kvA.Value, &funtokenA)
+			cdc.MustUnmarshal(kvB.Value, &funtokenB)
+			return fmt.Sprintf("%v\n%v", funtokenA, funtokenB)
+		case evm.KeyPrefixFunTokenIdxErc20, evm.KeyPrefixFunTokenIdxBankDenom:
+			// Index entries only have keys.
+			return fmt.Sprintf("%x\n%x", kvA.Key[1:], kvB.Key[1:])
+		case evm.KeyPrefixBaseFee:
+			return fmt.Sprintf(
+				"%v\n%v",
+				eth.ValueEncoderBigInt.Decode(kvA.Value),
+				eth.ValueEncoderBigInt.Decode(kvB.Value),
+			)
+		case evm.KeyPrefixLastBlockGasUsed:
+			return fmt.Sprintf(
+				"%v\n%v",
+				collections.Uint64ValueEncoder.Decode(kvA.Value),
+				collections.Uint64ValueEncoder.Decode(kvB.Value),
+			)
+		default:
+			panic(fmt.Sprintf("invalid evm key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
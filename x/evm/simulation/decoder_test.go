@@ -0,0 +1,72 @@
+package simulation_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm"
+	sim "github.com/NibiruChain/nibiru/x/evm/simulation"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Codec
+	dec := sim.NewDecodeStore(cdc)
+
+	code := []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+	stateValue := gethcommon.HexToHash("0x2a")
+	params := evm.DefaultParams()
+	ethAddr := gethcommon.HexToAddress("0x000000000000000000000000000000000000dead")
+	funtoken := evm.NewFunToken(ethAddr, "unibi", true)
+	baseFee := big.NewInt(1_000)
+	gasUsed := uint64(21_000)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: evm.KeyPrefixAccCodes.Prefix(), Value: code},
+			{Key: evm.KeyPrefixAccState.Prefix(), Value: stateValue.Bytes()},
+			{Key: evm.KeyPrefixParams.Prefix(), Value: cdc.MustMarshal(&params)},
+			{Key: evm.KeyPrefixEthAddrIndex.Prefix(), Value: ethAddr.Bytes()},
+			{Key: evm.KeyPrefixFunTokens.Prefix(), Value: cdc.MustMarshal(&funtoken)},
+			{Key: append(evm.KeyPrefixFunTokenIdxErc20.Prefix(), ethAddr.Bytes()...), Value: []byte{}},
+			{Key: evm.KeyPrefixBaseFee.Prefix(), Value: eth.ValueEncoderBigInt.Encode(baseFee)},
+			{Key: evm.KeyPrefixLastBlockGasUsed.Prefix(), Value: collections.Uint64ValueEncoder.Encode(gasUsed)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"ContractBytecode", fmt.Sprintf("%x\n%x", code, code)},
+		{"AccState", fmt.Sprintf("%s\n%s", stateValue.Hex(), stateValue.Hex())},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"EthAddrIndex", fmt.Sprintf("%v\n%v", sdk.AccAddress(ethAddr.Bytes()), sdk.AccAddress(ethAddr.Bytes()))},
+		{"FunTokens", fmt.Sprintf("%v\n%v", funtoken, funtoken)},
+		{"FunTokenIdxErc20", fmt.Sprintf("%x\n%x", ethAddr.Bytes(), ethAddr.Bytes())},
+		{"BaseFee", fmt.Sprintf("%v\n%v", baseFee, baseFee)},
+		{"LastBlockGasUsed", fmt.Sprintf("%v\n%v", gasUsed, gasUsed)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
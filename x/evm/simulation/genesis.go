@@ -0,0 +1,105 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// Simulation parameter constants
+const (
+	createFuntokenFeeKey        = "create_funtoken_fee"
+	burnCreateFuntokenFeeKey    = "burn_create_funtoken_fee"
+	baseFeeChangeDenominatorKey = "base_fee_change_denominator"
+	elasticityMultiplierKey     = "elasticity_multiplier"
+	minBaseFeeKey               = "min_base_fee"
+)
+
+// GenCreateFuntokenFee randomized CreateFuntokenFee
+func GenCreateFuntokenFee(r *rand.Rand) sdkmath.Int {
+	return sdkmath.NewInt(int64(r.Intn(1_000)))
+}
+
+// GenBurnCreateFuntokenFee randomized BurnCreateFuntokenFee
+func GenBurnCreateFuntokenFee(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenBaseFeeChangeDenominator randomized BaseFeeChangeDenominator
+func GenBaseFeeChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(16))
+}
+
+// GenElasticityMultiplier randomized ElasticityMultiplier
+func GenElasticityMultiplier(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(4))
+}
+
+// GenMinBaseFee randomized MinBaseFee
+func GenMinBaseFee(r *rand.Rand) sdkmath.Int {
+	return sdkmath.NewInt(int64(r.Intn(10)))
+}
+
+// RandomizedGenState generates a random GenesisState for the evm module. The
+// EVM denom is the default bond denom, since the simulation accounts only hold
+// bond denom coins to pay for gas.
+func RandomizedGenState(simState *module.SimulationState) {
+	var createFuntokenFee sdkmath.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, createFuntokenFeeKey, &createFuntokenFee, simState.Rand,
+		func(r *rand.Rand) { createFuntokenFee = GenCreateFuntokenFee(r) },
+	)
+
+	var burnCreateFuntokenFee bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, burnCreateFuntokenFeeKey, &burnCreateFuntokenFee, simState.Rand,
+		func(r *rand.Rand) { burnCreateFuntokenFee = GenBurnCreateFuntokenFee(r) },
+	)
+
+	var baseFeeChangeDenominator uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, baseFeeChangeDenominatorKey, &baseFeeChangeDenominator, simState.Rand,
+		func(r *rand.Rand) { baseFeeChangeDenominator = GenBaseFeeChangeDenominator(r) },
+	)
+
+	var elasticityMultiplier uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, elasticityMultiplierKey, &elasticityMultiplier, simState.Rand,
+		func(r *rand.Rand) { elasticityMultiplier = GenElasticityMultiplier(r) },
+	)
+
+	var minBaseFee sdkmath.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, minBaseFeeKey, &minBaseFee, simState.Rand,
+		func(r *rand.Rand) { minBaseFee = GenMinBaseFee(r) },
+	)
+
+	params := evm.DefaultParams()
+	params.EvmDenom = sdk.DefaultBondDenom
+	params.CreateFuntokenFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, createFuntokenFee))
+	params.BurnCreateFuntokenFee = burnCreateFuntokenFee
+	params.BaseFeeChangeDenominator = baseFeeChangeDenominator
+	params.ElasticityMultiplier = elasticityMultiplier
+	params.MinBaseFee = minBaseFee
+
+	evmGenesis := evm.GenesisState{
+		Accounts:         []evm.GenesisAccount{},
+		Params:           params,
+		FuntokenMappings: []evm.FunToken{},
+	}
+
+	bz, err := json.MarshalIndent(&evmGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated x/evm parameters:\n%s\n", bz)
+	simState.GenState[evm.ModuleName] = simState.Cdc.MustMarshalJSON(&evmGenesis)
+}
@@ -0,0 +1,482 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/CosmWasm/wasmd/app/params"
+	"github.com/NibiruChain/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	helpers "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/eth/crypto/ethsecp256k1"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgEthereumTxTransfer     = "op_weight_msg_ethereum_tx_transfer"
+	OpWeightMsgEthereumTxDeployERC20  = "op_weight_msg_ethereum_tx_deploy_erc20"
+	OpWeightMsgCreateFunTokenFromCoin = "op_weight_msg_create_funtoken_from_coin"
+	OpWeightMsgSendFunTokenToEvm      = "op_weight_msg_send_funtoken_to_evm"
+
+	gasLimitTransfer uint64 = 21_000
+	gasLimitDeploy   uint64 = 3_000_000
+	gasLimitCall     uint64 = 500_000
+)
+
+var (
+	typeMsgCreateFunToken    = sdk.MsgTypeURL(&evm.MsgCreateFunToken{})
+	typeMsgSendFunTokenToEvm = sdk.MsgTypeURL(&evm.MsgSendFunTokenToEvm{})
+
+	// protoCdc: JSON codec for the logs of the simulation operations. The
+	// FunToken msgs don't hold any "Any" fields, so an empty interface
+	// registry is enough.
+	protoCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak evm.AccountKeeper,
+	bk evm.BankKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgEthereumTxTransfer     int
+		weightMsgEthereumTxDeployERC20  int
+		weightMsgCreateFunTokenFromCoin int
+		weightMsgSendFunTokenToEvm      int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgEthereumTxTransfer, &weightMsgEthereumTxTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgEthereumTxTransfer = params.DefaultWeightMsgSend
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgEthereumTxDeployERC20, &weightMsgEthereumTxDeployERC20, nil,
+		func(_ *rand.Rand) {
+			weightMsgEthereumTxDeployERC20 = params.DefaultWeightMsgDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateFunTokenFromCoin, &weightMsgCreateFunTokenFromCoin, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateFunTokenFromCoin = params.DefaultWeightMsgDelegate / 10
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendFunTokenToEvm, &weightMsgSendFunTokenToEvm, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendFunTokenToEvm = params.DefaultWeightMsgSend
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgEthereumTxTransfer,
+			SimulateMsgEthereumTxTransfer(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgEthereumTxDeployERC20,
+			SimulateMsgEthereumTxDeployERC20(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateFunTokenFromCoin,
+			SimulateMsgCreateFunTokenFromCoin(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSendFunTokenToEvm,
+			SimulateMsgSendFunTokenToEvm(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgEthereumTxTransfer generates a MsgEthereumTx that transfers a
+// random amount of gas tokens between the Ethereum accounts of two
+// simulation accounts.
+func SimulateMsgEthereumTxTransfer(ak evm.AccountKeeper, bk evm.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		sender, err := newEthAccount(simAccount)
+		if err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, "unable to derive eth key"), nil, err
+		}
+		to, err := newEthAccount(recipient)
+		if err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, "unable to derive eth key"), nil, err
+		}
+
+		value := big.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000)))
+		comment, err := sendEthTx(r, app, ctx, ak, bk, k, sender, &to.addr, value, gasLimitTransfer, nil, chainID)
+		if comment != "" || err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, comment), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(evm.RouterKey, evm.TypeMsgEthereumTx, "transfer", true, nil), nil, nil
+	}
+}
+
+// SimulateMsgEthereumTxDeployERC20 generates a MsgEthereumTx that deploys the
+// embedded ERC20Minter contract, and a second one that mints a random supply
+// to the deployer. The deployer then registers a FunToken mapping for the
+// ERC20 in a future operation.
+func SimulateMsgEthereumTxDeployERC20(ak evm.AccountKeeper, bk evm.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		deployer, err := newEthAccount(simAccount)
+		if err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, "unable to derive eth key"), nil, err
+		}
+
+		contract := embeds.Contract_ERC20Minter
+		symbol := simtypes.RandStringOfLength(r, 4)
+		packedArgs, err := contract.ABI.Pack("", "sim "+symbol, symbol, uint8(simtypes.RandIntBetween(r, 0, 19)))
+		if err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, "unable to pack constructor args"), nil, err
+		}
+		input := append(append([]byte{}, contract.Bytecode...), packedArgs...)
+
+		erc20 := crypto.CreateAddress(deployer.addr, k.GetAccNonce(ctx, deployer.addr))
+		comment, err := sendEthTx(r, app, ctx, ak, bk, k, deployer, nil, big.NewInt(0), gasLimitDeploy, input, chainID)
+		if comment != "" || err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, comment), nil, err
+		}
+		if acct := k.GetAccount(ctx, erc20); acct == nil || bytes.Equal(acct.CodeHash, evm.EmptyCodeHash) {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, "contract deployment reverted"), nil, nil
+		}
+
+		supply := new(big.Int).Mul(
+			big.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000))), big.NewInt(1_000_000),
+		)
+		input, err = contract.ABI.Pack("mint", deployer.addr, supply)
+		if err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, "unable to pack mint args"), nil, err
+		}
+		comment, err = sendEthTx(r, app, ctx, ak, bk, k, deployer, &erc20, big.NewInt(0), gasLimitCall, input, chainID)
+		if comment != "" || err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, comment), nil, err
+		}
+
+		futureOps := []simtypes.FutureOperation{{
+			BlockHeight: int(ctx.BlockHeight()) + 1,
+			Op:          SimulateMsgCreateFunTokenFromErc20(ak, bk, k, simAccount, erc20),
+		}}
+		return simtypes.NewOperationMsgBasic(evm.RouterKey, evm.TypeMsgEthereumTx, "deploy ERC20", true, nil), futureOps, nil
+	}
+}
+
+// SimulateMsgCreateFunTokenFromErc20 generates a MsgCreateFunToken for an
+// ERC20 deployed by [SimulateMsgEthereumTxDeployERC20]. The deployer then
+// sends part of its ERC20 balance as bank coins with the FunToken precompile
+// in a future operation.
+func SimulateMsgCreateFunTokenFromErc20(
+	ak evm.AccountKeeper, bk evm.BankKeeper, k *keeper.Keeper,
+	simAccount simtypes.Account, erc20 gethcommon.Address,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		erc20Addr := eth.NewHexAddr(erc20)
+		msg := &evm.MsgCreateFunToken{
+			FromErc20: &erc20Addr,
+			Sender:    simAccount.Address.String(),
+		}
+
+		comment, err := deliverCosmosTx(r, app, ctx, ak, bk, simAccount, msg, k.GetParams(ctx).CreateFuntokenFee, chainID)
+		if comment != "" || err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, typeMsgCreateFunToken, comment), nil, err
+		}
+
+		futureOps := []simtypes.FutureOperation{{
+			BlockHeight: int(ctx.BlockHeight()) + 1,
+			Op:          SimulatePrecompileBankSend(ak, bk, k, simAccount, erc20),
+		}}
+		return simtypes.NewOperationMsg(msg, true, "", protoCdc), futureOps, nil
+	}
+}
+
+// SimulatePrecompileBankSend generates a MsgEthereumTx that calls
+// "IFunToken.bankSend" to send a random part of the ERC20 balance of the
+// Ethereum account of a simulation account to a random simulation account as
+// bank coins.
+func SimulatePrecompileBankSend(
+	ak evm.AccountKeeper, bk evm.BankKeeper, k *keeper.Keeper,
+	simAccount simtypes.Account, erc20 gethcommon.Address,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sender, err := newEthAccount(simAccount)
+		if err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, "unable to derive eth key"), nil, err
+		}
+		recipient, _ := simtypes.RandomAcc(r, accs)
+
+		cacheCtx, _ := ctx.CacheContext()
+		balance, err := k.ERC20().BalanceOf(erc20, sender.addr, cacheCtx)
+		if err != nil || balance.Sign() != 1 {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, "no ERC20 balance to send"), nil, nil
+		}
+		amount := simtypes.RandomAmount(r, sdkmath.NewIntFromBigInt(balance))
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, "zero amount"), nil, nil
+		}
+
+		input, err := embeds.Contract_Funtoken.ABI.Pack(
+			string(precompile.FunTokenMethod_BankSend), erc20, amount.BigInt(), recipient.Address.String(),
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, "unable to pack bankSend args"), nil, err
+		}
+		precompileAddr := precompile.PrecompileAddr_FuntokenGateway.ToAddr()
+		comment, err := sendEthTx(r, app, ctx, ak, bk, k, sender, &precompileAddr, big.NewInt(0), gasLimitCall, input, chainID)
+		if comment != "" || err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, evm.TypeMsgEthereumTx, comment), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(evm.RouterKey, evm.TypeMsgEthereumTx, "FunToken bankSend", true, nil), nil, nil
+	}
+}
+
+// SimulateMsgCreateFunTokenFromCoin generates a MsgCreateFunToken for a random
+// bank coin of a simulation account that has no FunToken mapping yet.
+func SimulateMsgCreateFunTokenFromCoin(ak evm.AccountKeeper, bk evm.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if spendable.Empty() {
+			return simtypes.NoOpMsg(evm.ModuleName, typeMsgCreateFunToken, "no coins"), nil, nil
+		}
+		bankDenom := spendable[r.Intn(len(spendable))].Denom
+		if funtokens := k.FunTokens.Collect(
+			ctx, k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, bankDenom),
+		); len(funtokens) > 0 {
+			return simtypes.NoOpMsg(evm.ModuleName, typeMsgCreateFunToken, "funtoken already exists"), nil, nil
+		}
+
+		// The simulation genesis doesn't hold any bank metadata, which a
+		// FunToken mapping requires for the ERC20 of the coin.
+		if _, found := bk.GetDenomMetaData(ctx, bankDenom); !found {
+			bk.SetDenomMetaData(ctx, banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnit{{Denom: bankDenom, Exponent: 0}},
+				Base:       bankDenom,
+				Display:    bankDenom,
+				Name:       bankDenom,
+				Symbol:     bankDenom,
+			})
+		}
+
+		msg := &evm.MsgCreateFunToken{
+			FromBankDenom: bankDenom,
+			Sender:        simAccount.Address.String(),
+		}
+		comment, err := deliverCosmosTx(r, app, ctx, ak, bk, simAccount, msg, k.GetParams(ctx).CreateFuntokenFee, chainID)
+		if comment != "" || err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, typeMsgCreateFunToken, comment), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", protoCdc), nil, nil
+	}
+}
+
+// SimulateMsgSendFunTokenToEvm generates a MsgSendFunTokenToEvm that converts
+// a random amount of a bank coin with a FunToken mapping to its ERC20.
+func SimulateMsgSendFunTokenToEvm(ak evm.AccountKeeper, bk evm.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		to, err := newEthAccount(recipient)
+		if err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, typeMsgSendFunTokenToEvm, "unable to derive eth key"), nil, err
+		}
+
+		var candidates []evm.FunToken
+		for _, funtoken := range k.FunTokens.Iterate(ctx, collections.Range[[]byte]{}).Values() {
+			if funtoken.IsMadeFromCoin && !funtoken.Paused &&
+				bk.SpendableCoins(ctx, simAccount.Address).AmountOf(funtoken.BankDenom).IsPositive() {
+				candidates = append(candidates, funtoken)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(evm.ModuleName, typeMsgSendFunTokenToEvm, "no bank coins with a funtoken"), nil, nil
+		}
+		funtoken := candidates[r.Intn(len(candidates))]
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(funtoken.BankDenom)
+		amount := simtypes.RandomAmount(r, spendable)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(evm.ModuleName, typeMsgSendFunTokenToEvm, "zero amount"), nil, nil
+		}
+
+		msg := &evm.MsgSendFunTokenToEvm{
+			Sender:    simAccount.Address.String(),
+			BankCoin:  sdk.NewCoin(funtoken.BankDenom, amount),
+			ToEthAddr: eth.NewHexAddr(to.addr),
+		}
+		comment, err := deliverCosmosTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(msg.BankCoin), chainID)
+		if comment != "" || err != nil {
+			return simtypes.NoOpMsg(evm.ModuleName, typeMsgSendFunTokenToEvm, comment), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", protoCdc), nil, nil
+	}
+}
+
+// ethAccount is the Ethereum account of a simulation account. The simulation
+// accounts have secp256k1 keys, and Ethereum derives a different address from
+// the same key than Cosmos does. So, each simulation account controls a
+// second account that signs its Ethereum txs.
+type ethAccount struct {
+	simAccount simtypes.Account
+	privKey    *ecdsa.PrivateKey
+	addr       gethcommon.Address
+}
+
+func newEthAccount(simAccount simtypes.Account) (ethAccount, error) {
+	privKey, err := (&ethsecp256k1.PrivKey{Key: simAccount.PrivKey.Bytes()}).ToECDSA()
+	if err != nil {
+		return ethAccount{}, err
+	}
+	return ethAccount{
+		simAccount: simAccount,
+		privKey:    privKey,
+		addr:       crypto.PubkeyToAddress(privKey.PublicKey),
+	}, nil
+}
+
+// sendEthTx signs and delivers an Ethereum tx from the Ethereum account of a
+// simulation account. The simulation account first sends the gas tokens that
+// the tx costs to its Ethereum account. A non-empty comment means that the
+// operation could not run.
+func sendEthTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	ak evm.AccountKeeper, bk evm.BankKeeper, k *keeper.Keeper,
+	from ethAccount, to *gethcommon.Address, value *big.Int, gasLimit uint64,
+	input []byte, chainID string,
+) (comment string, err error) {
+	evmDenom := k.GetParams(ctx).EvmDenom
+	gasPrice := new(big.Int).Add(k.GetBaseFee(ctx), big.NewInt(1))
+	cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit))
+	cost.Add(cost, value)
+
+	balance := bk.GetBalance(ctx, from.addr.Bytes(), evmDenom).Amount
+	if shortfall := sdkmath.NewIntFromBigInt(cost).Sub(balance); shortfall.IsPositive() {
+		msg := banktypes.NewMsgSend(
+			from.simAccount.Address, from.addr.Bytes(),
+			sdk.NewCoins(sdk.NewCoin(evmDenom, shortfall)),
+		)
+		// Bank sends can be disabled in the randomized genesis.
+		if err := bk.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+			return "unable to fund eth account: transfers disabled", nil
+		}
+		if comment, err := deliverCosmosTx(r, app, ctx, ak, bk, from.simAccount, msg, msg.Amount, chainID); comment != "" || err != nil {
+			return "unable to fund eth account: " + comment, err
+		}
+	}
+
+	ethTx, err := gethcore.SignTx(
+		gethcore.NewTx(&gethcore.LegacyTx{
+			Nonce:    k.GetAccNonce(ctx, from.addr),
+			GasPrice: gasPrice,
+			Gas:      gasLimit,
+			To:       to,
+			Value:    value,
+			Data:     input,
+		}),
+		gethcore.LatestSignerForChainID(k.EthChainID(ctx)),
+		from.privKey,
+	)
+	if err != nil {
+		return "unable to sign eth tx", err
+	}
+	msg := new(evm.MsgEthereumTx)
+	if err := msg.FromEthereumTx(ethTx); err != nil {
+		return "unable to build eth tx msg", err
+	}
+
+	txGen := testutil.MakeTestEncodingConfig().TxConfig
+	tx, err := msg.BuildTx(txGen.NewTxBuilder(), evmDenom)
+	if err != nil {
+		return "unable to build eth tx", err
+	}
+	_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return "unable to deliver tx", fmt.Errorf("%s: %w", evm.TypeMsgEthereumTx, err)
+	}
+	return "", nil
+}
+
+// deliverCosmosTx signs and delivers a tx with a single msg from a simulation
+// account, which pays random fees from the coins it doesn't "spend" in the
+// msg. A non-empty comment means that the operation could not run.
+func deliverCosmosTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	ak evm.AccountKeeper, bk evm.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, spent sdk.Coins, chainID string,
+) (comment string, err error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	if account == nil {
+		return "account not found", nil
+	}
+	coins, hasNeg := bk.SpendableCoins(ctx, simAccount.Address).SafeSub(spent...)
+	if hasNeg {
+		return "insufficient funds", nil
+	}
+	fees, err := simtypes.RandomFees(r, ctx, coins)
+	if err != nil {
+		return "unable to generate fees", err
+	}
+
+	txGen := testutil.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenSignedMockTx(
+		r,
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return "unable to generate mock tx", err
+	}
+
+	_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return "unable to deliver tx", err
+	}
+	return "", nil
+}
@@ -137,6 +137,13 @@ type (
 		address *common.Address
 		slot    *common.Hash
 	}
+
+	// Changes made by precompiled contracts
+	precompileCalledChange struct {
+		// prevObjects is the live set of state objects before the call. The
+		// dirty objects of the set are written to the precompile branch.
+		prevObjects map[common.Address]*stateObject
+	}
 )
 
 func (ch createObjectChange) Revert(s *StateDB) {
@@ -239,3 +246,12 @@ func (ch accessListAddSlotChange) Revert(s *StateDB) {
 func (ch accessListAddSlotChange) Dirtied() *common.Address {
 	return nil
 }
+
+func (ch precompileCalledChange) Revert(s *StateDB) {
+	s.precompileCtxs = s.precompileCtxs[:len(s.precompileCtxs)-1]
+	s.stateObjects = ch.prevObjects
+}
+
+func (ch precompileCalledChange) Dirtied() *common.Address {
+	return nil
+}
@@ -51,6 +51,27 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+
+	// precompileCtxs is the stack of branches of "ctx" that hold the state
+	// written by state-changing precompile calls. Each branch is a cache over
+	// the previous one, so the last element holds the latest state. The
+	// branches are journaled, which lets a revert of the EVM frame discard the
+	// writes of the precompiles called inside of it.
+	precompileCtxs []precompileCtx
+
+	// committedStorage caches the storage of "ctx", which holds the state
+	// from before the transaction, once a state-changing precompile has been
+	// called. The state objects are then loaded from the precompile branches,
+	// so their origin storage no longer holds the committed values that SSTORE
+	// is priced with (EIP-2200, EIP-3529).
+	committedStorage map[common.Address]Storage
+}
+
+// precompileCtx is a branch of the EVM transaction context created for a
+// single precompile call.
+type precompileCtx struct {
+	ctx   sdk.Context
+	write func()
 }
 
 // New creates a new state from a given trie.
@@ -76,6 +97,50 @@ func (s *StateDB) GetContext() sdk.Context {
 	return s.ctx
 }
 
+// latestCtx returns the context that holds the latest state: the newest
+// precompile branch if a state-changing precompile has been called, otherwise
+// the transaction context.
+func (s *StateDB) latestCtx() sdk.Context {
+	if n := len(s.precompileCtxs); n > 0 {
+		return s.precompileCtxs[n-1].ctx
+	}
+	return s.ctx
+}
+
+// CacheCtxForPrecompile returns a new branch of the latest state for a
+// state-changing precompile call. The branch is journaled, so its writes are
+// discarded if the EVM reverts to a snapshot taken before the call, and they
+// are written to the transaction context on "Commit".
+//
+// The dirty state objects are written to the branch before the call, so the
+// precompile sees the balances moved by the EVM. The live set of state objects
+// is then reset, so the accounts changed by the precompile are reloaded from
+// the branch instead of being overwritten with stale values on "Commit".
+func (s *StateDB) CacheCtxForPrecompile() (sdk.Context, error) {
+	cacheCtx, write := s.latestCtx().CacheContext()
+	if err := s.writeStateObjects(cacheCtx); err != nil {
+		return sdk.Context{}, err
+	}
+	s.journal.append(precompileCalledChange{prevObjects: s.stateObjects})
+	s.stateObjects = make(map[common.Address]*stateObject)
+	s.precompileCtxs = append(s.precompileCtxs, precompileCtx{
+		ctx:   cacheCtx,
+		write: write,
+	})
+	return cacheCtx, nil
+}
+
+// ReadCtxForPrecompile returns a throwaway branch of the latest state, with
+// the dirty state objects written to it, for a read-only precompile call.
+// Writes to the returned context are never committed.
+func (s *StateDB) ReadCtxForPrecompile() (sdk.Context, error) {
+	cacheCtx, _ := s.latestCtx().CacheContext()
+	if err := s.writeStateObjects(cacheCtx); err != nil {
+		return sdk.Context{}, err
+	}
+	return cacheCtx, nil
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *gethcore.Log) {
 	s.journal.append(addLogChange{})
@@ -179,10 +244,26 @@ func (s *StateDB) GetState(addr common.Address, hash common.Hash) common.Hash {
 // GetCommittedState retrieves a value from the given account's committed storage trie.
 func (s *StateDB) GetCommittedState(addr common.Address, hash common.Hash) common.Hash {
 	stateObject := s.getStateObject(addr)
-	if stateObject != nil {
+	if stateObject == nil {
+		return common.Hash{}
+	}
+	if len(s.precompileCtxs) == 0 {
 		return stateObject.GetCommittedState(hash)
 	}
-	return common.Hash{}
+	if s.committedStorage == nil {
+		s.committedStorage = make(map[common.Address]Storage)
+	}
+	storage, ok := s.committedStorage[addr]
+	if !ok {
+		storage = make(Storage)
+		s.committedStorage[addr] = storage
+	}
+	if value, cached := storage[hash]; cached {
+		return value
+	}
+	value := s.keeper.GetState(s.ctx, addr, hash)
+	storage[hash] = value
+	return value
 }
 
 // GetRefund returns the current value of the refund counter.
@@ -213,7 +294,7 @@ func (s *StateDB) getStateObject(addr common.Address) *stateObject {
 		return obj
 	}
 	// If no live objects are available, load it from keeper
-	account := s.keeper.GetAccount(s.ctx, addr)
+	account := s.keeper.GetAccount(s.latestCtx(), addr)
 	if account == nil {
 		return nil
 	}
@@ -273,7 +354,7 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
-	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
+	s.keeper.ForEachStorage(s.latestCtx(), addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
 		}
@@ -447,17 +528,34 @@ func (s *StateDB) RevertToSnapshot(revid int) {
 // Commit writes the dirty states to keeper
 // the StateDB object should be discarded after committed.
 func (s *StateDB) Commit() error {
+	// Write the state of precompile calls first, newest branch first, so that
+	// each branch lands in its parent and finally in the transaction context.
+	for i := len(s.precompileCtxs) - 1; i >= 0; i-- {
+		s.precompileCtxs[i].write()
+	}
+	s.precompileCtxs = nil
+
+	return s.writeStateObjects(s.ctx)
+}
+
+// writeStateObjects writes the dirty objects of the live set of state objects
+// to "ctx". Dirty accounts that are not in the live set were written to a
+// precompile branch and not loaded since.
+func (s *StateDB) writeStateObjects(ctx sdk.Context) error {
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
+		if obj == nil {
+			continue
+		}
 		if obj.suicided {
-			if err := s.keeper.DeleteAccount(s.ctx, obj.Address()); err != nil {
+			if err := s.keeper.DeleteAccount(ctx, obj.Address()); err != nil {
 				return errorsmod.Wrap(err, "failed to delete account")
 			}
 		} else {
 			if obj.code != nil && obj.dirtyCode {
-				s.keeper.SetCode(s.ctx, obj.CodeHash(), obj.code)
+				s.keeper.SetCode(ctx, obj.CodeHash(), obj.code)
 			}
-			if err := s.keeper.SetAccount(s.ctx, obj.Address(), obj.account); err != nil {
+			if err := s.keeper.SetAccount(ctx, obj.Address(), obj.account); err != nil {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
 			for _, key := range obj.dirtyStorage.SortedKeys() {
@@ -466,13 +564,82 @@ func (s *StateDB) Commit() error {
 				if value == obj.originStorage[key] {
 					continue
 				}
-				s.keeper.SetState(s.ctx, obj.Address(), key, value.Bytes())
+				s.keeper.SetState(ctx, obj.Address(), key, value.Bytes())
 			}
 		}
 	}
 	return nil
 }
 
+// StateChangesHash returns a deterministic hash over the EVM account and
+// storage changes recorded in the journal. Accounts are visited in address
+// order and storage slots in key order. Storage writes that restore the
+// original value are skipped, the same way they are in "Commit".
+//
+// The hash is unaffected by "Commit", so it can be computed after the changes
+// are written.
+func (s *StateDB) StateChangesHash() common.Hash {
+	// The live set only holds the changes since the last state-changing
+	// precompile call. The earlier changes are in the sets replaced by the
+	// precompile calls, which are kept in the journal.
+	objectSets := []map[common.Address]*stateObject{}
+	for _, entry := range s.journal.entries {
+		if ch, ok := entry.(precompileCalledChange); ok {
+			objectSets = append(objectSets, ch.prevObjects)
+		}
+	}
+	objectSets = append(objectSets, s.stateObjects)
+
+	var bz []byte
+	for _, addr := range s.journal.sortedDirties() {
+		var (
+			obj           *stateObject
+			dirtyStorage  = make(Storage)
+			originStorage = make(Storage)
+		)
+		for _, objects := range objectSets {
+			next := objects[addr]
+			if next == nil {
+				continue
+			}
+			obj = next
+			for key, value := range next.dirtyStorage {
+				if _, seen := dirtyStorage[key]; !seen {
+					originStorage[key] = next.originStorage[key]
+				}
+				dirtyStorage[key] = value
+			}
+		}
+		if obj == nil {
+			continue
+		}
+		bz = append(bz, addr.Bytes()...)
+		if obj.suicided {
+			bz = append(bz, 1)
+			continue
+		}
+		bz = append(bz, 0)
+		bz = append(bz, sdk.Uint64ToBigEndian(obj.account.Nonce)...)
+		bz = append(bz, common.BigToHash(obj.account.Balance).Bytes()...)
+		bz = append(bz, obj.CodeHash()...)
+
+		var storageBz []byte
+		numSlots := uint64(0)
+		for _, key := range dirtyStorage.SortedKeys() {
+			value := dirtyStorage[key]
+			if value == originStorage[key] {
+				continue
+			}
+			storageBz = append(storageBz, key.Bytes()...)
+			storageBz = append(storageBz, value.Bytes()...)
+			numSlots++
+		}
+		bz = append(bz, sdk.Uint64ToBigEndian(numSlots)...)
+		bz = append(bz, storageBz...)
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
 // StateObjects: Returns a copy of the [StateDB.stateObjects] map.
 func (s *StateDB) StateObjects() map[common.Address]*stateObject {
 	copyOfMap := make(map[common.Address]*stateObject)
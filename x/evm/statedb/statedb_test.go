@@ -9,8 +9,12 @@ import (
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	gethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
 	"github.com/NibiruChain/nibiru/x/evm/statedb"
 )
 
@@ -568,6 +572,148 @@ func (suite *StateDBTestSuite) TestIterateStorage() {
 	suite.Require().Equal(1, len(storage))
 }
 
+// TestPrecompileSnapshots: Shows that the state written by precompiles follows
+// the snapshots and reverts of the StateDB journal.
+func (suite *StateDBTestSuite) TestPrecompileSnapshots() {
+	deps := evmtest.NewTestDeps()
+	bankKeeper := deps.Chain.BankKeeper
+	denom := "unibi"
+	addrA, addrB := testutil.AccAddress(), testutil.AccAddress()
+	fund := func(ctx sdk.Context, addr sdk.AccAddress) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 420))
+		suite.Require().NoError(testapp.FundAccount(bankKeeper, ctx, addr, coins))
+	}
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) int64 {
+		return bankKeeper.GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+
+	suite.Run("reverted precompile call is discarded", func() {
+		db := deps.StateDB()
+		snapshot := db.Snapshot()
+		ctx, err := db.CacheCtxForPrecompile()
+		suite.Require().NoError(err)
+		fund(ctx, addrA)
+		suite.Equal(int64(420), balance(ctx, addrA))
+		suite.Equal(int64(0), balance(deps.Ctx, addrA), "written before commit")
+
+		db.RevertToSnapshot(snapshot)
+		suite.Require().NoError(db.Commit())
+		suite.Equal(int64(0), balance(deps.Ctx, addrA))
+	})
+
+	suite.Run("only calls after the snapshot are reverted", func() {
+		db := deps.StateDB()
+		ctxA, err := db.CacheCtxForPrecompile()
+		suite.Require().NoError(err)
+		fund(ctxA, addrA)
+
+		snapshot := db.Snapshot()
+		ctxB, err := db.CacheCtxForPrecompile()
+		suite.Require().NoError(err)
+		suite.Equal(int64(420), balance(ctxB, addrA), "branch must see earlier calls")
+		fund(ctxB, addrB)
+		db.RevertToSnapshot(snapshot)
+
+		readCtx, err := db.ReadCtxForPrecompile()
+		suite.Require().NoError(err)
+		suite.Equal(int64(420), balance(readCtx, addrA))
+		suite.Equal(int64(0), balance(readCtx, addrB))
+		fund(readCtx, addrB) // never committed
+
+		suite.Require().NoError(db.Commit())
+		suite.Equal(int64(420), balance(deps.Ctx, addrA))
+		suite.Equal(int64(0), balance(deps.Ctx, addrB))
+	})
+}
+
+// TestPrecompileSstoreGas: Shows that SSTORE is priced with the storage from
+// before the transaction after a state-changing precompile call.
+func (suite *StateDBTestSuite) TestPrecompileSstoreGas() {
+	deps := evmtest.NewTestDeps()
+	contract := common.BigToAddress(big.NewInt(420))
+	slot := common.Hash{}
+	db := deps.StateDB()
+	db.SetCode(contract, common.FromHex("600060005500")) // sstore(0, 0)
+	suite.Require().NoError(db.Commit())
+
+	db = deps.StateDB()
+	db.SetState(contract, slot, common.BigToHash(big.NewInt(1)))
+	_, err := db.CacheCtxForPrecompile()
+	suite.Require().NoError(err)
+	suite.Equal(common.Hash{}, db.GetCommittedState(contract, slot))
+	suite.Equal(common.BigToHash(big.NewInt(1)), db.GetState(contract, slot))
+
+	cfg, err := deps.K.GetEVMConfig(
+		deps.Ctx, sdk.ConsAddress(deps.Ctx.BlockHeader().ProposerAddress), deps.K.EthChainID(deps.Ctx),
+	)
+	suite.Require().NoError(err)
+	gasLimit := uint64(100_000)
+	msg := gethcore.NewMessage(
+		deps.Sender.EthAddr, &contract, 0, big.NewInt(0), gasLimit,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false,
+	)
+	db.AddSlotToAccessList(contract, slot)
+	evmObj := deps.K.NewEVM(deps.Ctx, msg, cfg, nil, db)
+	_, leftoverGas, err := evmObj.Call(
+		vm.AccountRef(deps.Sender.EthAddr), contract, nil, gasLimit, big.NewInt(0),
+	)
+	suite.Require().NoError(err)
+
+	// The slot is reset to its value from before the transaction, so SSTORE
+	// costs a warm read and refunds the cost of setting the slot.
+	suite.Equal(uint64(3+3)+gethparams.WarmStorageReadCostEIP2929, gasLimit-leftoverGas)
+	suite.Equal(gethparams.SstoreSetGasEIP2200-gethparams.WarmStorageReadCostEIP2929, db.GetRefund())
+}
+
+func (suite *StateDBTestSuite) TestStateChangesHash() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	newHash := func(malleate func(*statedb.StateDB)) common.Hash {
+		db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+		malleate(db)
+		return db.StateChangesHash()
+	}
+
+	emptyHash := newHash(func(db *statedb.StateDB) {})
+	suite.Equal(crypto.Keccak256Hash(nil), emptyHash)
+
+	suite.Run("deterministic regardless of the order of changes", func() {
+		hashA := newHash(func(db *statedb.StateDB) {
+			db.AddBalance(address, big.NewInt(10))
+			db.SetState(address2, key1, value1)
+		})
+		hashB := newHash(func(db *statedb.StateDB) {
+			db.SetState(address2, key1, value1)
+			db.AddBalance(address, big.NewInt(10))
+		})
+		suite.Equal(hashA, hashB)
+		suite.NotEqual(emptyHash, hashA)
+	})
+
+	suite.Run("different changes have different hashes", func() {
+		hashA := newHash(func(db *statedb.StateDB) { db.AddBalance(address, big.NewInt(10)) })
+		hashB := newHash(func(db *statedb.StateDB) { db.AddBalance(address, big.NewInt(11)) })
+		suite.NotEqual(hashA, hashB)
+	})
+
+	suite.Run("reverted changes are not included", func() {
+		hash := newHash(func(db *statedb.StateDB) {
+			revision := db.Snapshot()
+			db.AddBalance(address, big.NewInt(10))
+			db.RevertToSnapshot(revision)
+		})
+		suite.Equal(emptyHash, hash)
+	})
+
+	suite.Run("unaffected by commit", func() {
+		db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+		db.SetState(address, key1, value1)
+		hashBefore := db.StateChangesHash()
+		suite.Require().NoError(db.Commit())
+		suite.Equal(hashBefore, db.StateChangesHash())
+	})
+}
+
 func CollectContractStorage(db vm.StateDB) statedb.Storage {
 	storage := make(statedb.Storage)
 	err := db.ForEachStorage(address, func(k, v common.Hash) bool {
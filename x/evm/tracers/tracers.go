@@ -0,0 +1,75 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+
+// Package tracers registers the native Go tracers that a trace request can
+// select by name with "TraceConfig.Tracer": the "callTracer" and
+// "prestateTracer" of this package, which take precedence over the geth ones
+// of the same name, and the "4byteTracer" of geth.
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+	// Registers the geth native tracers, such as "4byteTracer".
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+)
+
+const (
+	TracerCall     = "callTracer"
+	TracerPrestate = "prestateTracer"
+	Tracer4Byte    = "4byteTracer"
+)
+
+// memoryPadLimit is the max number of zero bytes that [memoryCopyPadded]
+// pads a memory copy with.
+const memoryPadLimit = 1024 * 1024
+
+type ctorFn = func(*gethtracers.Context, json.RawMessage) (gethtracers.Tracer, error)
+
+var ctors = map[string]ctorFn{
+	TracerCall:     newCallTracer,
+	TracerPrestate: newPrestateTracer,
+}
+
+// init registers the tracers of this package. Since geth's native tracers
+// register in the init of a dependency, which runs first, and non-wildcard
+// lookups are prepended, the tracers of this package are looked up first.
+func init() {
+	gethtracers.RegisterLookup(false, lookup)
+}
+
+// lookup returns a tracer of this package, if one can be matched to the
+// given name.
+func lookup(
+	name string, ctx *gethtracers.Context, cfg json.RawMessage,
+) (gethtracers.Tracer, error) {
+	if ctor, ok := ctors[name]; ok {
+		return ctor(ctx, cfg)
+	}
+	return nil, errors.New("no tracer found")
+}
+
+// memoryCopyPadded returns a copy of the memory in [offset, offset+size),
+// padded with zeros past the end of the memory. Tracers read the memory
+// before the opcode resizes it, so the range may not be allocated yet.
+func memoryCopyPadded(m *vm.Memory, offset, size int64) ([]byte, error) {
+	if offset < 0 || size < 0 {
+		return nil, errors.New("offset or size must not be negative")
+	}
+	if int(offset+size) < m.Len() {
+		return m.GetCopy(offset, size), nil
+	}
+	paddingNeeded := int(offset+size) - m.Len()
+	if paddingNeeded > memoryPadLimit {
+		return nil, fmt.Errorf(
+			"reached limit for padding memory slice: %d", paddingNeeded)
+	}
+	cpy := make([]byte, size)
+	if overlap := int64(m.Len()) - offset; overlap > 0 {
+		copy(cpy, m.GetPtr(offset, overlap))
+	}
+	return cpy, nil
+}
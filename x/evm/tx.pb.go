@@ -13,6 +13,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	types2 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -639,856 +640,1049 @@ func (m *MsgSendFunTokenToEvmResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendFunTokenToEvmResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgEthereumTx)(nil), "eth.evm.v1.MsgEthereumTx")
-	proto.RegisterType((*LegacyTx)(nil), "eth.evm.v1.LegacyTx")
-	proto.RegisterType((*AccessListTx)(nil), "eth.evm.v1.AccessListTx")
-	proto.RegisterType((*DynamicFeeTx)(nil), "eth.evm.v1.DynamicFeeTx")
-	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "eth.evm.v1.ExtensionOptionsEthereumTx")
-	proto.RegisterType((*MsgEthereumTxResponse)(nil), "eth.evm.v1.MsgEthereumTxResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "eth.evm.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "eth.evm.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateFunToken)(nil), "eth.evm.v1.MsgCreateFunToken")
-	proto.RegisterType((*MsgCreateFunTokenResponse)(nil), "eth.evm.v1.MsgCreateFunTokenResponse")
-	proto.RegisterType((*MsgSendFunTokenToEvm)(nil), "eth.evm.v1.MsgSendFunTokenToEvm")
-	proto.RegisterType((*MsgSendFunTokenToEvmResponse)(nil), "eth.evm.v1.MsgSendFunTokenToEvmResponse")
+// MsgConvertEvmToCoin: Arguments to convert ERC20 tokens into their bank coin
+// representation using the ERC20's "FunToken" mapping.
+type MsgConvertEvmToCoin struct {
+	// Sender: Address for the signer of the transaction. The ERC20 tokens are
+	// taken from the Ethereum address corresponding to this account.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token to convert
+	Erc20Addr github_com_NibiruChain_nibiru_eth.HexAddr `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3,customtype=github.com/NibiruChain/nibiru/eth.HexAddr" json:"erc20_addr"`
+	// Amount of ERC20 tokens to convert, in the smallest unit of the ERC20.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// Bech32 address of the account that receives the bank coins.
+	ToBankAddr string `protobuf:"bytes,4,opt,name=to_bank_addr,json=toBankAddr,proto3" json:"to_bank_addr,omitempty"`
+}
+
+func (m *MsgConvertEvmToCoin) Reset()         { *m = MsgConvertEvmToCoin{} }
+func (m *MsgConvertEvmToCoin) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertEvmToCoin) ProtoMessage()    {}
+func (*MsgConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{12}
+}
+func (m *MsgConvertEvmToCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertEvmToCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertEvmToCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertEvmToCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertEvmToCoin.Merge(m, src)
+}
+func (m *MsgConvertEvmToCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertEvmToCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertEvmToCoin.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("eth/evm/v1/tx.proto", fileDescriptor_82a0bfe4f0bab953) }
+var xxx_messageInfo_MsgConvertEvmToCoin proto.InternalMessageInfo
 
-var fileDescriptor_82a0bfe4f0bab953 = []byte{
-	// 1239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x1b, 0xff, 0x78, 0x76, 0x93, 0x76, 0xbf, 0xe9, 0xb7, 0x6b, 0x53, 0xbc, 0x66,
-	0x23, 0x90, 0x41, 0xca, 0x6e, 0x13, 0x24, 0xa4, 0x46, 0xe2, 0x10, 0x27, 0x2e, 0x14, 0x25, 0x10,
-	0xb6, 0x2e, 0x07, 0x2e, 0xd6, 0x78, 0x77, 0xb2, 0x5e, 0x25, 0x3b, 0xb3, 0xda, 0x19, 0x5b, 0x0e,
-	0x27, 0xd4, 0x13, 0x12, 0x07, 0x40, 0xdc, 0x11, 0x17, 0x2e, 0x9c, 0x38, 0xf4, 0xc0, 0x9f, 0x50,
-	0x71, 0xaa, 0xe8, 0x05, 0xf5, 0x60, 0x50, 0x8a, 0x84, 0x94, 0x63, 0x0f, 0x9c, 0xd1, 0xcc, 0xae,
-	0x1d, 0x3b, 0x21, 0x29, 0xad, 0x10, 0xb7, 0x79, 0xf3, 0x7e, 0xcc, 0x7b, 0x9f, 0xcf, 0x9b, 0x37,
-	0x03, 0xff, 0xc3, 0xbc, 0x67, 0xe3, 0x41, 0x68, 0x0f, 0x56, 0x6d, 0x3e, 0xb4, 0xa2, 0x98, 0x72,
-	0xaa, 0x01, 0xe6, 0x3d, 0x0b, 0x0f, 0x42, 0x6b, 0xb0, 0x5a, 0xbd, 0xe6, 0x52, 0x16, 0x52, 0x66,
-	0x87, 0xcc, 0x17, 0x36, 0x21, 0xf3, 0x13, 0xa3, 0x6a, 0x2d, 0x55, 0x74, 0x11, 0xc3, 0xf6, 0x60,
-	0xb5, 0x8b, 0x39, 0x5a, 0xb5, 0x5d, 0x1a, 0x90, 0x54, 0x5f, 0x49, 0xf4, 0x1d, 0x29, 0xd9, 0x89,
-	0x90, 0xaa, 0x96, 0xa6, 0x0e, 0x15, 0xc7, 0xa4, 0xbb, 0x3e, 0xf5, 0x69, 0x62, 0x2d, 0x56, 0xe9,
-	0xee, 0x75, 0x9f, 0x52, 0xff, 0x00, 0xdb, 0x28, 0x0a, 0x6c, 0x44, 0x08, 0xe5, 0x88, 0x07, 0x94,
-	0x8c, 0x23, 0x55, 0x52, 0xad, 0x94, 0xba, 0xfd, 0x3d, 0x1b, 0x91, 0xc3, 0x44, 0x65, 0x7e, 0xa1,
-	0xc0, 0xa5, 0x1d, 0xe6, 0xb7, 0x78, 0x0f, 0xc7, 0xb8, 0x1f, 0xb6, 0x87, 0x5a, 0x03, 0x54, 0x0f,
-	0x71, 0xa4, 0x2b, 0x75, 0xa5, 0x51, 0x5a, 0x5b, 0xb2, 0x12, 0x5f, 0x6b, 0xec, 0x6b, 0x6d, 0x90,
-	0x43, 0x47, 0x5a, 0x68, 0x15, 0x50, 0x59, 0xf0, 0x09, 0xd6, 0x33, 0x75, 0xa5, 0xa1, 0x34, 0xe7,
-	0x8f, 0x47, 0x86, 0xb2, 0xe2, 0xc8, 0x2d, 0xcd, 0x00, 0xb5, 0x87, 0x58, 0x4f, 0xcf, 0xd6, 0x95,
-	0x46, 0xb1, 0x59, 0x7a, 0x3a, 0x32, 0xf2, 0xf1, 0x41, 0xb4, 0x6e, 0xae, 0x98, 0x8e, 0x54, 0x68,
-	0x1a, 0xa8, 0x7b, 0x31, 0x0d, 0x75, 0x55, 0x18, 0x38, 0x72, 0xbd, 0xae, 0x7e, 0xf6, 0xad, 0x31,
-	0x67, 0x7e, 0x95, 0x81, 0xc2, 0x36, 0xf6, 0x91, 0x7b, 0xd8, 0x1e, 0x6a, 0x4b, 0x30, 0x4f, 0x28,
-	0x71, 0xb1, 0xcc, 0x46, 0x75, 0x12, 0x41, 0x7b, 0x0b, 0x8a, 0x3e, 0x12, 0x98, 0x05, 0x6e, 0x72,
-	0x7a, 0xb1, 0x59, 0x79, 0x3c, 0x32, 0xae, 0x26, 0xf0, 0x31, 0x6f, 0xdf, 0x0a, 0xa8, 0x1d, 0x22,
-	0xde, 0xb3, 0x6e, 0x13, 0xee, 0x14, 0x7c, 0xc4, 0x76, 0x85, 0xa9, 0x56, 0x83, 0xac, 0x8f, 0x98,
-	0x4c, 0x4a, 0x6d, 0x96, 0x8f, 0x46, 0x46, 0xe1, 0x1d, 0xc4, 0xb6, 0x83, 0x30, 0xe0, 0x8e, 0x50,
-	0x68, 0x0b, 0x90, 0xe1, 0x34, 0x4d, 0x29, 0xc3, 0xa9, 0x76, 0x13, 0xe6, 0x07, 0xe8, 0xa0, 0x8f,
-	0xf5, 0x79, 0x79, 0xc6, 0xf2, 0xb9, 0x67, 0x1c, 0x8d, 0x8c, 0xdc, 0x46, 0x48, 0xfb, 0x84, 0x3b,
-	0x89, 0x87, 0xa8, 0x4f, 0xa2, 0x98, 0xab, 0x2b, 0x8d, 0x72, 0x8a, 0x57, 0x19, 0x94, 0x81, 0x9e,
-	0x97, 0x1b, 0xca, 0x40, 0x48, 0xb1, 0x5e, 0x48, 0xa4, 0x58, 0x48, 0x4c, 0x2f, 0x26, 0x12, 0x5b,
-	0x5f, 0x10, 0x48, 0xfc, 0x74, 0x7f, 0x25, 0xd7, 0x1e, 0x6e, 0x21, 0x8e, 0xcc, 0x1f, 0xb3, 0x50,
-	0xde, 0x70, 0x5d, 0xcc, 0xd8, 0x76, 0xc0, 0x78, 0x7b, 0xa8, 0xbd, 0x07, 0x05, 0xb7, 0x87, 0x02,
-	0xd2, 0x09, 0x3c, 0x09, 0x4d, 0xb1, 0x69, 0x5f, 0x94, 0x5c, 0x7e, 0x53, 0x18, 0xdf, 0xde, 0x3a,
-	0x1e, 0x19, 0x79, 0x37, 0x59, 0x3a, 0xe9, 0xc2, 0x3b, 0xc1, 0x38, 0x73, 0x2e, 0xc6, 0xd9, 0xe7,
-	0xc6, 0x58, 0xbd, 0x18, 0xe3, 0xf9, 0xb3, 0x18, 0xe7, 0x5e, 0x18, 0xe3, 0xfc, 0x14, 0xc6, 0x77,
-	0xa1, 0x80, 0x24, 0x50, 0x98, 0xe9, 0x85, 0x7a, 0xb6, 0x51, 0x5a, 0xbb, 0x66, 0x9d, 0xdc, 0x53,
-	0x2b, 0x01, 0xb1, 0xdd, 0x8f, 0x0e, 0x70, 0xb3, 0xfe, 0x60, 0x64, 0xcc, 0x1d, 0x8f, 0x0c, 0x40,
-	0x13, 0x64, 0xbf, 0xff, 0xd5, 0x80, 0x13, 0x9c, 0x9d, 0x49, 0xa8, 0x84, 0xba, 0xe2, 0x0c, 0x75,
-	0x30, 0x43, 0x5d, 0xe9, 0x3c, 0xea, 0xfe, 0xcc, 0x42, 0x79, 0xeb, 0x90, 0xa0, 0x30, 0x70, 0x6f,
-	0x61, 0xfc, 0x9f, 0x50, 0x77, 0x13, 0x4a, 0x82, 0x3a, 0x1e, 0x44, 0x1d, 0x17, 0x45, 0xcf, 0x26,
-	0x4f, 0x10, 0xdd, 0x0e, 0xa2, 0x4d, 0x14, 0x8d, 0x5d, 0xf7, 0x30, 0x96, 0xae, 0xea, 0x3f, 0x71,
-	0xbd, 0x85, 0xb1, 0x70, 0x4d, 0x89, 0x9f, 0xbf, 0x98, 0xf8, 0xdc, 0x59, 0xe2, 0xf3, 0x2f, 0x4c,
-	0x7c, 0xe1, 0x1c, 0xe2, 0x8b, 0xff, 0x32, 0xf1, 0x30, 0x43, 0x7c, 0x69, 0x86, 0xf8, 0xf2, 0x79,
-	0xc4, 0x9b, 0x50, 0x6d, 0x0d, 0x39, 0x26, 0x2c, 0xa0, 0xe4, 0x83, 0x48, 0x8e, 0xe3, 0x93, 0x29,
-	0x9b, 0xce, 0xba, 0x6f, 0x14, 0xb8, 0x3a, 0x33, 0x7d, 0x1d, 0xcc, 0x22, 0x4a, 0x98, 0x2c, 0x51,
-	0x0e, 0x50, 0x25, 0x99, 0x8f, 0x72, 0x66, 0x2e, 0x83, 0x7a, 0x40, 0x7d, 0xa6, 0x67, 0x64, 0x79,
-	0x8b, 0xd3, 0xe5, 0x6d, 0x53, 0xdf, 0x91, 0x4a, 0xed, 0x32, 0x64, 0x63, 0xcc, 0x25, 0xe9, 0x65,
-	0x47, 0x2c, 0xb5, 0x0a, 0x14, 0x06, 0x61, 0x07, 0xc7, 0x31, 0x8d, 0xd3, 0xd9, 0x96, 0x1f, 0x84,
-	0x2d, 0x21, 0x0a, 0x95, 0xa0, 0xbb, 0xcf, 0xb0, 0x97, 0x10, 0xe7, 0xe4, 0x7d, 0xc4, 0xee, 0x32,
-	0xec, 0xa5, 0x09, 0x7e, 0xae, 0xc0, 0xe2, 0x0e, 0xf3, 0xef, 0x46, 0x1e, 0xe2, 0x78, 0x17, 0xc5,
-	0x28, 0x64, 0x62, 0x32, 0xa0, 0x3e, 0xef, 0xd1, 0x38, 0xe0, 0x87, 0x69, 0x07, 0xeb, 0x3f, 0xdf,
-	0x5f, 0x59, 0x4a, 0x1f, 0xaf, 0x0d, 0xcf, 0x8b, 0x31, 0x63, 0x77, 0x78, 0x1c, 0x10, 0xdf, 0x39,
-	0x31, 0xd5, 0x6e, 0x40, 0x2e, 0x92, 0x11, 0x64, 0xb7, 0x96, 0xd6, 0xb4, 0xe9, 0x02, 0x92, 0xd8,
-	0x4d, 0x55, 0x50, 0xe3, 0xa4, 0x76, 0xeb, 0x0b, 0xf7, 0xfe, 0xf8, 0xe1, 0x8d, 0x93, 0x08, 0x66,
-	0x05, 0xae, 0x9d, 0x4a, 0x66, 0x8c, 0x97, 0xf9, 0x9d, 0x02, 0x57, 0x76, 0x98, 0xbf, 0x19, 0x63,
-	0xc4, 0xf1, 0xad, 0x3e, 0x69, 0xd3, 0x7d, 0x4c, 0xb4, 0x5d, 0x00, 0xf1, 0xb2, 0x74, 0x70, 0xec,
-	0xae, 0xdd, 0x48, 0x73, 0x5d, 0x7d, 0x30, 0x32, 0x94, 0xc7, 0x23, 0xe3, 0x75, 0x3f, 0xe0, 0xbd,
-	0x7e, 0xd7, 0x72, 0x69, 0x68, 0xbf, 0x1f, 0x74, 0x83, 0xb8, 0x2f, 0x6f, 0x9a, 0x4d, 0xe4, 0xda,
-	0x16, 0xb9, 0xbd, 0x8b, 0x87, 0xa2, 0x1a, 0xa7, 0x28, 0x82, 0xb4, 0x44, 0x0c, 0xed, 0x35, 0x58,
-	0x94, 0x11, 0xbb, 0x88, 0xec, 0x77, 0x3c, 0x4c, 0x68, 0x98, 0x3c, 0x40, 0xce, 0x25, 0xb1, 0xdd,
-	0x44, 0x64, 0x7f, 0x4b, 0x6c, 0x6a, 0xff, 0x87, 0x1c, 0xc3, 0xc4, 0xc3, 0x71, 0x72, 0xfd, 0x9c,
-	0x54, 0x32, 0xbb, 0x50, 0x39, 0x93, 0xe6, 0x84, 0xf4, 0x16, 0x5c, 0xde, 0xeb, 0x13, 0x2e, 0xf6,
-	0x3a, 0x21, 0x8a, 0xa2, 0x80, 0xf8, 0x93, 0x67, 0x78, 0x0a, 0xab, 0xb1, 0x5f, 0x8a, 0xd6, 0xe2,
-	0xd8, 0x67, 0x27, 0x71, 0x31, 0x1f, 0x29, 0xb0, 0xb4, 0xc3, 0xfc, 0x3b, 0x98, 0x78, 0x63, 0xd3,
-	0x36, 0x6d, 0x0d, 0x42, 0xed, 0x43, 0x28, 0x71, 0xda, 0xc1, 0xbc, 0xd7, 0x41, 0x9e, 0x17, 0x4f,
-	0xe1, 0x31, 0xf7, 0x9c, 0x78, 0x70, 0xda, 0xe2, 0x3d, 0xb1, 0x9c, 0xaa, 0x33, 0x33, 0x5d, 0xa7,
-	0xb6, 0x0b, 0x45, 0x09, 0x91, 0xf8, 0xea, 0x48, 0x08, 0x4a, 0x6b, 0x15, 0x2b, 0xed, 0x10, 0xf1,
-	0x17, 0xb2, 0xd2, 0xbf, 0x90, 0xb5, 0x49, 0x03, 0xd2, 0xd4, 0x45, 0x0e, 0x4f, 0x47, 0xc6, 0xe5,
-	0x43, 0x14, 0x1e, 0xac, 0x9b, 0x13, 0x4f, 0xd3, 0x29, 0x88, 0xb5, 0xb0, 0x31, 0x6b, 0x70, 0xfd,
-	0xef, 0x8a, 0x1a, 0x83, 0xb7, 0xf6, 0x69, 0x16, 0xb2, 0x3b, 0xcc, 0xd7, 0x08, 0xc0, 0xd4, 0x6f,
-	0xa6, 0x32, 0x0d, 0xdc, 0xcc, 0x55, 0xab, 0xbe, 0x72, 0xae, 0x6a, 0xd2, 0x55, 0xe6, 0xbd, 0x47,
-	0xbf, 0x7f, 0x9d, 0xb9, 0x6e, 0x56, 0x27, 0x28, 0xa4, 0xdf, 0xb1, 0xd4, 0xb4, 0xc3, 0x87, 0xda,
-	0x2e, 0x94, 0x67, 0xae, 0xc7, 0x4b, 0xa7, 0xc2, 0x4e, 0x2b, 0xab, 0xcb, 0x17, 0x28, 0x27, 0x6d,
-	0xf0, 0x11, 0x2c, 0x9c, 0xea, 0xe3, 0x97, 0x4f, 0xb9, 0xcd, 0xaa, 0xab, 0xaf, 0x5e, 0xa8, 0x9e,
-	0xc4, 0xed, 0xc0, 0x95, 0xb3, 0x3d, 0x51, 0x3f, 0xe5, 0x7b, 0xc6, 0xa2, 0xda, 0x78, 0x96, 0xc5,
-	0xf8, 0x80, 0xe6, 0xdb, 0x0f, 0x8e, 0x6a, 0xca, 0xc3, 0xa3, 0x9a, 0xf2, 0xdb, 0x51, 0x4d, 0xf9,
-	0xf2, 0x49, 0x6d, 0xee, 0xe1, 0x93, 0xda, 0xdc, 0x2f, 0x4f, 0x6a, 0x73, 0x1f, 0x2f, 0x5f, 0xdc,
-	0x5c, 0x43, 0x01, 0x6c, 0x37, 0x27, 0xff, 0x98, 0x6f, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x5d,
-	0xb8, 0xdf, 0xf4, 0x6e, 0x0b, 0x00, 0x00,
+func (m *MsgConvertEvmToCoin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgConvertEvmToCoin) GetToBankAddr() string {
+	if m != nil {
+		return m.ToBankAddr
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type MsgConvertEvmToCoinResponse struct {
+	// Bank coin credited to the recipient
+	BankCoin types1.Coin `protobuf:"bytes,1,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// EthereumTx defines a method submitting Ethereum transactions.
-	EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
-	// UpdateParams defined a governance operation for updating the x/evm module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateFunToken: Create a "FunToken" mapping. Either the ERC20 contract
-	// address can be given to create the mapping to a bank coin, or the
-	// denomination for a bank coin can be given to create the mapping to an ERC20.
-	CreateFunToken(ctx context.Context, in *MsgCreateFunToken, opts ...grpc.CallOption) (*MsgCreateFunTokenResponse, error)
-	// SendFunTokenToEvm: Sends a coin with a valid "FunToken" mapping to the
-	// given recipient address ("to_eth_addr") in the corresponding ERC20
-	// representation.
-	SendFunTokenToEvm(ctx context.Context, in *MsgSendFunTokenToEvm, opts ...grpc.CallOption) (*MsgSendFunTokenToEvmResponse, error)
+func (m *MsgConvertEvmToCoinResponse) Reset()         { *m = MsgConvertEvmToCoinResponse{} }
+func (m *MsgConvertEvmToCoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertEvmToCoinResponse) ProtoMessage()    {}
+func (*MsgConvertEvmToCoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{13}
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertEvmToCoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertEvmToCoinResponse.Merge(m, src)
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertEvmToCoinResponse.DiscardUnknown(m)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_MsgConvertEvmToCoinResponse proto.InternalMessageInfo
+
+func (m *MsgConvertEvmToCoinResponse) GetBankCoin() types1.Coin {
+	if m != nil {
+		return m.BankCoin
+	}
+	return types1.Coin{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgSetFunTokenPaused: Arguments to pause or resume the conversions of a
+// "FunToken" mapping.
+type MsgSetFunTokenPaused struct {
+	// Sender: Address of the x/sudo root.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token of the "FunToken" mapping
+	Erc20Addr github_com_NibiruChain_nibiru_eth.HexAddr `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3,customtype=github.com/NibiruChain/nibiru/eth.HexAddr" json:"erc20_addr"`
+	// Paused: If true, conversions are paused. If false, they are resumed.
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func (c *msgClient) EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error) {
-	out := new(MsgEthereumTxResponse)
-	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/EthereumTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFunTokenPaused) Reset()         { *m = MsgSetFunTokenPaused{} }
+func (m *MsgSetFunTokenPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFunTokenPaused) ProtoMessage()    {}
+func (*MsgSetFunTokenPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{14}
+}
+func (m *MsgSetFunTokenPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFunTokenPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFunTokenPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetFunTokenPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFunTokenPaused.Merge(m, src)
+}
+func (m *MsgSetFunTokenPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFunTokenPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFunTokenPaused.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetFunTokenPaused proto.InternalMessageInfo
+
+func (m *MsgSetFunTokenPaused) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateFunToken(ctx context.Context, in *MsgCreateFunToken, opts ...grpc.CallOption) (*MsgCreateFunTokenResponse, error) {
-	out := new(MsgCreateFunTokenResponse)
-	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/CreateFunToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFunTokenPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) SendFunTokenToEvm(ctx context.Context, in *MsgSendFunTokenToEvm, opts ...grpc.CallOption) (*MsgSendFunTokenToEvmResponse, error) {
-	out := new(MsgSendFunTokenToEvmResponse)
-	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SendFunTokenToEvm", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgSetFunTokenPausedResponse struct {
+	// Fungible token mapping after the update
+	FuntokenMapping FunToken `protobuf:"bytes,1,opt,name=funtoken_mapping,json=funtokenMapping,proto3" json:"funtoken_mapping"`
+}
+
+func (m *MsgSetFunTokenPausedResponse) Reset()         { *m = MsgSetFunTokenPausedResponse{} }
+func (m *MsgSetFunTokenPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFunTokenPausedResponse) ProtoMessage()    {}
+func (*MsgSetFunTokenPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{15}
+}
+func (m *MsgSetFunTokenPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFunTokenPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFunTokenPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetFunTokenPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFunTokenPausedResponse.Merge(m, src)
+}
+func (m *MsgSetFunTokenPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFunTokenPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFunTokenPausedResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// EthereumTx defines a method submitting Ethereum transactions.
-	EthereumTx(context.Context, *MsgEthereumTx) (*MsgEthereumTxResponse, error)
-	// UpdateParams defined a governance operation for updating the x/evm module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateFunToken: Create a "FunToken" mapping. Either the ERC20 contract
-	// address can be given to create the mapping to a bank coin, or the
-	// denomination for a bank coin can be given to create the mapping to an ERC20.
-	CreateFunToken(context.Context, *MsgCreateFunToken) (*MsgCreateFunTokenResponse, error)
-	// SendFunTokenToEvm: Sends a coin with a valid "FunToken" mapping to the
-	// given recipient address ("to_eth_addr") in the corresponding ERC20
-	// representation.
-	SendFunTokenToEvm(context.Context, *MsgSendFunTokenToEvm) (*MsgSendFunTokenToEvmResponse, error)
+var xxx_messageInfo_MsgSetFunTokenPausedResponse proto.InternalMessageInfo
+
+func (m *MsgSetFunTokenPausedResponse) GetFuntokenMapping() FunToken {
+	if m != nil {
+		return m.FuntokenMapping
+	}
+	return FunToken{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgUnregisterFunToken: Arguments to delete a "FunToken" mapping.
+type MsgUnregisterFunToken struct {
+	// Sender: Address of the x/sudo root.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token of the "FunToken" mapping
+	Erc20Addr github_com_NibiruChain_nibiru_eth.HexAddr `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3,customtype=github.com/NibiruChain/nibiru/eth.HexAddr" json:"erc20_addr"`
 }
 
-func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumTx) (*MsgEthereumTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EthereumTx not implemented")
+func (m *MsgUnregisterFunToken) Reset()         { *m = MsgUnregisterFunToken{} }
+func (m *MsgUnregisterFunToken) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterFunToken) ProtoMessage()    {}
+func (*MsgUnregisterFunToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{16}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUnregisterFunToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateFunToken(ctx context.Context, req *MsgCreateFunToken) (*MsgCreateFunTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFunToken not implemented")
+func (m *MsgUnregisterFunToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterFunToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SendFunTokenToEvm(ctx context.Context, req *MsgSendFunTokenToEvm) (*MsgSendFunTokenToEvmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendFunTokenToEvm not implemented")
+func (m *MsgUnregisterFunToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterFunToken.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgUnregisterFunToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterFunToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterFunToken.DiscardUnknown(m)
 }
 
-func _Msg_EthereumTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEthereumTx)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EthereumTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/eth.evm.v1.Msg/EthereumTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EthereumTx(ctx, req.(*MsgEthereumTx))
+var xxx_messageInfo_MsgUnregisterFunToken proto.InternalMessageInfo
+
+func (m *MsgUnregisterFunToken) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/eth.evm.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgUnregisterFunTokenResponse struct {
 }
 
-func _Msg_CreateFunToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateFunToken)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateFunToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/eth.evm.v1.Msg/CreateFunToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateFunToken(ctx, req.(*MsgCreateFunToken))
+func (m *MsgUnregisterFunTokenResponse) Reset()         { *m = MsgUnregisterFunTokenResponse{} }
+func (m *MsgUnregisterFunTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterFunTokenResponse) ProtoMessage()    {}
+func (*MsgUnregisterFunTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{17}
+}
+func (m *MsgUnregisterFunTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterFunTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterFunTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_SendFunTokenToEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendFunTokenToEvm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendFunTokenToEvm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/eth.evm.v1.Msg/SendFunTokenToEvm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendFunTokenToEvm(ctx, req.(*MsgSendFunTokenToEvm))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgUnregisterFunTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterFunTokenResponse.Merge(m, src)
+}
+func (m *MsgUnregisterFunTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterFunTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterFunTokenResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "eth.evm.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EthereumTx",
-			Handler:    _Msg_EthereumTx_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateFunToken",
-			Handler:    _Msg_CreateFunToken_Handler,
-		},
-		{
-			MethodName: "SendFunTokenToEvm",
-			Handler:    _Msg_SendFunTokenToEvm_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "eth/evm/v1/tx.proto",
+var xxx_messageInfo_MsgUnregisterFunTokenResponse proto.InternalMessageInfo
+
+// MsgRefreshFunTokenMetadata: Arguments to re-sync the bank metadata of a
+// "FunToken" mapping with its ERC20.
+type MsgRefreshFunTokenMetadata struct {
+	// Sender: Address of the x/sudo root.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token of the "FunToken" mapping
+	Erc20Addr github_com_NibiruChain_nibiru_eth.HexAddr `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3,customtype=github.com/NibiruChain/nibiru/eth.HexAddr" json:"erc20_addr"`
 }
 
-func (m *MsgEthereumTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRefreshFunTokenMetadata) Reset()         { *m = MsgRefreshFunTokenMetadata{} }
+func (m *MsgRefreshFunTokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgRefreshFunTokenMetadata) ProtoMessage()    {}
+func (*MsgRefreshFunTokenMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{18}
+}
+func (m *MsgRefreshFunTokenMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefreshFunTokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefreshFunTokenMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgEthereumTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefreshFunTokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefreshFunTokenMetadata.Merge(m, src)
+}
+func (m *MsgRefreshFunTokenMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefreshFunTokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefreshFunTokenMetadata.DiscardUnknown(m)
 }
 
-func (m *MsgEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Size_ != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Size_))))
-		i--
-		dAtA[i] = 0x11
+var xxx_messageInfo_MsgRefreshFunTokenMetadata proto.InternalMessageInfo
+
+func (m *MsgRefreshFunTokenMetadata) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	return ""
+}
+
+type MsgRefreshFunTokenMetadataResponse struct {
+	// Bank metadata of the coin after the update
+	BankMetadata types2.Metadata `protobuf:"bytes,1,opt,name=bank_metadata,json=bankMetadata,proto3" json:"bank_metadata"`
+}
+
+func (m *MsgRefreshFunTokenMetadataResponse) Reset()         { *m = MsgRefreshFunTokenMetadataResponse{} }
+func (m *MsgRefreshFunTokenMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefreshFunTokenMetadataResponse) ProtoMessage()    {}
+func (*MsgRefreshFunTokenMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{19}
+}
+func (m *MsgRefreshFunTokenMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefreshFunTokenMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefreshFunTokenMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRefreshFunTokenMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefreshFunTokenMetadataResponse.Merge(m, src)
+}
+func (m *MsgRefreshFunTokenMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefreshFunTokenMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefreshFunTokenMetadataResponse.DiscardUnknown(m)
 }
 
-func (m *LegacyTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRefreshFunTokenMetadataResponse proto.InternalMessageInfo
+
+func (m *MsgRefreshFunTokenMetadataResponse) GetBankMetadata() types2.Metadata {
+	if m != nil {
+		return m.BankMetadata
 	}
-	return dAtA[:n], nil
+	return types2.Metadata{}
 }
 
-func (m *LegacyTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgEditCreateAllowlist: Arguments to edit the allowlist of the
+// "create_policy" param.
+type MsgEditCreateAllowlist struct {
+	// Sender: Address of the x/sudo root.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// add_addrs: Addresses to add to the allowlist, in hex (0x) or Bech32
+	// (nibi) format.
+	AddAddrs []string `protobuf:"bytes,2,rep,name=add_addrs,json=addAddrs,proto3" json:"add_addrs,omitempty"`
+	// remove_addrs: Addresses to remove from the allowlist, in hex (0x) or
+	// Bech32 (nibi) format.
+	RemoveAddrs []string `protobuf:"bytes,3,rep,name=remove_addrs,json=removeAddrs,proto3" json:"remove_addrs,omitempty"`
 }
 
-func (m *LegacyTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.V) > 0 {
-		i -= len(m.V)
-		copy(dAtA[i:], m.V)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Amount != nil {
-		{
-			size := m.Amount.Size()
-			i -= size
-			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgEditCreateAllowlist) Reset()         { *m = MsgEditCreateAllowlist{} }
+func (m *MsgEditCreateAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgEditCreateAllowlist) ProtoMessage()    {}
+func (*MsgEditCreateAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{20}
+}
+func (m *MsgEditCreateAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditCreateAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditCreateAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.GasPrice != nil {
-		{
-			size := m.GasPrice.Size()
-			i -= size
-			if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgEditCreateAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditCreateAllowlist.Merge(m, src)
+}
+func (m *MsgEditCreateAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditCreateAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditCreateAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEditCreateAllowlist proto.InternalMessageInfo
+
+func (m *MsgEditCreateAllowlist) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *MsgEditCreateAllowlist) GetAddAddrs() []string {
+	if m != nil {
+		return m.AddAddrs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AccessListTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgEditCreateAllowlist) GetRemoveAddrs() []string {
+	if m != nil {
+		return m.RemoveAddrs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AccessListTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgEditCreateAllowlistResponse struct {
+	// Create policy after the update
+	CreatePolicy CreatePolicy `protobuf:"bytes,1,opt,name=create_policy,json=createPolicy,proto3" json:"create_policy"`
 }
 
-func (m *AccessListTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.V) > 0 {
-		i -= len(m.V)
-		copy(dAtA[i:], m.V)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Accesses) > 0 {
-		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Amount != nil {
-		{
-			size := m.Amount.Size()
-			i -= size
-			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgEditCreateAllowlistResponse) Reset()         { *m = MsgEditCreateAllowlistResponse{} }
+func (m *MsgEditCreateAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEditCreateAllowlistResponse) ProtoMessage()    {}
+func (*MsgEditCreateAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{21}
+}
+func (m *MsgEditCreateAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditCreateAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditCreateAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if m.GasPrice != nil {
-		{
-			size := m.GasPrice.Size()
-			i -= size
-			if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgEditCreateAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditCreateAllowlistResponse.Merge(m, src)
+}
+func (m *MsgEditCreateAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditCreateAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditCreateAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEditCreateAllowlistResponse proto.InternalMessageInfo
+
+func (m *MsgEditCreateAllowlistResponse) GetCreatePolicy() CreatePolicy {
+	if m != nil {
+		return m.CreatePolicy
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
+	return CreatePolicy{}
+}
+
+func init() {
+	proto.RegisterType((*MsgEthereumTx)(nil), "eth.evm.v1.MsgEthereumTx")
+	proto.RegisterType((*LegacyTx)(nil), "eth.evm.v1.LegacyTx")
+	proto.RegisterType((*AccessListTx)(nil), "eth.evm.v1.AccessListTx")
+	proto.RegisterType((*DynamicFeeTx)(nil), "eth.evm.v1.DynamicFeeTx")
+	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "eth.evm.v1.ExtensionOptionsEthereumTx")
+	proto.RegisterType((*MsgEthereumTxResponse)(nil), "eth.evm.v1.MsgEthereumTxResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "eth.evm.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "eth.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateFunToken)(nil), "eth.evm.v1.MsgCreateFunToken")
+	proto.RegisterType((*MsgCreateFunTokenResponse)(nil), "eth.evm.v1.MsgCreateFunTokenResponse")
+	proto.RegisterType((*MsgSendFunTokenToEvm)(nil), "eth.evm.v1.MsgSendFunTokenToEvm")
+	proto.RegisterType((*MsgSendFunTokenToEvmResponse)(nil), "eth.evm.v1.MsgSendFunTokenToEvmResponse")
+	proto.RegisterType((*MsgConvertEvmToCoin)(nil), "eth.evm.v1.MsgConvertEvmToCoin")
+	proto.RegisterType((*MsgConvertEvmToCoinResponse)(nil), "eth.evm.v1.MsgConvertEvmToCoinResponse")
+	proto.RegisterType((*MsgSetFunTokenPaused)(nil), "eth.evm.v1.MsgSetFunTokenPaused")
+	proto.RegisterType((*MsgSetFunTokenPausedResponse)(nil), "eth.evm.v1.MsgSetFunTokenPausedResponse")
+	proto.RegisterType((*MsgUnregisterFunToken)(nil), "eth.evm.v1.MsgUnregisterFunToken")
+	proto.RegisterType((*MsgUnregisterFunTokenResponse)(nil), "eth.evm.v1.MsgUnregisterFunTokenResponse")
+	proto.RegisterType((*MsgRefreshFunTokenMetadata)(nil), "eth.evm.v1.MsgRefreshFunTokenMetadata")
+	proto.RegisterType((*MsgRefreshFunTokenMetadataResponse)(nil), "eth.evm.v1.MsgRefreshFunTokenMetadataResponse")
+	proto.RegisterType((*MsgEditCreateAllowlist)(nil), "eth.evm.v1.MsgEditCreateAllowlist")
+	proto.RegisterType((*MsgEditCreateAllowlistResponse)(nil), "eth.evm.v1.MsgEditCreateAllowlistResponse")
+}
+
+func init() { proto.RegisterFile("eth/evm/v1/tx.proto", fileDescriptor_82a0bfe4f0bab953) }
+
+var fileDescriptor_82a0bfe4f0bab953 = []byte{
+	// 1591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x8e, 0x3f, 0x9e, 0x9d, 0x8f, 0x6e, 0xd3, 0xc6, 0x76, 0x9b, 0x38, 0xdd, 0x40,
+	0x69, 0x2b, 0xc5, 0x6e, 0x82, 0x40, 0x6a, 0x24, 0x0e, 0x71, 0x92, 0xd2, 0xa2, 0x16, 0xc2, 0x36,
+	0xe1, 0xc0, 0xc5, 0x5a, 0xaf, 0x27, 0xf6, 0xaa, 0xde, 0x9d, 0x65, 0x77, 0x6d, 0x1c, 0x6e, 0xf4,
+	0x80, 0x2a, 0x71, 0x00, 0xc4, 0x85, 0x13, 0xe2, 0xc2, 0xa5, 0x27, 0x0e, 0x3d, 0xf0, 0x27, 0x54,
+	0x9c, 0x2a, 0x7a, 0xa9, 0x38, 0x04, 0x54, 0x90, 0x90, 0x38, 0x72, 0xe0, 0xcc, 0x9b, 0xd9, 0xd9,
+	0x8d, 0xbd, 0xb6, 0x93, 0xb6, 0xaa, 0x72, 0x58, 0x65, 0x66, 0xde, 0xc7, 0xbc, 0xf7, 0xfb, 0xbd,
+	0x7d, 0x6f, 0x1d, 0x38, 0x4d, 0xbc, 0x66, 0x99, 0x74, 0xcc, 0x72, 0x67, 0xa5, 0xec, 0x75, 0x4b,
+	0xb6, 0x43, 0x3d, 0x2a, 0x03, 0x1e, 0x96, 0xf0, 0xb0, 0xd4, 0x59, 0x29, 0xcc, 0xe9, 0xd4, 0x35,
+	0xa9, 0x5b, 0x36, 0xdd, 0x06, 0xd3, 0xc1, 0x3f, 0xbe, 0x52, 0x61, 0x41, 0x08, 0x6a, 0x9a, 0x75,
+	0x17, 0x25, 0x35, 0xe2, 0x69, 0x2b, 0x7c, 0x33, 0x20, 0x77, 0x49, 0x28, 0xd7, 0xa9, 0x61, 0x09,
+	0x79, 0xde, 0x97, 0x57, 0xf9, 0xae, 0xec, 0x6f, 0x84, 0x68, 0xb6, 0x27, 0x28, 0x16, 0x86, 0x38,
+	0x6d, 0xd0, 0x06, 0xf5, 0xb5, 0xd9, 0x4a, 0x9c, 0x9e, 0x6f, 0x50, 0xda, 0x68, 0x91, 0xb2, 0x66,
+	0x1b, 0x65, 0xcd, 0xb2, 0xa8, 0xa7, 0x79, 0x06, 0xb5, 0x02, 0x4f, 0x79, 0x21, 0xe5, 0xbb, 0x5a,
+	0x7b, 0x0f, 0x55, 0xf6, 0x7d, 0x91, 0xf2, 0x95, 0x04, 0x93, 0xb7, 0xdd, 0xc6, 0x96, 0xd7, 0x24,
+	0x0e, 0x69, 0x9b, 0x3b, 0x5d, 0xf9, 0x12, 0xc4, 0xeb, 0x9a, 0xa7, 0xe5, 0xa4, 0x45, 0xe9, 0x52,
+	0x66, 0x75, 0xb6, 0xe4, 0xdb, 0x96, 0x02, 0xdb, 0xd2, 0xba, 0xb5, 0xaf, 0x72, 0x0d, 0x39, 0x0f,
+	0x71, 0xd7, 0xf8, 0x8c, 0xe4, 0xc6, 0x51, 0x53, 0xaa, 0x4c, 0xfc, 0x73, 0x50, 0x94, 0x96, 0x55,
+	0x7e, 0x24, 0x17, 0x21, 0xde, 0xd4, 0xdc, 0x66, 0x2e, 0x86, 0xa2, 0x74, 0x25, 0xf3, 0xef, 0x41,
+	0x31, 0xe9, 0xb4, 0xec, 0x35, 0x65, 0x59, 0x51, 0xb9, 0x40, 0x96, 0x21, 0xbe, 0xe7, 0x50, 0x33,
+	0x17, 0x67, 0x0a, 0x2a, 0x5f, 0xaf, 0xc5, 0xef, 0xff, 0x50, 0x1c, 0x53, 0xbe, 0x19, 0x87, 0xd4,
+	0x2d, 0xd2, 0xd0, 0xf4, 0x7d, 0x0c, 0x66, 0x16, 0x26, 0x2c, 0x6a, 0xe9, 0x84, 0x47, 0x13, 0x57,
+	0xfd, 0x8d, 0xfc, 0x36, 0xa4, 0x1b, 0x1a, 0xc3, 0xcc, 0xd0, 0xfd, 0xdb, 0xd3, 0x95, 0xfc, 0x6f,
+	0x07, 0xc5, 0x33, 0x3e, 0x7c, 0x6e, 0xfd, 0x6e, 0xc9, 0xa0, 0x65, 0x53, 0x43, 0xfa, 0x6e, 0x5a,
+	0x9e, 0x9a, 0x42, 0xdd, 0x6d, 0xa6, 0x2a, 0x2f, 0x40, 0x0c, 0xd7, 0x3c, 0xa8, 0x78, 0x25, 0xfb,
+	0xec, 0xa0, 0x98, 0x7a, 0x57, 0x73, 0x6f, 0x19, 0xa6, 0xe1, 0xa9, 0x4c, 0x20, 0x4f, 0xc1, 0xb8,
+	0x47, 0x45, 0x48, 0xb8, 0x92, 0xaf, 0xc1, 0x44, 0x47, 0x6b, 0xb5, 0x49, 0x6e, 0x82, 0xdf, 0xb1,
+	0x34, 0xf2, 0x0e, 0x74, 0x95, 0x58, 0x37, 0x69, 0x1b, 0x6f, 0xf3, 0x2d, 0x58, 0x7e, 0x1c, 0xc5,
+	0x04, 0x5a, 0x66, 0x05, 0x5e, 0x59, 0x90, 0x3a, 0xb9, 0x24, 0x3f, 0x90, 0x3a, 0x6c, 0xe7, 0xe4,
+	0x52, 0xfe, 0xce, 0x61, 0x3b, 0x37, 0x97, 0xf6, 0x77, 0xee, 0xda, 0x14, 0x43, 0xe2, 0x97, 0x87,
+	0xcb, 0x89, 0x9d, 0xee, 0x26, 0x5a, 0x2a, 0x3f, 0xc7, 0x20, 0xbb, 0xae, 0xeb, 0xc4, 0xc5, 0x68,
+	0x5d, 0x0f, 0x71, 0x79, 0x0f, 0x52, 0x7a, 0x53, 0x33, 0xac, 0xaa, 0x51, 0xe7, 0xd0, 0xa4, 0x2b,
+	0xe5, 0xa3, 0x82, 0x4b, 0x6e, 0x30, 0xe5, 0x9b, 0x9b, 0x48, 0x51, 0x52, 0xf7, 0x97, 0xaa, 0x58,
+	0xd4, 0x0f, 0x31, 0x1e, 0x1f, 0x89, 0x71, 0xec, 0x85, 0x31, 0x8e, 0x1f, 0x8d, 0xf1, 0xc4, 0x20,
+	0xc6, 0x89, 0x97, 0xc6, 0x38, 0xd9, 0x83, 0xf1, 0x2e, 0xa4, 0x34, 0x0e, 0x14, 0x71, 0x11, 0xdc,
+	0x18, 0x56, 0xf0, 0x5c, 0xe9, 0xf0, 0x3d, 0x2e, 0xf9, 0x20, 0xee, 0xb4, 0xed, 0x16, 0xa9, 0x2c,
+	0x3e, 0x3a, 0x28, 0x8e, 0x21, 0x22, 0xa0, 0x85, 0xc8, 0x3e, 0xf8, 0xbd, 0x08, 0x87, 0x38, 0xab,
+	0xa1, 0x2b, 0x9f, 0xba, 0x74, 0x1f, 0x75, 0xd0, 0x47, 0x5d, 0x66, 0x14, 0x75, 0xff, 0x21, 0x75,
+	0x9b, 0xfb, 0x96, 0x66, 0x1a, 0xfa, 0x75, 0x42, 0x4e, 0x84, 0xba, 0x6b, 0x90, 0x61, 0xd4, 0x79,
+	0x86, 0x5d, 0xd5, 0x35, 0xfb, 0x78, 0xf2, 0x18, 0xd1, 0x3b, 0x86, 0xbd, 0xa1, 0xd9, 0x81, 0xe9,
+	0x1e, 0x21, 0xdc, 0x34, 0xfe, 0x3c, 0xa6, 0x98, 0x16, 0x33, 0x15, 0xc4, 0x4f, 0x1c, 0x4d, 0x7c,
+	0x62, 0x90, 0xf8, 0xe4, 0x4b, 0x13, 0x9f, 0x1a, 0x41, 0x7c, 0xfa, 0x15, 0x13, 0x0f, 0x7d, 0xc4,
+	0x67, 0xfa, 0x88, 0xcf, 0x8e, 0x22, 0x5e, 0x81, 0xc2, 0x56, 0xd7, 0x23, 0x96, 0x8b, 0x8d, 0xf8,
+	0x03, 0x9b, 0xb7, 0xe3, 0xc3, 0x2e, 0x2b, 0x7a, 0xdd, 0xf7, 0x12, 0x9c, 0xe9, 0xeb, 0xbe, 0x2a,
+	0x71, 0x6d, 0x54, 0xe4, 0x29, 0xf2, 0x06, 0x2a, 0xf9, 0xfd, 0x91, 0xf7, 0xcc, 0x25, 0x88, 0xb7,
+	0x68, 0xc3, 0x45, 0xb2, 0x59, 0x7a, 0xd3, 0xbd, 0xe9, 0xdd, 0xa2, 0x0d, 0x95, 0x0b, 0xe5, 0x19,
+	0x88, 0x39, 0xc4, 0xe3, 0xa4, 0x67, 0x55, 0xb6, 0xc4, 0x36, 0x9d, 0xea, 0x98, 0x55, 0xe2, 0x38,
+	0xd4, 0x11, 0xbd, 0x2d, 0xd9, 0x31, 0xb7, 0xd8, 0x96, 0x89, 0x18, 0xdd, 0x6d, 0x97, 0xd4, 0x7d,
+	0xe2, 0xd4, 0x24, 0xee, 0x77, 0x71, 0x2b, 0x02, 0xfc, 0x52, 0x82, 0x69, 0x0c, 0x70, 0xd7, 0x46,
+	0x8c, 0xc9, 0xb6, 0xe6, 0x68, 0xa6, 0xcb, 0x3a, 0x83, 0xd6, 0xf6, 0x9a, 0xd4, 0x31, 0xbc, 0x7d,
+	0x51, 0xc1, 0xb9, 0x5f, 0x1f, 0x2e, 0xcf, 0x8a, 0xe1, 0xb5, 0x5e, 0xaf, 0x3b, 0x88, 0xdd, 0x1d,
+	0xcf, 0x31, 0xac, 0x86, 0x7a, 0xa8, 0x2a, 0x5f, 0x85, 0x84, 0xcd, 0x3d, 0xf0, 0x6a, 0xcd, 0xac,
+	0xca, 0xbd, 0x09, 0xf8, 0xbe, 0x2b, 0x71, 0x46, 0x8d, 0x2a, 0xf4, 0xd6, 0xa6, 0xee, 0xfd, 0xfd,
+	0xd3, 0x95, 0x43, 0x0f, 0x4a, 0x1e, 0xe6, 0x22, 0xc1, 0x04, 0x78, 0x29, 0x3f, 0x4a, 0x70, 0x0a,
+	0x65, 0x1b, 0x0e, 0x41, 0xd9, 0xf5, 0xb6, 0xb5, 0x43, 0xef, 0x12, 0x4b, 0xde, 0x06, 0x60, 0x93,
+	0x05, 0x93, 0xd7, 0x57, 0xaf, 0x8a, 0x58, 0x57, 0xf0, 0x0a, 0x09, 0x8b, 0xed, 0x72, 0xc3, 0xf0,
+	0x9a, 0xed, 0x5a, 0x49, 0xa7, 0x66, 0xf9, 0x7d, 0xa3, 0x66, 0x38, 0x6d, 0xfe, 0xa6, 0x95, 0x2d,
+	0xbe, 0x2e, 0xb3, 0xd8, 0x6e, 0x90, 0x2e, 0xcb, 0x46, 0x4d, 0x33, 0x27, 0x5b, 0xcc, 0x87, 0x7c,
+	0x11, 0xa6, 0xb9, 0x47, 0x36, 0xe2, 0xab, 0x75, 0x62, 0xe1, 0x08, 0xe3, 0x03, 0x48, 0x9d, 0x64,
+	0xc7, 0x15, 0x3c, 0xdd, 0x64, 0x87, 0xf2, 0x59, 0x48, 0xb8, 0xc4, 0xaa, 0x13, 0xc7, 0x7f, 0xfd,
+	0x54, 0xb1, 0x53, 0x6a, 0x90, 0x1f, 0x08, 0x33, 0x24, 0x7d, 0x0b, 0x66, 0xf6, 0xb0, 0xcc, 0xd9,
+	0x59, 0xd5, 0xd4, 0x6c, 0x1b, 0x01, 0x0c, 0xc7, 0x70, 0x0f, 0x56, 0x81, 0x9d, 0x40, 0x6b, 0x3a,
+	0xb0, 0xb9, 0xed, 0x9b, 0x28, 0x4f, 0x24, 0x98, 0xc5, 0x4b, 0xee, 0xe0, 0x8d, 0x81, 0xea, 0x0e,
+	0xdd, 0xea, 0x98, 0xf2, 0x87, 0x90, 0xf1, 0x68, 0x15, 0x3d, 0x55, 0x35, 0x4c, 0xab, 0x07, 0x8f,
+	0xb1, 0x17, 0xc4, 0xc3, 0xa3, 0x58, 0xb2, 0x6c, 0xd9, 0x93, 0xe7, 0x78, 0x6f, 0x9e, 0x88, 0x7c,
+	0x9a, 0x43, 0xc4, 0x3e, 0x75, 0x38, 0x04, 0x99, 0xd5, 0x7c, 0x49, 0x54, 0x08, 0xfb, 0x16, 0x2a,
+	0x89, 0x6f, 0xa1, 0xd2, 0x06, 0x2a, 0x54, 0x72, 0x2c, 0x06, 0xfc, 0x48, 0x98, 0xd9, 0xd7, 0xcc,
+	0xd6, 0x9a, 0x12, 0x5a, 0x2a, 0x6a, 0x8a, 0xad, 0x99, 0x8e, 0xb2, 0x00, 0xe7, 0x87, 0x25, 0x15,
+	0x56, 0xc0, 0x53, 0x09, 0x4e, 0x33, 0x68, 0xa9, 0xd5, 0x21, 0x8e, 0x87, 0x92, 0x1d, 0xca, 0xec,
+	0x7a, 0x22, 0x94, 0x22, 0x11, 0x02, 0x2f, 0x0b, 0x1f, 0x8b, 0xf1, 0x97, 0xc6, 0x82, 0x3b, 0xe1,
+	0x58, 0xbc, 0x05, 0x09, 0x8d, 0xf7, 0x29, 0xd1, 0x72, 0xe7, 0x85, 0xb7, 0x11, 0xbd, 0x53, 0x28,
+	0xcb, 0x8b, 0x90, 0x45, 0x56, 0x78, 0xce, 0x3c, 0x14, 0xff, 0x1d, 0x05, 0x8f, 0xb2, 0x6a, 0x62,
+	0x8e, 0x15, 0x0a, 0xe7, 0x86, 0x64, 0x16, 0x96, 0x4d, 0x1f, 0xd6, 0xd2, 0xab, 0xc0, 0xfa, 0xbb,
+	0xa0, 0x82, 0xbc, 0x00, 0xeb, 0x6d, 0x8d, 0x35, 0x89, 0x13, 0x04, 0xf3, 0x2c, 0xeb, 0x16, 0xbc,
+	0x31, 0x31, 0x30, 0x53, 0xaa, 0xd8, 0x29, 0x44, 0x94, 0x41, 0x24, 0xb2, 0x57, 0xfd, 0x0e, 0x7d,
+	0xee, 0x77, 0xe6, 0x5d, 0xcb, 0x21, 0x0d, 0x9c, 0x08, 0xc4, 0x09, 0x7b, 0xca, 0x89, 0x41, 0xa0,
+	0x14, 0x61, 0x7e, 0x68, 0x08, 0x61, 0xc9, 0x7f, 0x21, 0x41, 0x01, 0x35, 0x54, 0xb2, 0x87, 0x1d,
+	0xb7, 0x19, 0x88, 0x6f, 0x23, 0xd7, 0x7c, 0x24, 0x9e, 0x5c, 0xa4, 0x16, 0x28, 0xa3, 0xe3, 0x08,
+	0xa9, 0xb9, 0x01, 0x93, 0xbc, 0xda, 0x4c, 0x21, 0x10, 0xbc, 0xcc, 0x1f, 0xd6, 0x2a, 0xfe, 0x6c,
+	0x0a, 0x6a, 0x35, 0xb0, 0x16, 0x04, 0x65, 0x99, 0x30, 0x38, 0x53, 0x6c, 0x38, 0xcb, 0xc6, 0x66,
+	0xdd, 0xf0, 0xfc, 0x4e, 0xba, 0xde, 0x6a, 0xd1, 0x4f, 0x5b, 0x08, 0xd2, 0xc8, 0x9c, 0xcf, 0xe1,
+	0xd0, 0xaa, 0xd7, 0x79, 0xc6, 0xfe, 0x00, 0x4d, 0xe3, 0x90, 0xaf, 0xd7, 0x59, 0xf4, 0xae, 0x7c,
+	0x01, 0xb2, 0x0e, 0x31, 0x69, 0x87, 0x08, 0x79, 0x8c, 0xcb, 0x33, 0xfe, 0x19, 0x57, 0xc1, 0xb2,
+	0x5b, 0x18, 0x7e, 0x63, 0x98, 0xdd, 0x06, 0x4c, 0xea, 0x5c, 0x54, 0xb5, 0x69, 0xcb, 0xd0, 0xf7,
+	0x45, 0x76, 0xb9, 0xde, 0xaa, 0xf3, 0x6d, 0xb7, 0xb9, 0x3c, 0x48, 0x4c, 0xef, 0x39, 0x5b, 0x7d,
+	0x90, 0x84, 0x18, 0xde, 0x23, 0x5b, 0x00, 0x3d, 0x3f, 0xc9, 0xf2, 0xbd, 0x3e, 0xfa, 0xbe, 0x17,
+	0x0a, 0x17, 0x46, 0x8a, 0xc2, 0x2a, 0x51, 0xee, 0x3d, 0xf9, 0xeb, 0xdb, 0xf1, 0xf3, 0x4a, 0x21,
+	0x24, 0x51, 0xfc, 0xa6, 0x14, 0xaa, 0x55, 0xaf, 0x8b, 0x25, 0x91, 0xed, 0x9b, 0xf1, 0xe7, 0x22,
+	0x6e, 0x7b, 0x85, 0x85, 0xa5, 0x23, 0x84, 0x21, 0x1c, 0x1f, 0xc1, 0x54, 0x64, 0x18, 0xcf, 0x47,
+	0xcc, 0xfa, 0xc5, 0x85, 0xd7, 0x8f, 0x14, 0x87, 0x7e, 0xab, 0x70, 0x6a, 0x70, 0xb0, 0x2d, 0x46,
+	0x6c, 0x07, 0x34, 0x0a, 0x97, 0x8e, 0xd3, 0x08, 0x2f, 0xb8, 0x2f, 0xc1, 0xcc, 0xc0, 0x10, 0x29,
+	0x46, 0x83, 0x8b, 0x28, 0x14, 0xde, 0x38, 0x46, 0x21, 0x64, 0xe3, 0x0a, 0x67, 0xe3, 0x35, 0x45,
+	0x89, 0xb0, 0xa1, 0xfb, 0x06, 0x55, 0xdc, 0x56, 0x71, 0x1c, 0xb0, 0x8e, 0xec, 0xe7, 0x1a, 0x6d,
+	0xc1, 0x83, 0xb9, 0x46, 0x34, 0x86, 0xe4, 0x3a, 0xaa, 0x59, 0xd6, 0x40, 0x1e, 0xd2, 0xe1, 0xa2,
+	0x35, 0x35, 0xa8, 0x52, 0xb8, 0x7c, 0xac, 0x4a, 0x78, 0xc7, 0x27, 0x30, 0x37, 0xaa, 0x41, 0x5d,
+	0x8c, 0x78, 0x19, 0xa1, 0x57, 0x28, 0x3d, 0x9f, 0x5e, 0x78, 0x25, 0x81, 0xd3, 0xc3, 0x7a, 0x83,
+	0x12, 0x7d, 0x57, 0x06, 0x75, 0x0a, 0x57, 0x8e, 0xd7, 0x09, 0xae, 0xa9, 0xbc, 0xf3, 0xe8, 0xd9,
+	0x82, 0xf4, 0x18, 0x9f, 0x3f, 0xf0, 0xf9, 0xfa, 0xcf, 0x85, 0xb1, 0xc7, 0xf8, 0x3c, 0xc5, 0xe7,
+	0xe3, 0xa5, 0xa3, 0xbb, 0x68, 0x97, 0x91, 0x5e, 0x4b, 0xf0, 0x7f, 0xa9, 0xbc, 0xf9, 0x3f, 0xd6,
+	0x80, 0x45, 0x69, 0x7d, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// EthereumTx defines a method submitting Ethereum transactions.
+	EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
+	// UpdateParams defined a governance operation for updating the x/evm module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateFunToken: Create a "FunToken" mapping. Either the ERC20 contract
+	// address can be given to create the mapping to a bank coin, or the
+	// denomination for a bank coin can be given to create the mapping to an ERC20.
+	CreateFunToken(ctx context.Context, in *MsgCreateFunToken, opts ...grpc.CallOption) (*MsgCreateFunTokenResponse, error)
+	// SendFunTokenToEvm: Sends a coin with a valid "FunToken" mapping to the
+	// given recipient address ("to_eth_addr") in the corresponding ERC20
+	// representation.
+	SendFunTokenToEvm(ctx context.Context, in *MsgSendFunTokenToEvm, opts ...grpc.CallOption) (*MsgSendFunTokenToEvmResponse, error)
+	// ConvertEvmToCoin: Converts ERC20 tokens held by the sender's Ethereum
+	// address into the bank coin given by the ERC20's "FunToken" mapping. The
+	// coins are sent to the recipient address ("to_bank_addr").
+	ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error)
+	// SetFunTokenPaused: [SUDO] Pauses or resumes the conversions of a
+	// "FunToken" mapping. Only callable by the x/sudo root.
+	SetFunTokenPaused(ctx context.Context, in *MsgSetFunTokenPaused, opts ...grpc.CallOption) (*MsgSetFunTokenPausedResponse, error)
+	// UnregisterFunToken: [SUDO] Deletes a "FunToken" mapping once no tokens
+	// are left in the converted representation. Only callable by the x/sudo
+	// root.
+	UnregisterFunToken(ctx context.Context, in *MsgUnregisterFunToken, opts ...grpc.CallOption) (*MsgUnregisterFunTokenResponse, error)
+	// RefreshFunTokenMetadata: [SUDO] Re-syncs the bank metadata of a
+	// "FunToken" mapping created from an ERC20 with the name, symbol, and
+	// decimals of the ERC20. Only callable by the x/sudo root.
+	RefreshFunTokenMetadata(ctx context.Context, in *MsgRefreshFunTokenMetadata, opts ...grpc.CallOption) (*MsgRefreshFunTokenMetadataResponse, error)
+	// EditCreateAllowlist: [SUDO] Adds and removes addresses from the allowlist
+	// of the "create_policy" param. Only callable by the x/sudo root.
+	EditCreateAllowlist(ctx context.Context, in *MsgEditCreateAllowlist, opts ...grpc.CallOption) (*MsgEditCreateAllowlistResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error) {
+	out := new(MsgEthereumTxResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/EthereumTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ChainID != nil {
-		{
-			size := m.ChainID.Size()
-			i -= size
-			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DynamicFeeTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateFunToken(ctx context.Context, in *MsgCreateFunToken, opts ...grpc.CallOption) (*MsgCreateFunTokenResponse, error) {
+	out := new(MsgCreateFunTokenResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/CreateFunToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DynamicFeeTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SendFunTokenToEvm(ctx context.Context, in *MsgSendFunTokenToEvm, opts ...grpc.CallOption) (*MsgSendFunTokenToEvmResponse, error) {
+	out := new(MsgSendFunTokenToEvmResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SendFunTokenToEvm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DynamicFeeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x62
+func (c *msgClient) ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error) {
+	out := new(MsgConvertEvmToCoinResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertEvmToCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
-		i--
-		dAtA[i] = 0x5a
+	return out, nil
+}
+
+func (c *msgClient) SetFunTokenPaused(ctx context.Context, in *MsgSetFunTokenPaused, opts ...grpc.CallOption) (*MsgSetFunTokenPausedResponse, error) {
+	out := new(MsgSetFunTokenPausedResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SetFunTokenPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.V) > 0 {
-		i -= len(m.V)
-		copy(dAtA[i:], m.V)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
-		i--
-		dAtA[i] = 0x52
+	return out, nil
+}
+
+func (c *msgClient) UnregisterFunToken(ctx context.Context, in *MsgUnregisterFunToken, opts ...grpc.CallOption) (*MsgUnregisterFunTokenResponse, error) {
+	out := new(MsgUnregisterFunTokenResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/UnregisterFunToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Accesses) > 0 {
-		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	return out, nil
+}
+
+func (c *msgClient) RefreshFunTokenMetadata(ctx context.Context, in *MsgRefreshFunTokenMetadata, opts ...grpc.CallOption) (*MsgRefreshFunTokenMetadataResponse, error) {
+	out := new(MsgRefreshFunTokenMetadataResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/RefreshFunTokenMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x42
+	return out, nil
+}
+
+func (c *msgClient) EditCreateAllowlist(ctx context.Context, in *MsgEditCreateAllowlist, opts ...grpc.CallOption) (*MsgEditCreateAllowlistResponse, error) {
+	out := new(MsgEditCreateAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/EditCreateAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Amount != nil {
-		{
-			size := m.Amount.Size()
-			i -= size
-			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.GasFeeCap != nil {
-		{
-			size := m.GasFeeCap.Size()
-			i -= size
-			if _, err := m.GasFeeCap.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.GasTipCap != nil {
-		{
-			size := m.GasTipCap.Size()
-			i -= size
-			if _, err := m.GasTipCap.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainID != nil {
-		{
-			size := m.ChainID.Size()
-			i -= size
-			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// EthereumTx defines a method submitting Ethereum transactions.
+	EthereumTx(context.Context, *MsgEthereumTx) (*MsgEthereumTxResponse, error)
+	// UpdateParams defined a governance operation for updating the x/evm module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateFunToken: Create a "FunToken" mapping. Either the ERC20 contract
+	// address can be given to create the mapping to a bank coin, or the
+	// denomination for a bank coin can be given to create the mapping to an ERC20.
+	CreateFunToken(context.Context, *MsgCreateFunToken) (*MsgCreateFunTokenResponse, error)
+	// SendFunTokenToEvm: Sends a coin with a valid "FunToken" mapping to the
+	// given recipient address ("to_eth_addr") in the corresponding ERC20
+	// representation.
+	SendFunTokenToEvm(context.Context, *MsgSendFunTokenToEvm) (*MsgSendFunTokenToEvmResponse, error)
+	// ConvertEvmToCoin: Converts ERC20 tokens held by the sender's Ethereum
+	// address into the bank coin given by the ERC20's "FunToken" mapping. The
+	// coins are sent to the recipient address ("to_bank_addr").
+	ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error)
+	// SetFunTokenPaused: [SUDO] Pauses or resumes the conversions of a
+	// "FunToken" mapping. Only callable by the x/sudo root.
+	SetFunTokenPaused(context.Context, *MsgSetFunTokenPaused) (*MsgSetFunTokenPausedResponse, error)
+	// UnregisterFunToken: [SUDO] Deletes a "FunToken" mapping once no tokens
+	// are left in the converted representation. Only callable by the x/sudo
+	// root.
+	UnregisterFunToken(context.Context, *MsgUnregisterFunToken) (*MsgUnregisterFunTokenResponse, error)
+	// RefreshFunTokenMetadata: [SUDO] Re-syncs the bank metadata of a
+	// "FunToken" mapping created from an ERC20 with the name, symbol, and
+	// decimals of the ERC20. Only callable by the x/sudo root.
+	RefreshFunTokenMetadata(context.Context, *MsgRefreshFunTokenMetadata) (*MsgRefreshFunTokenMetadataResponse, error)
+	// EditCreateAllowlist: [SUDO] Adds and removes addresses from the allowlist
+	// of the "create_policy" param. Only callable by the x/sudo root.
+	EditCreateAllowlist(context.Context, *MsgEditCreateAllowlist) (*MsgEditCreateAllowlistResponse, error)
 }
 
-func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumTx) (*MsgEthereumTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumTx not implemented")
 }
-
-func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateFunToken(ctx context.Context, req *MsgCreateFunToken) (*MsgCreateFunTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFunToken not implemented")
+}
+func (*UnimplementedMsgServer) SendFunTokenToEvm(ctx context.Context, req *MsgSendFunTokenToEvm) (*MsgSendFunTokenToEvmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFunTokenToEvm not implemented")
+}
+func (*UnimplementedMsgServer) ConvertEvmToCoin(ctx context.Context, req *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertEvmToCoin not implemented")
+}
+func (*UnimplementedMsgServer) SetFunTokenPaused(ctx context.Context, req *MsgSetFunTokenPaused) (*MsgSetFunTokenPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFunTokenPaused not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterFunToken(ctx context.Context, req *MsgUnregisterFunToken) (*MsgUnregisterFunTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterFunToken not implemented")
+}
+func (*UnimplementedMsgServer) RefreshFunTokenMetadata(ctx context.Context, req *MsgRefreshFunTokenMetadata) (*MsgRefreshFunTokenMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshFunTokenMetadata not implemented")
+}
+func (*UnimplementedMsgServer) EditCreateAllowlist(ctx context.Context, req *MsgEditCreateAllowlist) (*MsgEditCreateAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditCreateAllowlist not implemented")
 }
 
-func (m *MsgEthereumTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasUsed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.VmError) > 0 {
-		i -= len(m.VmError)
-		copy(dAtA[i:], m.VmError)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_EthereumTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumTx)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Ret) > 0 {
-		i -= len(m.Ret)
-		copy(dAtA[i:], m.Ret)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).EthereumTx(ctx, in)
 	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/EthereumTx",
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EthereumTx(ctx, req.(*MsgEthereumTx))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/UpdateParams",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateFunToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFunToken)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFunToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/CreateFunToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFunToken(ctx, req.(*MsgCreateFunToken))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SendFunTokenToEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendFunTokenToEvm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendFunTokenToEvm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/SendFunTokenToEvm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendFunTokenToEvm(ctx, req.(*MsgSendFunTokenToEvm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateFunToken) Marshal() (dAtA []byte, err error) {
+func _Msg_ConvertEvmToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertEvmToCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertEvmToCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, req.(*MsgConvertEvmToCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFunTokenPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFunTokenPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFunTokenPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/SetFunTokenPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFunTokenPaused(ctx, req.(*MsgSetFunTokenPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterFunToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterFunToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterFunToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/UnregisterFunToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterFunToken(ctx, req.(*MsgUnregisterFunToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefreshFunTokenMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefreshFunTokenMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefreshFunTokenMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/RefreshFunTokenMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefreshFunTokenMetadata(ctx, req.(*MsgRefreshFunTokenMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditCreateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditCreateAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditCreateAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/EditCreateAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditCreateAllowlist(ctx, req.(*MsgEditCreateAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eth.evm.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EthereumTx",
+			Handler:    _Msg_EthereumTx_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateFunToken",
+			Handler:    _Msg_CreateFunToken_Handler,
+		},
+		{
+			MethodName: "SendFunTokenToEvm",
+			Handler:    _Msg_SendFunTokenToEvm_Handler,
+		},
+		{
+			MethodName: "ConvertEvmToCoin",
+			Handler:    _Msg_ConvertEvmToCoin_Handler,
+		},
+		{
+			MethodName: "SetFunTokenPaused",
+			Handler:    _Msg_SetFunTokenPaused_Handler,
+		},
+		{
+			MethodName: "UnregisterFunToken",
+			Handler:    _Msg_UnregisterFunToken_Handler,
+		},
+		{
+			MethodName: "RefreshFunTokenMetadata",
+			Handler:    _Msg_RefreshFunTokenMetadata_Handler,
+		},
+		{
+			MethodName: "EditCreateAllowlist",
+			Handler:    _Msg_EditCreateAllowlist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eth/evm/v1/tx.proto",
+}
+
+func (m *MsgEthereumTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1498,37 +1692,43 @@ func (m *MsgCreateFunToken) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateFunToken) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEthereumTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateFunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.FromBankDenom) > 0 {
-		i -= len(m.FromBankDenom)
-		copy(dAtA[i:], m.FromBankDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromBankDenom)))
+	if m.Size_ != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Size_))))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x11
 	}
-	if m.FromErc20 != nil {
+	if m.Data != nil {
 		{
-			size := m.FromErc20.Size()
-			i -= size
-			if _, err := m.FromErc20.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
@@ -1537,7 +1737,7 @@ func (m *MsgCreateFunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateFunTokenResponse) Marshal() (dAtA []byte, err error) {
+func (m *LegacyTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1547,30 +1747,89 @@ func (m *MsgCreateFunTokenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateFunTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LegacyTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateFunTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LegacyTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.FuntokenMapping.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasPrice != nil {
+		{
+			size := m.GasPrice.Size()
+			i -= size
+			if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendFunTokenToEvm) Marshal() (dAtA []byte, err error) {
+func (m *AccessListTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1580,47 +1839,115 @@ func (m *MsgSendFunTokenToEvm) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendFunTokenToEvm) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccessListTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendFunTokenToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccessListTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x5a
 	}
-	{
-		size := m.ToEthAddr.Size()
-		i -= size
-		if _, err := m.ToEthAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Accesses) > 0 {
+		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasPrice != nil {
+		{
+			size := m.GasPrice.Size()
+			i -= size
+			if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendFunTokenToEvmResponse) Marshal() (dAtA []byte, err error) {
+func (m *DynamicFeeTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1630,336 +1957,1773 @@ func (m *MsgSendFunTokenToEvmResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendFunTokenToEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DynamicFeeTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendFunTokenToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DynamicFeeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.Size_ != 0 {
-		n += 9
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Accesses) > 0 {
+		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	return n
-}
-
-func (m *LegacyTx) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GasFeeCap != nil {
+		{
+			size := m.GasFeeCap.Size()
+			i -= size
+			if _, err := m.GasFeeCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.V)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GasTipCap != nil {
+		{
+			size := m.GasTipCap.Size()
+			i -= size
+			if _, err := m.GasTipCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.R)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.S)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AccessListTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainID != nil {
-		l = m.ChainID.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
-	}
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Accesses) > 0 {
-		for _, e := range m.Accesses {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.V)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.R)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.S)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DynamicFeeTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainID != nil {
-		l = m.ChainID.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
-	}
-	if m.GasTipCap != nil {
-		l = m.GasTipCap.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GasFeeCap != nil {
-		l = m.GasFeeCap.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Accesses) > 0 {
-		for _, e := range m.Accesses {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.V)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.R)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.S)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExtensionOptionsEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEthereumTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateFunToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFunToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.Ret)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.VmError)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.FromBankDenom) > 0 {
+		i -= len(m.FromBankDenom)
+		copy(dAtA[i:], m.FromBankDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromBankDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GasUsed != 0 {
-		n += 1 + sovTx(uint64(m.GasUsed))
+	if m.FromErc20 != nil {
+		{
+			size := m.FromErc20.Size()
+			i -= size
+			if _, err := m.FromErc20.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateFunTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFunTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFunTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.FuntokenMapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSendFunTokenToEvm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateFunToken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSendFunTokenToEvm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendFunTokenToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FromErc20 != nil {
-		l = m.FromErc20.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.FromBankDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ToEthAddr.Size()
+		i -= size
+		if _, err := m.ToEthAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateFunTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSendFunTokenToEvmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendFunTokenToEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendFunTokenToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.FuntokenMapping.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendFunTokenToEvm) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConvertEvmToCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertEvmToCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertEvmToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ToEthAddr.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ToBankAddr) > 0 {
+		i -= len(m.ToBankAddr)
+		copy(dAtA[i:], m.ToBankAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToBankAddr)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.BankCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Erc20Addr.Size()
+		i -= size
+		if _, err := m.Erc20Addr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendFunTokenToEvmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConvertEvmToCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertEvmToCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertEvmToCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetFunTokenPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSetFunTokenPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *MsgSetFunTokenPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Erc20Addr.Size()
+		i -= size
+		if _, err := m.Erc20Addr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFunTokenPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFunTokenPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFunTokenPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FuntokenMapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterFunToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterFunToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterFunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Erc20Addr.Size()
+		i -= size
+		if _, err := m.Erc20Addr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterFunTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterFunTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterFunTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefreshFunTokenMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefreshFunTokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefreshFunTokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Erc20Addr.Size()
+		i -= size
+		if _, err := m.Erc20Addr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefreshFunTokenMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefreshFunTokenMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefreshFunTokenMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BankMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditCreateAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditCreateAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditCreateAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveAddrs) > 0 {
+		for iNdEx := len(m.RemoveAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddrs[iNdEx])
+			copy(dAtA[i:], m.RemoveAddrs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AddAddrs) > 0 {
+		for iNdEx := len(m.AddAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddAddrs[iNdEx])
+			copy(dAtA[i:], m.AddAddrs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditCreateAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditCreateAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditCreateAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CreatePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 9
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *LegacyTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AccessListTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Accesses) > 0 {
+		for _, e := range m.Accesses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicFeeTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasTipCap != nil {
+		l = m.GasTipCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasFeeCap != nil {
+		l = m.GasFeeCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Accesses) > 0 {
+		for _, e := range m.Accesses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionsEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEthereumTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateFunToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromErc20 != nil {
+		l = m.FromErc20.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromBankDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateFunTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FuntokenMapping.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSendFunTokenToEvm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ToEthAddr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BankCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSendFunTokenToEvmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertEvmToCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Erc20Addr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ToBankAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertEvmToCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BankCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFunTokenPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Erc20Addr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFunTokenPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FuntokenMapping.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnregisterFunToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Erc20Addr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnregisterFunTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefreshFunTokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Erc20Addr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRefreshFunTokenMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BankMetadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgEditCreateAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AddAddrs) > 0 {
+		for _, s := range m.AddAddrs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveAddrs) > 0 {
+		for _, s := range m.RemoveAddrs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEditCreateAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CreatePolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Size_ = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -1968,28 +3732,240 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2016,29 +3992,50 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Size_ = float64(math.Float64frombits(v))
-		case 3:
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2048,29 +4045,31 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2080,23 +4079,25 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2119,7 +4120,7 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LegacyTx) Unmarshal(dAtA []byte) error {
+func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2142,13 +4143,49 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
@@ -2167,9 +4204,9 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2198,12 +4235,48 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
@@ -2222,7 +4295,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -2254,7 +4327,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2290,7 +4363,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -2304,27 +4377,61 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
@@ -2358,7 +4465,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.V = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
@@ -2392,7 +4499,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.R = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
@@ -2447,7 +4554,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2470,15 +4577,65 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2506,36 +4663,13 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.ChainID = &v
-			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2545,52 +4679,31 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
-			}
-			m.GasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2600,27 +4713,29 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2648,17 +4763,13 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Amount = &v
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var byteLen int
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2668,65 +4779,66 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accesses = append(m.Accesses, AccessTuple{})
-			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2736,31 +4848,29 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
-			if m.V == nil {
-				m.V = []byte{}
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2770,60 +4880,75 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
-			if m.R == nil {
-				m.R = []byte{}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
-			if m.S == nil {
-				m.S = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2845,7 +4970,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2868,15 +4993,15 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateFunToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateFunToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromErc20", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2904,34 +5029,15 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.ChainID = &v
-			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_NibiruChain_nibiru_eth.HexAddr
+			m.FromErc20 = &v
+			if err := m.FromErc20.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBankDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2959,15 +5065,11 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.GasTipCap = &v
-			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromBankDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2995,17 +5097,63 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.GasFeeCap = &v
-			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.GasLimit = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFunTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFunTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuntokenMapping", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3015,14 +5163,78 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FuntokenMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendFunTokenToEvm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendFunTokenToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3050,11 +5262,13 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			if err := m.ToEthAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3082,17 +5296,13 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Amount = &v
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3102,31 +5312,130 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendFunTokenToEvmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendFunTokenToEvmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendFunTokenToEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertEvmToCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3136,31 +5445,29 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accesses = append(m.Accesses, AccessTuple{})
-			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3170,31 +5477,31 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
-			if m.V == nil {
-				m.V = []byte{}
+			if err := m.Erc20Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3204,31 +5511,31 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
-			if m.R == nil {
-				m.R = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBankAddr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3238,25 +5545,23 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
-			if m.S == nil {
-				m.S = []byte{}
-			}
+			m.ToBankAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3279,7 +5584,7 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertEvmToCoinResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3298,16 +5603,49 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3329,7 +5667,7 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFunTokenPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3352,15 +5690,15 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFunTokenPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFunTokenPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3388,13 +5726,13 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3404,31 +5742,31 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Erc20Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3438,31 +5776,67 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFunTokenPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFunTokenPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFunTokenPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuntokenMapping", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3472,43 +5846,25 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
-			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.FuntokenMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3530,7 +5886,7 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterFunToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3553,15 +5909,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterFunToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterFunToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3589,13 +5945,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3605,22 +5961,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Erc20Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3645,7 +6002,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterFunTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3668,10 +6025,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterFunTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterFunTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3695,7 +6052,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
+func (m *MsgRefreshFunTokenMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3718,15 +6075,15 @@ func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFunToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefreshFunTokenMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFunToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefreshFunTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromErc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3754,15 +6111,11 @@ func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_NibiruChain_nibiru_eth.HexAddr
-			m.FromErc20 = &v
-			if err := m.FromErc20.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromBankDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3790,39 +6143,9 @@ func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromBankDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Erc20Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3845,7 +6168,7 @@ func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRefreshFunTokenMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3868,15 +6191,15 @@ func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFunTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefreshFunTokenMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFunTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefreshFunTokenMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuntokenMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3903,7 +6226,7 @@ func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuntokenMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BankMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3928,7 +6251,7 @@ func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
+func (m *MsgEditCreateAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3951,15 +6274,15 @@ func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendFunTokenToEvm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditCreateAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendFunTokenToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditCreateAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3987,13 +6310,11 @@ func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ToEthAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAddrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4021,13 +6342,13 @@ func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.AddAddrs = append(m.AddAddrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddrs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4037,24 +6358,23 @@ func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RemoveAddrs = append(m.RemoveAddrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4077,7 +6397,7 @@ func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendFunTokenToEvmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEditCreateAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4100,12 +6420,45 @@ func (m *MsgSendFunTokenToEvmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendFunTokenToEvmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditCreateAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendFunTokenToEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditCreateAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreatePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
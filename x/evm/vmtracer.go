@@ -2,6 +2,7 @@
 package evm
 
 import (
+	"encoding/json"
 	"math/big"
 	"os"
 	"time"
@@ -50,6 +51,29 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 	}
 }
 
+// UnmarshalJSON implements json.Unmarshaler so that the "tracerConfig" of a
+// JSON-RPC trace request can be a JSON object, as in geth, and not only the
+// JSON string of one.
+func (tc *TraceConfig) UnmarshalJSON(bz []byte) error {
+	type traceConfig TraceConfig
+	aux := struct {
+		*traceConfig
+		TracerJsonConfig json.RawMessage `json:"tracerConfig"`
+	}{traceConfig: (*traceConfig)(tc)}
+	if err := json.Unmarshal(bz, &aux); err != nil {
+		return err
+	}
+	switch {
+	case len(aux.TracerJsonConfig) == 0 || string(aux.TracerJsonConfig) == "null":
+		tc.TracerJsonConfig = ""
+	case aux.TracerJsonConfig[0] == '"':
+		return json.Unmarshal(aux.TracerJsonConfig, &tc.TracerJsonConfig)
+	default:
+		tc.TracerJsonConfig = string(aux.TracerJsonConfig)
+	}
+	return nil
+}
+
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
 	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer